@@ -4,10 +4,16 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/webconfig"
 )
 
-// parseWebConfig delegates to the shared webconfig package.
-func parseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
-	return webconfig.ParseWebConfig(enabled, logger)
+// parseWebConfig delegates to the shared webconfig package, using the "web"
+// section of the configuration file, if any, for settings not in the environment.
+func parseWebConfig(cfg *config.Config, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
+	var web config.Web
+	if cfg != nil {
+		web = cfg.Web
+	}
+	return webconfig.ParseWebConfigFrom(webconfig.Enabled(web), web, logger)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/router"
 )
 
@@ -53,7 +54,6 @@ func run() error {
 	// Parse environment variables
 	offline := strings.ToLower(os.Getenv("FINFOCUS_PLUGIN_OFFLINE")) == "true"
 	eagerWarmup := strings.ToLower(os.Getenv("FINFOCUS_PLUGIN_EAGER_WARMUP")) != "false"
-	webEnabled := strings.ToLower(os.Getenv("FINFOCUS_PLUGIN_WEB_ENABLED")) == "true"
 
 	// Create downloader (nil if offline)
 	var downloader *router.Downloader
@@ -61,8 +61,19 @@ func run() error {
 		downloader = router.NewDownloader(version, binaryDir, logger)
 	}
 
+	// Load the configuration file, if any; an invalid file fails startup.
+	// Children inherit FINFOCUS_CONFIG_FILE and load and watch it themselves.
+	cfg, err := config.LoadFromEnv()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load configuration file")
+		return err
+	}
+
 	// Create router plugin
 	routerPlugin := router.NewPlugin(version, logger, binaryDir, offline, downloader)
+	if cfg != nil {
+		routerPlugin.ApplyConfig(cfg)
+	}
 
 	logger.Info().
 		Str("binary_dir", binaryDir).
@@ -83,6 +94,14 @@ func run() error {
 		routerPlugin.ShutdownAll(context.Background())
 	}()
 
+	// Reload the router's own settings when the configuration file changes
+	if filename := os.Getenv(config.EnvConfigFile); filename != "" {
+		go config.Watch(ctx, filename, config.DefaultWatchInterval, logger, func(cfg *config.Config) error {
+			routerPlugin.ApplyConfig(cfg)
+			return nil
+		})
+	}
+
 	// Eager warm-up: launch discovered children before serving
 	if eagerWarmup {
 		routerPlugin.WarmUp(ctx)
@@ -95,13 +114,13 @@ func run() error {
 	}
 
 	// Configure web serving
-	webConfig, err := parseWebConfig(webEnabled, logger)
+	webConfig, err := parseWebConfig(cfg, logger)
 	if err != nil {
 		logger.Error().Err(err).Msg("failed to parse web configuration")
 		return err
 	}
 
-	config := pluginsdk.ServeConfig{
+	serveConfig := pluginsdk.ServeConfig{
 		Plugin: routerPlugin,
 		Port:   port,
 		PluginInfo: &pluginsdk.PluginInfo{
@@ -116,11 +135,11 @@ func run() error {
 	}
 
 	if webConfig.Enabled {
-		config.Web = webConfig
+		serveConfig.Web = webConfig
 		logger.Info().Msg("web serving enabled with multi-protocol support")
 	}
 
-	if serveErr := pluginsdk.Serve(ctx, config); serveErr != nil {
+	if serveErr := pluginsdk.Serve(ctx, serveConfig); serveErr != nil {
 		logger.Error().Err(serveErr).Msg("server error")
 		return serveErr
 	}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
+)
+
+// runBudget evaluates the budgets in FINFOCUS_BUDGETS_FILE, or the budgets
+// file of the configuration file, against the resources read as JSON or YAML
+// from in, and writes the evaluated budgets as
+// indented JSON to out. It fails when any budget is exceeded, so a CI job can
+// block a "pulumi preview" that breaks a budget:
+//
+//	finfocus-plugin-aws-public budget < resources.json
+func runBudget(ctx context.Context, awsPlugin *plugin.AWSPublicPlugin, cfg *config.Config, in io.Reader,
+	out io.Writer, logger zerolog.Logger) error {
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	files := cfg.Budgets.Files()
+	if files.Budgets == "" {
+		logger.Error().Err(budget.ErrNoBudgets).Msg("failed to load budgets")
+		return budget.ErrNoBudgets
+	}
+	budgets, err := budget.Load(files.Budgets)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load budgets")
+		return err
+	}
+	data, err := io.ReadAll(in)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to read budget resources")
+		return err
+	}
+	resources, err := budget.ParseResources(data)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to parse budget resources")
+		return err
+	}
+
+	resp := budget.Evaluate(ctx, budgets, resources, &pbc.GetBudgetsRequest{IncludeStatus: true},
+		func(ctx context.Context, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+			return awsPlugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+		})
+
+	encoded, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintln(out, string(encoded)); err != nil {
+		return err
+	}
+
+	var exceeded []error
+	for _, b := range resp.GetBudgets() {
+		if b.GetStatus().GetHealth() == pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED {
+			exceeded = append(exceeded, fmt.Errorf("budget %s exceeded: %.2f of %.2f %s",
+				b.GetId(), b.GetStatus().GetForecastedSpend(), b.GetAmount().GetLimit(),
+				b.GetMetadata()[budget.MetadataKeyUnit]))
+		}
+	}
+	if err = errors.Join(exceeded...); err != nil {
+		logger.Error().Err(err).Msg("budget check failed")
+	}
+	return err
+}
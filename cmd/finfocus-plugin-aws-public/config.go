@@ -1,13 +1,68 @@
 package main
 
 import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/webconfig"
 )
 
-// parseWebConfig delegates to the shared webconfig package.
-func parseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
-	return webconfig.ParseWebConfig(enabled, logger)
+// parseWebConfig delegates to the shared webconfig package, using the "web"
+// section of the configuration file, if any, for settings not in the environment.
+func parseWebConfig(cfg *config.Config, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
+	var web config.Web
+	if cfg != nil {
+		web = cfg.Web
+	}
+	return webconfig.ParseWebConfigFrom(webconfig.Enabled(web), web, logger)
+}
+
+// runConfig validates a configuration file or prints the JSON Schema of
+// configuration files to out:
+//
+//	finfocus-plugin-aws-public config validate [file]
+//	finfocus-plugin-aws-public config schema
+//
+// validate checks the file named by FINFOCUS_CONFIG_FILE when none is given.
+func runConfig(args []string, out io.Writer, logger zerolog.Logger) error {
+	var command string
+	if len(args) > 0 {
+		command = args[0]
+	}
+	switch command {
+	case "schema":
+		_, err := out.Write(config.Schema)
+		return err
+	case "validate":
+		filename := os.Getenv(config.EnvConfigFile)
+		if len(args) > 1 {
+			filename = args[1]
+		}
+		if filename == "" {
+			err := errors.New("no configuration file given; pass a file or set " + config.EnvConfigFile)
+			logger.Error().Err(err).Msg("failed to validate configuration file")
+			return err
+		}
+		cfg, err := config.Load(filename)
+		if err == nil {
+			err = plugin.ValidateConfig(cfg)
+		}
+		if err != nil {
+			logger.Error().Err(err).Str("config_file", filename).Msg("invalid configuration file")
+			return err
+		}
+		_, err = fmt.Fprintf(out, "%s is valid\n", filename)
+		return err
+	default:
+		err := fmt.Errorf("unknown config command %q, want validate or schema", command)
+		logger.Error().Err(err).Msg("failed to run config command")
+		return err
+	}
 }
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+
+	"github.com/rs/zerolog"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
+)
+
+// runDiff reads a cost diff request as JSON from in, prices the old and new
+// resource state and writes the diff as indented JSON to out. Used by CI jobs
+// such as PR review bots that run the plugin binary directly:
+//
+//	finfocus-plugin-aws-public diff < change.json
+func runDiff(ctx context.Context, awsPlugin *plugin.AWSPublicPlugin, in io.Reader, out io.Writer,
+	logger zerolog.Logger) error {
+	data, err := io.ReadAll(in)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to read cost diff request")
+		return err
+	}
+	req, err := plugin.ParseCostDiffRequest(data)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to parse cost diff request")
+		return err
+	}
+	diff, err := awsPlugin.DiffCost(ctx, req)
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to compute cost diff")
+		return err
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(diff)
+}
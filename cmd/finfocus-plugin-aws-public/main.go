@@ -6,12 +6,12 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
-	"strings"
 	"syscall"
 
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/plugin"
 	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
 )
@@ -48,6 +48,11 @@ func run() error {
 	// Create logger using SDK utility (outputs JSON to stderr)
 	logger := pluginsdk.NewPluginLogger("aws-public", version, level, nil)
 
+	// "config" validates the configuration file or prints its schema
+	if flag.Arg(0) == "config" {
+		return runConfig(flag.Args()[1:], os.Stdout, logger)
+	}
+
 	// Validate test mode env var at startup (logs warning for invalid values)
 	plugin.ValidateTestModeEnv(logger)
 
@@ -85,8 +90,31 @@ func run() error {
 		logger.Debug().Msg("using ephemeral port")
 	}
 
+	// Load the configuration file, if any; an invalid file fails startup
+	cfg, err := config.LoadFromEnv()
+	if err != nil {
+		logger.Error().Err(err).Msg("failed to load configuration file")
+		return err
+	}
+
 	// Create plugin instance with logger
 	awsPlugin := plugin.NewAWSPublicPlugin(region, version, pricingClient, logger)
+	if cfg != nil {
+		if err = awsPlugin.ApplyConfig(cfg); err != nil {
+			logger.Error().Err(err).Msg("failed to apply configuration file")
+			return err
+		}
+	}
+
+	// "diff" prices a resource change read from stdin instead of serving
+	if flag.Arg(0) == "diff" {
+		return runDiff(context.Background(), awsPlugin, os.Stdin, os.Stdout, logger)
+	}
+
+	// "budget" checks resources read from stdin against the declared budgets
+	if flag.Arg(0) == "budget" {
+		return runBudget(context.Background(), awsPlugin, cfg, os.Stdin, os.Stdout, logger)
+	}
 
 	// Setup context for graceful shutdown
 	ctx, cancel := context.WithCancel(context.Background())
@@ -101,11 +129,14 @@ func run() error {
 		cancel()
 	}()
 
-	// Check if web serving is enabled (for browser/testing access)
-	webEnabled := strings.ToLower(os.Getenv("FINFOCUS_PLUGIN_WEB_ENABLED")) == "true"
+	// Reload the configuration file when it changes. Web settings and the
+	// port take effect on restart.
+	if filename := os.Getenv(config.EnvConfigFile); filename != "" {
+		go config.Watch(ctx, filename, config.DefaultWatchInterval, logger, awsPlugin.ApplyConfig)
+	}
 
 	// Serve using pluginsdk
-	config := pluginsdk.ServeConfig{
+	serveConfig := pluginsdk.ServeConfig{
 		Plugin: awsPlugin,
 		Port:   port, // Use determined port (0 for ephemeral)
 		// PluginInfo enables GetPluginInfo RPC for version negotiation with Core
@@ -122,15 +153,15 @@ func run() error {
 	}
 
 	// Enable web serving if requested (supports browser access via connect-go)
-	webConfig, err := parseWebConfig(webEnabled, logger)
+	webConfig, err := parseWebConfig(cfg, logger)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("failed to parse web configuration")
 	}
 	if webConfig.Enabled {
-		config.Web = webConfig
+		serveConfig.Web = webConfig
 		logger.Info().Msg("web serving enabled with multi-protocol support")
 	}
-	err = pluginsdk.Serve(ctx, config)
+	err = pluginsdk.Serve(ctx, serveConfig)
 	if err != nil {
 		logger.Error().Err(err).Msg("server error")
 		return err
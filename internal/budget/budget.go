@@ -0,0 +1,307 @@
+// Package budget evaluates locally declared monthly budgets against the
+// projected costs of a set of resources. It backs GetBudgets in both the
+// region plugin and the router, and the "budget" pre-deployment check.
+//
+// Budgets are declared in a YAML or JSON file and scoped by tags, service,
+// region and Pulumi stack. Each budget limits either the monthly cost in USD
+// or the monthly carbon footprint in gCO2e. Pricing is supplied by the caller,
+// so the same evaluation serves a single-region plugin and the multi-region
+// router.
+package budget
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables locating the budget files.
+const (
+	// EnvBudgetsFile is the path of the budgets file. GetBudgets returns no
+	// budgets when it is unset.
+	EnvBudgetsFile = "FINFOCUS_BUDGETS_FILE"
+
+	// EnvResourcesFile is the path of the resources file the budgets are
+	// evaluated against, typically written from a "pulumi preview" before
+	// GetBudgets is called. Budgets have no spend when it is unset.
+	EnvResourcesFile = "FINFOCUS_BUDGET_RESOURCES_FILE"
+)
+
+// Budget units.
+const (
+	UnitUSD    = "USD"
+	UnitCarbon = "gCO2e"
+)
+
+// defaultThresholds are the alert percentages of a budget declaring none.
+var defaultThresholds = []float64{80, 100}
+
+// Definition is a budget declared in the budgets file.
+type Definition struct {
+	// ID uniquely identifies the budget.
+	ID string `yaml:"id" json:"id"`
+
+	// Name is a human-readable name; defaults to the ID.
+	Name string `yaml:"name" json:"name"`
+
+	// Limit is the monthly limit in Unit.
+	Limit float64 `yaml:"limit" json:"limit"`
+
+	// Unit is UnitUSD (default) or UnitCarbon.
+	Unit string `yaml:"unit" json:"unit"`
+
+	// Thresholds are alert percentages of the limit (default 80 and 100).
+	Thresholds []float64 `yaml:"thresholds" json:"thresholds"`
+
+	// Scope restricts the resources counted against the budget.
+	Scope Scope `yaml:"scope" json:"scope"`
+}
+
+// Scope restricts the resources of a budget. Empty fields match every
+// resource; a resource must match every non-empty field.
+type Scope struct {
+	// Tags a resource must carry, all with the given values.
+	Tags map[string]string `yaml:"tags" json:"tags"`
+
+	// Services the resource must belong to, e.g. "ec2", "s3", or a full
+	// resource type such as "aws:rds/instance:Instance".
+	Services []string `yaml:"services" json:"services"`
+
+	// Regions the resource must be in.
+	Regions []string `yaml:"regions" json:"regions"`
+
+	// Stack is the Pulumi stack of the resource, read from its URN.
+	Stack string `yaml:"stack" json:"stack"`
+}
+
+// Config is the budgets file.
+type Config struct {
+	Budgets []Definition `yaml:"budgets" json:"budgets"`
+}
+
+// Resource is a resource a budget is evaluated against.
+type Resource struct {
+	// ID identifies the resource in top contributors, e.g. its Pulumi URN.
+	ID           string            `yaml:"id" json:"id"`
+	Provider     string            `yaml:"provider" json:"provider"`
+	ResourceType string            `yaml:"resource_type" json:"resource_type"`
+	SKU          string            `yaml:"sku" json:"sku"`
+	Region       string            `yaml:"region" json:"region"`
+	Tags         map[string]string `yaml:"tags" json:"tags"`
+}
+
+// Descriptor returns the resource as priced by GetProjectedCost.
+func (r Resource) Descriptor() *pbc.ResourceDescriptor {
+	provider := r.Provider
+	if provider == "" {
+		provider = "aws"
+	}
+	return &pbc.ResourceDescriptor{
+		Id:           r.ID,
+		Provider:     provider,
+		ResourceType: r.ResourceType,
+		Sku:          r.SKU,
+		Region:       r.Region,
+		Tags:         r.Tags,
+	}
+}
+
+// resourcesFile is the resources file, also read by the "budget" check.
+type resourcesFile struct {
+	Resources []Resource `yaml:"resources" json:"resources"`
+}
+
+// Load reads and validates a budgets file.
+func Load(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read budgets file: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses and validates a budgets file in YAML or JSON.
+func Parse(data []byte) (*Config, error) {
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse budgets file: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate checks the budgets have unique IDs, positive limits, known units
+// and thresholds above zero, and fills in defaults.
+func (c *Config) Validate() error {
+	seen := make(map[string]struct{}, len(c.Budgets))
+	for i := range c.Budgets {
+		b := &c.Budgets[i]
+		if b.ID == "" {
+			return fmt.Errorf("budget %d missing id", i)
+		}
+		if _, exists := seen[b.ID]; exists {
+			return fmt.Errorf("duplicate budget id: %s", b.ID)
+		}
+		seen[b.ID] = struct{}{}
+
+		if b.Limit <= 0 {
+			return fmt.Errorf("budget %s limit must be positive, got %g", b.ID, b.Limit)
+		}
+		switch b.Unit {
+		case "":
+			b.Unit = UnitUSD
+		case UnitUSD, UnitCarbon:
+		default:
+			return fmt.Errorf("budget %s unit must be %s or %s, got %q", b.ID, UnitUSD, UnitCarbon, b.Unit)
+		}
+		if b.Name == "" {
+			b.Name = b.ID
+		}
+		if len(b.Thresholds) == 0 {
+			b.Thresholds = slices.Clone(defaultThresholds)
+		}
+		for _, t := range b.Thresholds {
+			if t <= 0 {
+				return fmt.Errorf("budget %s thresholds must be positive percentages, got %g", b.ID, t)
+			}
+		}
+		slices.Sort(b.Thresholds)
+	}
+	return nil
+}
+
+// LoadResources reads a resources file.
+func LoadResources(filename string) ([]Resource, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read budget resources file: %w", err)
+	}
+	return ParseResources(data)
+}
+
+// ParseResources parses a resources file in YAML or JSON:
+//
+//	{"resources": [{"id": "urn:pulumi:prod::app::aws:ec2/instance:Instance::web",
+//	  "resource_type": "aws:ec2/instance:Instance", "sku": "t3.micro",
+//	  "region": "us-east-1", "tags": {"team": "web"}}]}
+func ParseResources(data []byte) ([]Resource, error) {
+	var file resourcesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse budget resources: %w", err)
+	}
+	for i, r := range file.Resources {
+		if r.ResourceType == "" {
+			return nil, fmt.Errorf("budget resource %d missing resource_type", i)
+		}
+	}
+	return file.Resources, nil
+}
+
+// Files locates the budget files, as configured by EnvBudgetsFile and
+// EnvResourcesFile.
+type Files struct {
+	Budgets   string
+	Resources string
+}
+
+// FilesFromEnv returns the budget files configured in the environment.
+func FilesFromEnv() Files {
+	return Files{
+		Budgets:   os.Getenv(EnvBudgetsFile),
+		Resources: os.Getenv(EnvResourcesFile),
+	}
+}
+
+// Or returns the files, with those not set taken from fallback, such as the
+// files named in the plugin configuration file.
+func (f Files) Or(fallback Files) Files {
+	return Files{
+		Budgets:   cmp.Or(f.Budgets, fallback.Budgets),
+		Resources: cmp.Or(f.Resources, fallback.Resources),
+	}
+}
+
+// Load reads the budgets and resources. Both files are read on every call,
+// so budgets and the previewed resources can change between calls. A missing
+// budgets setting yields no budgets, a missing resources setting no resources.
+func (f Files) Load() (*Config, []Resource, error) {
+	config := &Config{}
+	if f.Budgets != "" {
+		loaded, err := Load(f.Budgets)
+		if err != nil {
+			return nil, nil, err
+		}
+		config = loaded
+	}
+	if f.Resources == "" || len(config.Budgets) == 0 {
+		return config, nil, nil
+	}
+	resources, err := LoadResources(f.Resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	return config, resources, nil
+}
+
+// ErrNoBudgets reports a budget check run without any declared budgets.
+var ErrNoBudgets = errors.New("no budgets declared; set " + EnvBudgetsFile)
+
+// serviceOf returns the service of a resource type: the module of a Pulumi
+// type ("aws:ec2/instance:Instance" is "ec2"), the service of a CloudFormation
+// type ("AWS::S3::Bucket" is "s3"), or the type itself, lowercased.
+func serviceOf(resourceType string) string {
+	resourceType = strings.ToLower(resourceType)
+	if rest, ok := strings.CutPrefix(resourceType, "aws::"); ok {
+		service, _, _ := strings.Cut(rest, "::")
+		return service
+	}
+	if rest, ok := strings.CutPrefix(resourceType, "aws:"); ok {
+		module, _, _ := strings.Cut(rest, "/")
+		return module
+	}
+	return resourceType
+}
+
+// stackOf returns the Pulumi stack of a resource from its URN
+// ("urn:pulumi:<stack>::<project>::<type>::<name>"), falling back to a
+// "pulumi:stack" tag.
+func stackOf(r Resource) string {
+	if rest, ok := strings.CutPrefix(r.ID, "urn:pulumi:"); ok {
+		stack, _, found := strings.Cut(rest, "::")
+		if found {
+			return stack
+		}
+	}
+	return r.Tags["pulumi:stack"]
+}
+
+// matches reports whether a resource falls within the scope.
+func (s Scope) matches(r Resource) bool {
+	for key, want := range s.Tags {
+		if got, ok := r.Tags[key]; !ok || got != want {
+			return false
+		}
+	}
+	if !s.matchesService(r.ResourceType) {
+		return false
+	}
+	if len(s.Regions) > 0 && !slices.Contains(s.Regions, r.Region) {
+		return false
+	}
+	return s.Stack == "" || s.Stack == stackOf(r)
+}
+
+// matchesService reports whether a resource type belongs to the scope's services.
+func (s Scope) matchesService(resourceType string) bool {
+	return len(s.Services) == 0 || slices.ContainsFunc(s.Services, func(service string) bool {
+		return strings.EqualFold(service, resourceType) || strings.EqualFold(service, serviceOf(resourceType))
+	})
+}
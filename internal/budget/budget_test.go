@@ -0,0 +1,214 @@
+package budget
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse verifies budgets files are parsed, defaulted and validated.
+func TestParse(t *testing.T) {
+	config, err := Parse([]byte(`
+budgets:
+  - id: web
+    limit: 100
+    thresholds: [100, 50]
+    scope:
+      tags: {team: web}
+      services: [ec2]
+      stack: prod
+  - id: carbon
+    name: Carbon cap
+    limit: 5000
+    unit: gCO2e
+`))
+	require.NoError(t, err)
+	require.Len(t, config.Budgets, 2)
+	assert.Equal(t, "web", config.Budgets[0].Name)
+	assert.Equal(t, UnitUSD, config.Budgets[0].Unit)
+	assert.Equal(t, []float64{50, 100}, config.Budgets[0].Thresholds)
+	assert.Equal(t, "prod", config.Budgets[0].Scope.Stack)
+	assert.Equal(t, []float64{80, 100}, config.Budgets[1].Thresholds)
+
+	for name, data := range map[string]string{
+		"missing id":    `{"budgets": [{"limit": 1}]}`,
+		"duplicate id":  `{"budgets": [{"id": "a", "limit": 1}, {"id": "a", "limit": 2}]}`,
+		"zero limit":    `{"budgets": [{"id": "a"}]}`,
+		"unknown unit":  `{"budgets": [{"id": "a", "limit": 1, "unit": "EUR"}]}`,
+		"bad threshold": `{"budgets": [{"id": "a", "limit": 1, "thresholds": [-5]}]}`,
+		"not yaml":      `budgets: [`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := Parse([]byte(data))
+			assert.Error(t, err)
+		})
+	}
+}
+
+// TestFilesLoad verifies both files are read, and missing settings yield no budgets.
+func TestFilesLoad(t *testing.T) {
+	config, resources, err := Files{}.Load()
+	require.NoError(t, err)
+	assert.Empty(t, config.Budgets)
+	assert.Empty(t, resources)
+
+	dir := t.TempDir()
+	budgetsFile := filepath.Join(dir, "budgets.yaml")
+	resourcesFile := filepath.Join(dir, "resources.json")
+	require.NoError(t, os.WriteFile(budgetsFile, []byte("budgets:\n  - id: all\n    limit: 10\n"), 0o600))
+	require.NoError(t, os.WriteFile(resourcesFile,
+		[]byte(`{"resources": [{"resource_type": "aws:ec2/instance:Instance", "sku": "t3.micro"}]}`), 0o600))
+
+	config, resources, err = Files{Budgets: budgetsFile, Resources: resourcesFile}.Load()
+	require.NoError(t, err)
+	assert.Len(t, config.Budgets, 1)
+	require.Len(t, resources, 1)
+	assert.Equal(t, "aws", resources[0].Descriptor().GetProvider())
+
+	_, _, err = Files{Budgets: filepath.Join(dir, "missing.yaml")}.Load()
+	assert.Error(t, err)
+}
+
+// TestScopeMatches verifies resources are scoped by tag, service, region and stack.
+func TestScopeMatches(t *testing.T) {
+	resource := Resource{
+		ID:           "urn:pulumi:prod::shop::aws:ec2/instance:Instance::web",
+		ResourceType: "aws:ec2/instance:Instance",
+		Region:       "us-east-1",
+		Tags:         map[string]string{"team": "web"},
+	}
+
+	tests := []struct {
+		name  string
+		scope Scope
+		want  bool
+	}{
+		{"empty scope", Scope{}, true},
+		{"tag", Scope{Tags: map[string]string{"team": "web"}}, true},
+		{"other tag value", Scope{Tags: map[string]string{"team": "data"}}, false},
+		{"service module", Scope{Services: []string{"EC2"}}, true},
+		{"full resource type", Scope{Services: []string{"aws:ec2/instance:Instance"}}, true},
+		{"other service", Scope{Services: []string{"s3"}}, false},
+		{"region", Scope{Regions: []string{"us-west-2", "us-east-1"}}, true},
+		{"other region", Scope{Regions: []string{"eu-west-1"}}, false},
+		{"stack", Scope{Stack: "prod"}, true},
+		{"other stack", Scope{Stack: "dev"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.scope.matches(resource))
+		})
+	}
+
+	assert.Equal(t, "s3", serviceOf("AWS::S3::Bucket"))
+	assert.Equal(t, "dev", stackOf(Resource{Tags: map[string]string{"pulumi:stack": "dev"}}))
+}
+
+// fixedPrices prices resources by SKU, failing for unknown SKUs.
+func fixedPrices(prices map[string]float64) PriceFunc {
+	return func(_ context.Context, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+		cost, ok := prices[resource.GetSku()]
+		if !ok {
+			return nil, errors.New("unsupported")
+		}
+		return &pbc.GetProjectedCostResponse{
+			CostPerMonth: cost,
+			ImpactMetrics: []*pbc.ImpactMetric{
+				{Kind: pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT, Value: cost * 10},
+			},
+		}, nil
+	}
+}
+
+// TestEvaluate verifies budget status, health, overage and top contributors.
+func TestEvaluate(t *testing.T) {
+	config, err := Parse([]byte(`{"budgets": [
+		{"id": "web", "limit": 100, "scope": {"tags": {"team": "web"}}},
+		{"id": "data", "limit": 100, "scope": {"tags": {"team": "data"}}},
+		{"id": "carbon", "limit": 1000, "unit": "gCO2e"},
+		{"id": "idle", "limit": 100, "scope": {"regions": ["eu-west-1"]}}
+	]}`))
+	require.NoError(t, err)
+	resources := []Resource{
+		{ID: "web-1", ResourceType: "ec2", SKU: "large", Tags: map[string]string{"team": "web"}},
+		{ID: "web-2", ResourceType: "ec2", SKU: "small", Tags: map[string]string{"team": "web"}},
+		{ID: "web-3", ResourceType: "ec2", SKU: "unknown", Tags: map[string]string{"team": "web"}},
+		{ID: "data-1", ResourceType: "s3", SKU: "medium", Tags: map[string]string{"team": "data"}},
+	}
+	calls := 0
+	prices := fixedPrices(map[string]float64{"large": 90, "small": 30, "medium": 85})
+	price := func(ctx context.Context, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+		calls++
+		return prices(ctx, resource)
+	}
+
+	resp := Evaluate(context.Background(), config, resources, &pbc.GetBudgetsRequest{IncludeStatus: true}, price)
+	require.Len(t, resp.GetBudgets(), 4)
+	assert.Equal(t, 4, calls, "each resource is priced once")
+
+	web := resp.GetBudgets()[0]
+	assert.InDelta(t, 120, web.GetStatus().GetForecastedSpend(), 1e-9)
+	assert.Equal(t, pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED, web.GetStatus().GetHealth())
+	assert.Equal(t, "20.00", web.GetMetadata()[MetadataKeyForecastedOverage])
+	assert.Equal(t, "1", web.GetMetadata()[MetadataKeyUnpricedResources])
+	assert.True(t, web.GetThresholds()[1].GetTriggered())
+	var top []Contributor
+	require.NoError(t, json.Unmarshal([]byte(web.GetMetadata()[MetadataKeyTopContributors]), &top))
+	require.Len(t, top, 2)
+	assert.Equal(t, Contributor{Resource: "web-1", ResourceType: "ec2", Amount: 90, Percent: 75}, top[0])
+
+	data := resp.GetBudgets()[1]
+	assert.Equal(t, pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING, data.GetStatus().GetHealth())
+	assert.Equal(t, "0.00", data.GetMetadata()[MetadataKeyForecastedOverage])
+
+	carbon := resp.GetBudgets()[2]
+	assert.InDelta(t, 2050, carbon.GetStatus().GetForecastedSpend(), 1e-9)
+	assert.Equal(t, "XXX", carbon.GetStatus().GetCurrency())
+	assert.Equal(t, UnitCarbon, carbon.GetMetadata()[MetadataKeyUnit])
+
+	idle := resp.GetBudgets()[3]
+	assert.Equal(t, pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK, idle.GetStatus().GetHealth())
+	assert.Equal(t, "[]", idle.GetMetadata()[MetadataKeyTopContributors])
+
+	assert.Equal(t, &pbc.BudgetSummary{TotalBudgets: 4, BudgetsOk: 1, BudgetsWarning: 1, BudgetsExceeded: 2},
+		resp.GetSummary())
+}
+
+// TestEvaluate_Filter verifies the request filter selects budgets, and status
+// is only evaluated when requested.
+func TestEvaluate_Filter(t *testing.T) {
+	config, err := Parse([]byte(`{"budgets": [
+		{"id": "east", "limit": 10, "scope": {"regions": ["us-east-1"], "services": ["ec2"]}},
+		{"id": "west", "limit": 10, "scope": {"regions": ["us-west-2"], "tags": {"team": "web"}}},
+		{"id": "all", "limit": 10}
+	]}`))
+	require.NoError(t, err)
+	price := func(context.Context, *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+		t.Fatal("resources must not be priced without include_status")
+		return nil, nil
+	}
+
+	ids := func(filter *pbc.BudgetFilter) []string {
+		resp := Evaluate(context.Background(), config, []Resource{{ResourceType: "ec2"}},
+			&pbc.GetBudgetsRequest{Filter: filter}, price)
+		var got []string
+		for _, b := range resp.GetBudgets() {
+			assert.Nil(t, b.GetStatus())
+			got = append(got, b.GetId())
+		}
+		return got
+	}
+
+	assert.Equal(t, []string{"east", "west", "all"}, ids(nil))
+	assert.Equal(t, []string{"east", "all"}, ids(&pbc.BudgetFilter{Regions: []string{"us-east-1"}}))
+	assert.Equal(t, []string{"east", "west", "all"}, ids(&pbc.BudgetFilter{ResourceTypes: []string{"aws:ec2/instance:Instance"}}))
+	assert.Equal(t, []string{"west"}, ids(&pbc.BudgetFilter{Tags: map[string]string{"team": "web"}}))
+	assert.Empty(t, ids(&pbc.BudgetFilter{Providers: []string{"gcp"}}))
+}
@@ -0,0 +1,286 @@
+package budget
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Source identifies these budgets in GetBudgets responses.
+const Source = "local"
+
+// Metadata keys of an evaluated budget.
+const (
+	// MetadataKeyUnit is the budget unit, UnitUSD or UnitCarbon.
+	MetadataKeyUnit = "unit"
+
+	// MetadataKeyForecastedOverage is the projected monthly amount over the
+	// limit, "0.00" when within it.
+	MetadataKeyForecastedOverage = "forecasted_overage"
+
+	// MetadataKeyTopContributors is the JSON-encoded list of the resources
+	// contributing most to the budget, as Contributor values.
+	MetadataKeyTopContributors = "top_contributors"
+
+	// MetadataKeyResources is the number of priced resources in scope.
+	MetadataKeyResources = "resources"
+
+	// MetadataKeyUnpricedResources is the number of resources in scope that
+	// could not be priced, set only when there are any.
+	MetadataKeyUnpricedResources = "unpriced_resources"
+
+	// MetadataKeyScopeServices and MetadataKeyScopeStack describe the parts of
+	// the scope BudgetFilter has no field for.
+	MetadataKeyScopeServices = "scope_services"
+	MetadataKeyScopeStack    = "scope_stack"
+)
+
+// noCurrency is the ISO 4217 code for "no currency", used as the currency of
+// carbon budgets.
+const noCurrency = "XXX"
+
+// criticalPercent is the share of the limit from which a budget is critical.
+const criticalPercent = 90
+
+// maxContributors is the number of top contributors reported per budget.
+const maxContributors = 5
+
+// Contributor is a resource contributing to a budget.
+type Contributor struct {
+	Resource     string  `json:"resource"`
+	ResourceType string  `json:"resource_type"`
+	Amount       float64 `json:"amount"`
+	Percent      float64 `json:"percent"`
+}
+
+// PriceFunc returns the projected monthly cost of a resource.
+type PriceFunc func(ctx context.Context, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error)
+
+// projection is the priced monthly cost and carbon footprint of a resource.
+type projection struct {
+	cost   float64
+	carbon float64
+	err    error
+}
+
+// Evaluate returns the budgets selected by the request filter. When the
+// request includes status, each budget's projected monthly spend is the sum
+// of the projected costs (or carbon footprints) of the resources in its scope,
+// priced once each with price. Budgets are evaluated against forecasted spend
+// only: current spend is zero, as the resources are not yet deployed.
+//
+// Health is EXCEEDED above the limit, CRITICAL from 90% of it, WARNING once
+// any threshold is crossed and OK otherwise.
+func Evaluate(
+	ctx context.Context,
+	config *Config,
+	resources []Resource,
+	req *pbc.GetBudgetsRequest,
+	price PriceFunc,
+) *pbc.GetBudgetsResponse {
+	resp := &pbc.GetBudgetsResponse{Summary: &pbc.BudgetSummary{}}
+	projections := make(map[int]projection, len(resources))
+	for _, def := range config.Budgets {
+		if !selected(def, req.GetFilter()) {
+			continue
+		}
+		budget := newBudget(def)
+		if req.GetIncludeStatus() {
+			evaluate(ctx, budget, def, resources, projections, price)
+			countHealth(resp.Summary, budget.GetStatus().GetHealth())
+		}
+		resp.Budgets = append(resp.Budgets, budget)
+	}
+	resp.Summary.TotalBudgets = int32(len(resp.Budgets)) //nolint:gosec // bounded by the budgets file
+	return resp
+}
+
+// selected reports whether a budget matches the request filter: the provider
+// is AWS, and its scope overlaps the requested regions and resource types and
+// includes the requested tags.
+func selected(def Definition, filter *pbc.BudgetFilter) bool {
+	if providers := filter.GetProviders(); len(providers) > 0 &&
+		!slices.ContainsFunc(providers, func(p string) bool { return strings.EqualFold(p, "aws") }) {
+		return false
+	}
+	if regions := filter.GetRegions(); len(regions) > 0 && len(def.Scope.Regions) > 0 &&
+		!slices.ContainsFunc(regions, func(r string) bool { return slices.Contains(def.Scope.Regions, r) }) {
+		return false
+	}
+	if types := filter.GetResourceTypes(); len(types) > 0 && !slices.ContainsFunc(types, def.Scope.matchesService) {
+		return false
+	}
+	for key, want := range filter.GetTags() {
+		if def.Scope.Tags[key] != want {
+			return false
+		}
+	}
+	return true
+}
+
+// newBudget returns the declared budget without status.
+func newBudget(def Definition) *pbc.Budget {
+	currency := UnitUSD
+	if def.Unit == UnitCarbon {
+		currency = noCurrency
+	}
+	budget := &pbc.Budget{
+		Id:     def.ID,
+		Name:   def.Name,
+		Source: Source,
+		Amount: &pbc.BudgetAmount{Limit: def.Limit, Currency: currency},
+		Period: pbc.BudgetPeriod_BUDGET_PERIOD_MONTHLY,
+		Filter: &pbc.BudgetFilter{
+			Providers:     []string{"aws"},
+			Regions:       def.Scope.Regions,
+			ResourceTypes: def.Scope.Services,
+			Tags:          def.Scope.Tags,
+		},
+		Metadata: map[string]string{MetadataKeyUnit: def.Unit},
+	}
+	if len(def.Scope.Services) > 0 {
+		budget.Metadata[MetadataKeyScopeServices] = strings.Join(def.Scope.Services, ",")
+	}
+	if def.Scope.Stack != "" {
+		budget.Metadata[MetadataKeyScopeStack] = def.Scope.Stack
+	}
+	for _, pct := range def.Thresholds {
+		budget.Thresholds = append(budget.Thresholds, &pbc.BudgetThreshold{
+			Percentage: pct,
+			Type:       pbc.ThresholdType_THRESHOLD_TYPE_FORECASTED,
+		})
+	}
+	return budget
+}
+
+// evaluate sets the status, triggered thresholds, overage and top
+// contributors of a budget from the resources in its scope.
+func evaluate(
+	ctx context.Context,
+	budget *pbc.Budget,
+	def Definition,
+	resources []Resource,
+	projections map[int]projection,
+	price PriceFunc,
+) {
+	var contributors []Contributor
+	total := 0.0
+	unpriced := 0
+	for i, resource := range resources {
+		if !def.Scope.matches(resource) {
+			continue
+		}
+		proj, ok := projections[i]
+		if !ok {
+			proj = project(ctx, resource, price)
+			projections[i] = proj
+		}
+		if proj.err != nil {
+			unpriced++
+			continue
+		}
+		amount := proj.cost
+		if def.Unit == UnitCarbon {
+			amount = proj.carbon
+		}
+		total += amount
+		contributors = append(contributors, Contributor{
+			Resource:     cmp.Or(resource.ID, resource.ResourceType+"/"+resource.SKU),
+			ResourceType: resource.ResourceType,
+			Amount:       amount,
+		})
+	}
+
+	percent := total / def.Limit * 100
+	budget.Status = &pbc.BudgetStatus{
+		ForecastedSpend:      total,
+		PercentageForecasted: percent,
+		Currency:             budget.GetAmount().GetCurrency(),
+		Health:               health(percent, def.Thresholds),
+	}
+	for _, threshold := range budget.GetThresholds() {
+		threshold.Triggered = percent >= threshold.GetPercentage()
+	}
+
+	budget.Metadata[MetadataKeyForecastedOverage] = strconv.FormatFloat(max(0, total-def.Limit), 'f', 2, 64)
+	budget.Metadata[MetadataKeyResources] = strconv.Itoa(len(contributors))
+	if unpriced > 0 {
+		budget.Metadata[MetadataKeyUnpricedResources] = strconv.Itoa(unpriced)
+	}
+	if encoded, err := json.Marshal(topContributors(contributors, total)); err == nil {
+		budget.Metadata[MetadataKeyTopContributors] = string(encoded)
+	}
+}
+
+// project prices a resource, recording the error when it cannot be priced.
+func project(ctx context.Context, resource Resource, price PriceFunc) projection {
+	resp, err := price(ctx, resource.Descriptor())
+	if err != nil {
+		return projection{err: err}
+	}
+	proj := projection{cost: resp.GetCostPerMonth()}
+	for _, metric := range resp.GetImpactMetrics() {
+		if metric.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+			proj.carbon = metric.GetValue()
+		}
+	}
+	return proj
+}
+
+// topContributors returns the largest contributors with their share of the total.
+func topContributors(contributors []Contributor, total float64) []Contributor {
+	slices.SortStableFunc(contributors, func(a, b Contributor) int {
+		return cmp.Compare(b.Amount, a.Amount)
+	})
+	top := contributors[:min(len(contributors), maxContributors)]
+	for i := range top {
+		if total > 0 {
+			top[i].Percent = roundTo2(top[i].Amount / total * 100)
+		}
+		top[i].Amount = roundTo2(top[i].Amount)
+	}
+	if top == nil {
+		top = []Contributor{}
+	}
+	return top
+}
+
+// health returns the health of a budget at the given forecasted percentage.
+func health(percent float64, thresholds []float64) pbc.BudgetHealthStatus {
+	switch {
+	case percent > 100:
+		return pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED
+	case percent >= criticalPercent:
+		return pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_CRITICAL
+	case len(thresholds) > 0 && percent >= thresholds[0]:
+		return pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING
+	default:
+		return pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK
+	}
+}
+
+// countHealth adds a budget's health to the summary.
+func countHealth(summary *pbc.BudgetSummary, health pbc.BudgetHealthStatus) {
+	switch health {
+	case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_EXCEEDED:
+		summary.BudgetsExceeded++
+	case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_CRITICAL:
+		summary.BudgetsCritical++
+	case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING:
+		summary.BudgetsWarning++
+	case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK:
+		summary.BudgetsOk++
+	case pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_UNSPECIFIED:
+	}
+}
+
+// roundTo2 rounds to two decimal places.
+func roundTo2(v float64) float64 {
+	return math.Round(v*100) / 100
+}
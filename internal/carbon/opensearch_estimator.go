@@ -0,0 +1,81 @@
+package carbon
+
+import "strings"
+
+// OpenSearchEstimator estimates carbon footprint for OpenSearch Service domains.
+type OpenSearchEstimator struct{}
+
+// NewOpenSearchEstimator creates a new OpenSearch carbon estimator.
+func NewOpenSearchEstimator() *OpenSearchEstimator {
+	return &OpenSearchEstimator{}
+}
+
+// resolveNodeCount returns the node count, defaulting to 1 if nodes <= 0.
+func (e *OpenSearchEstimator) resolveNodeCount(nodes int) int {
+	if nodes <= 0 {
+		return 1
+	}
+	return nodes
+}
+
+// EstimateCarbonGrams calculates the carbon footprint for an OpenSearch domain.
+//
+// OpenSearch carbon is calculated the same way as ElastiCache:
+//
+//	Compute carbon: EC2-equivalent carbon for each node type * number of nodes
+//
+// Dedicated master nodes are included when MasterNodeType is set. UltraWarm
+// nodes have no published EC2 equivalent and are not included.
+//
+// Returns (0, false) if the data or master node type is unknown.
+//
+// This method is thread-safe and can be called concurrently.
+func (e *OpenSearchEstimator) EstimateCarbonGrams(config OpenSearchConfig) (float64, bool) {
+	dataCarbon, ok := e.nodeCarbon(config.DataNodeType, config)
+	if !ok {
+		return 0, false
+	}
+	totalCarbon := dataCarbon * float64(e.resolveNodeCount(config.DataNodes))
+
+	if config.MasterNodeType != "" && config.MasterNodes > 0 {
+		masterCarbon, masterOK := e.nodeCarbon(config.MasterNodeType, config)
+		if !masterOK {
+			return 0, false
+		}
+		totalCarbon += masterCarbon * float64(config.MasterNodes)
+	}
+
+	return totalCarbon, true
+}
+
+// nodeCarbon returns the carbon for a single node of the given OpenSearch type.
+func (e *OpenSearchEstimator) nodeCarbon(nodeType string, config OpenSearchConfig) (float64, bool) {
+	// OpenSearch nodes are CPU-only, so GPU carbon is excluded explicitly.
+	ec2Estimator := NewEstimator()
+	ec2Estimator.IncludeGPU = false
+	return ec2Estimator.EstimateCarbonGrams(
+		opensearchToEC2InstanceType(nodeType),
+		config.Region,
+		config.Utilization,
+		config.Hours,
+	)
+}
+
+// GetBillingDetail returns a human-readable description of the carbon estimation.
+func (e *OpenSearchEstimator) GetBillingDetail(config OpenSearchConfig) string {
+	detail := "OpenSearch " + config.DataNodeType + ", " +
+		formatInt(e.resolveNodeCount(config.DataNodes)) + " data nodes"
+	if config.MasterNodeType != "" && config.MasterNodes > 0 {
+		detail += ", " + formatInt(config.MasterNodes) + " " + config.MasterNodeType + " masters"
+	}
+	return detail + ", " +
+		formatFloat(config.Hours) + " hrs, " +
+		formatInt(int(config.Utilization*100)) + "% utilization"
+}
+
+// opensearchToEC2InstanceType converts an OpenSearch node type to its EC2 equivalent
+// by removing the ".search" or legacy ".elasticsearch" suffix
+// (e.g., "r6g.large.search" -> "r6g.large").
+func opensearchToEC2InstanceType(nodeType string) string {
+	return strings.TrimSuffix(strings.TrimSuffix(nodeType, ".search"), ".elasticsearch")
+}
@@ -0,0 +1,74 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenSearchEstimator_EstimateCarbonGrams(t *testing.T) {
+	estimator := NewOpenSearchEstimator()
+
+	single, ok := estimator.EstimateCarbonGrams(OpenSearchConfig{
+		DataNodeType: "m5.large.search",
+		DataNodes:    1,
+		Region:       "us-east-1",
+		Utilization:  0.5,
+		Hours:        HoursPerMonth,
+	})
+	require.True(t, ok)
+	assert.Positive(t, single)
+
+	t.Run("data nodes scale linearly", func(t *testing.T) {
+		got, ok := estimator.EstimateCarbonGrams(OpenSearchConfig{
+			DataNodeType: "m5.large.search",
+			DataNodes:    3,
+			Region:       "us-east-1",
+			Utilization:  0.5,
+			Hours:        HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.InDelta(t, single*3, got, 1e-6)
+	})
+
+	t.Run("dedicated masters are added", func(t *testing.T) {
+		got, ok := estimator.EstimateCarbonGrams(OpenSearchConfig{
+			DataNodeType:   "m5.large.search",
+			DataNodes:      1,
+			MasterNodeType: "m5.large.search",
+			MasterNodes:    3,
+			Region:         "us-east-1",
+			Utilization:    0.5,
+			Hours:          HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.InDelta(t, single*4, got, 1e-6)
+	})
+
+	t.Run("legacy elasticsearch suffix", func(t *testing.T) {
+		got, ok := estimator.EstimateCarbonGrams(OpenSearchConfig{
+			DataNodeType: "m5.large.elasticsearch",
+			DataNodes:    1,
+			Region:       "us-east-1",
+			Utilization:  0.5,
+			Hours:        HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.InDelta(t, single, got, 1e-6)
+	})
+
+	t.Run("unknown data node type", func(t *testing.T) {
+		_, ok := estimator.EstimateCarbonGrams(OpenSearchConfig{
+			DataNodeType: "unknown.ultra.search",
+			Region:       "us-east-1",
+		})
+		assert.False(t, ok)
+	})
+}
+
+func TestOpensearchToEC2InstanceType(t *testing.T) {
+	assert.Equal(t, "r6g.large", opensearchToEC2InstanceType("r6g.large.search"))
+	assert.Equal(t, "m5.xlarge", opensearchToEC2InstanceType("m5.xlarge.elasticsearch"))
+	assert.Equal(t, "t3.small", opensearchToEC2InstanceType("t3.small"))
+}
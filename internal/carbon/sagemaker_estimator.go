@@ -0,0 +1,78 @@
+package carbon
+
+import "strings"
+
+// SageMakerEstimator estimates carbon footprint for SageMaker ML instances
+// (real-time endpoints and notebook instances).
+type SageMakerEstimator struct{}
+
+// NewSageMakerEstimator creates a new SageMaker carbon estimator.
+func NewSageMakerEstimator() *SageMakerEstimator {
+	return &SageMakerEstimator{}
+}
+
+// resolveInstanceCount returns the instance count, defaulting to 1 if count <= 0.
+func (e *SageMakerEstimator) resolveInstanceCount(count int) int {
+	if count <= 0 {
+		return 1
+	}
+	return count
+}
+
+// EstimateCarbonGrams calculates the carbon footprint for SageMaker ML instances.
+// It is the sum of the CPU and GPU carbon from EstimateCarbonGramsWithBreakdown.
+//
+// Returns (0, false) if the instance type has no EC2 equivalent in CCF data.
+//
+// This method is thread-safe and can be called concurrently.
+func (e *SageMakerEstimator) EstimateCarbonGrams(config SageMakerConfig) (float64, bool) {
+	cpuCarbon, gpuCarbon, ok := e.EstimateCarbonGramsWithBreakdown(config)
+	if !ok {
+		return 0, false
+	}
+	return cpuCarbon + gpuCarbon, true
+}
+
+// EstimateCarbonGramsWithBreakdown returns CPU and GPU carbon separately for
+// SageMaker ML instances.
+//
+// Unlike RDS and OpenSearch, SageMaker hosts GPU instance families (ml.g*, ml.p*),
+// so the EC2 estimator is used with GPU power included:
+//
+//	EC2-equivalent carbon (CPU + GPU TDP) for the instance type * instance count
+//
+// This method is thread-safe and can be called concurrently.
+func (e *SageMakerEstimator) EstimateCarbonGramsWithBreakdown(
+	config SageMakerConfig,
+) (float64, float64, bool) {
+	// Create a fresh EC2 estimator per call for thread-safety; GPU is included by default
+	ec2Estimator := NewEstimator()
+	cpuCarbon, gpuCarbon, ok := ec2Estimator.EstimateCarbonGramsWithBreakdown(
+		sagemakerToEC2InstanceType(config.InstanceType),
+		config.Region,
+		config.Utilization,
+		config.Hours,
+	)
+	if !ok {
+		return 0, 0, false
+	}
+
+	count := float64(e.resolveInstanceCount(config.InstanceCount))
+	return cpuCarbon * count, gpuCarbon * count, true
+}
+
+// GetBillingDetail returns a human-readable description of the carbon estimation.
+func (e *SageMakerEstimator) GetBillingDetail(config SageMakerConfig) string {
+	return "SageMaker " + config.InstanceType + ", " +
+		formatInt(e.resolveInstanceCount(config.InstanceCount)) + " instances, " +
+		formatFloat(config.Hours) + " hrs, " +
+		formatInt(int(config.Utilization*100)) + "% utilization"
+}
+
+// sagemakerToEC2InstanceType converts a SageMaker ML instance type to its EC2
+// equivalent by removing the "ml." prefix (e.g., "ml.g5.xlarge" -> "g5.xlarge").
+// SageMaker instance families run on the EC2 family of the same name, so the
+// GPU specs for the EC2 type apply unchanged.
+func sagemakerToEC2InstanceType(instanceType string) string {
+	return strings.TrimPrefix(strings.ToLower(instanceType), "ml.")
+}
@@ -0,0 +1,76 @@
+package carbon
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSageMakerEstimator_EstimateCarbonGramsWithBreakdown(t *testing.T) {
+	estimator := NewSageMakerEstimator()
+
+	t.Run("matches EC2 equivalent including GPU", func(t *testing.T) {
+		cpu, gpu, ok := estimator.EstimateCarbonGramsWithBreakdown(SageMakerConfig{
+			InstanceType:  "ml.g4dn.xlarge",
+			InstanceCount: 1,
+			Region:        "us-east-1",
+			Utilization:   0.5,
+			Hours:         HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.Positive(t, gpu)
+
+		wantCPU, wantGPU, ok := NewEstimator().EstimateCarbonGramsWithBreakdown(
+			"g4dn.xlarge", "us-east-1", 0.5, HoursPerMonth)
+		require.True(t, ok)
+		assert.InDelta(t, wantCPU, cpu, 1e-6)
+		assert.InDelta(t, wantGPU, gpu, 1e-6)
+	})
+
+	t.Run("CPU instance has no GPU carbon", func(t *testing.T) {
+		cpu, gpu, ok := estimator.EstimateCarbonGramsWithBreakdown(SageMakerConfig{
+			InstanceType: "ml.m5.large",
+			Region:       "us-east-1",
+			Utilization:  0.5,
+			Hours:        HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.Positive(t, cpu)
+		assert.Zero(t, gpu)
+	})
+
+	t.Run("instances scale linearly", func(t *testing.T) {
+		single, ok := estimator.EstimateCarbonGrams(SageMakerConfig{
+			InstanceType:  "ml.g5.xlarge",
+			InstanceCount: 1,
+			Region:        "us-east-1",
+			Utilization:   0.5,
+			Hours:         HoursPerMonth,
+		})
+		require.True(t, ok)
+		got, ok := estimator.EstimateCarbonGrams(SageMakerConfig{
+			InstanceType:  "ml.g5.xlarge",
+			InstanceCount: 4,
+			Region:        "us-east-1",
+			Utilization:   0.5,
+			Hours:         HoursPerMonth,
+		})
+		require.True(t, ok)
+		assert.InDelta(t, single*4, got, 1e-6)
+	})
+
+	t.Run("unknown instance type", func(t *testing.T) {
+		_, _, ok := estimator.EstimateCarbonGramsWithBreakdown(SageMakerConfig{
+			InstanceType: "ml.unknown.ultra",
+			Region:       "us-east-1",
+		})
+		assert.False(t, ok)
+	})
+}
+
+func TestSagemakerToEC2InstanceType(t *testing.T) {
+	assert.Equal(t, "g5.xlarge", sagemakerToEC2InstanceType("ml.g5.xlarge"))
+	assert.Equal(t, "p3.2xlarge", sagemakerToEC2InstanceType("ML.P3.2XLARGE"))
+	assert.Equal(t, "m5.large", sagemakerToEC2InstanceType("m5.large"))
+}
@@ -182,3 +182,45 @@ type ElastiCacheConfig struct {
 	// Hours is the operating hours.
 	Hours float64
 }
+
+// OpenSearchConfig contains configuration for OpenSearch Service domain carbon estimation.
+type OpenSearchConfig struct {
+	// DataNodeType is the OpenSearch data node type (e.g., "r6g.large.search").
+	DataNodeType string
+
+	// DataNodes is the number of data nodes in the domain.
+	DataNodes int
+
+	// MasterNodeType is the dedicated master node type (empty if none).
+	MasterNodeType string
+
+	// MasterNodes is the number of dedicated master nodes.
+	MasterNodes int
+
+	// Region is the AWS region.
+	Region string
+
+	// Utilization is the CPU utilization (0.0 to 1.0, default: 0.50).
+	Utilization float64
+
+	// Hours is the operating hours.
+	Hours float64
+}
+
+// SageMakerConfig contains configuration for SageMaker ML instance carbon estimation.
+type SageMakerConfig struct {
+	// InstanceType is the SageMaker ML instance type (e.g., "ml.g5.xlarge").
+	InstanceType string
+
+	// InstanceCount is the number of instances (endpoint variants scale horizontally).
+	InstanceCount int
+
+	// Region is the AWS region.
+	Region string
+
+	// Utilization is the CPU/GPU utilization (0.0 to 1.0, default: 0.50).
+	Utilization float64
+
+	// Hours is the operating hours.
+	Hours float64
+}
@@ -0,0 +1,221 @@
+// Package config loads and validates the plugin configuration file, a single
+// YAML or JSON document holding the settings otherwise read from FINFOCUS_*
+// environment variables, plus settings only available in the file such as
+// default usage assumptions. Environment variables that are set take
+// precedence over the file, so existing deployments behave unchanged.
+//
+// The file is validated at startup and may be watched for changes with Watch;
+// see schema.json for the accepted keys.
+package config
+
+import (
+	"bytes"
+	_ "embed"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+)
+
+// EnvConfigFile is the environment variable naming the configuration file.
+const EnvConfigFile = "FINFOCUS_CONFIG_FILE"
+
+// maxBatchSize is the protocol cap on recommendations batch size.
+const maxBatchSize = 100
+
+// Schema is the JSON Schema of the configuration file.
+//
+//go:embed schema.json
+var Schema []byte
+
+// Config is the configuration file. Unset fields fall back to the environment
+// variable of the same setting, then to the built-in default.
+type Config struct {
+	// TestMode enables verbose request logging (FINFOCUS_TEST_MODE).
+	TestMode *bool `yaml:"test_mode" json:"test_mode"`
+
+	Recommendations Recommendations `yaml:"recommendations" json:"recommendations"`
+	Pricing         Pricing         `yaml:"pricing" json:"pricing"`
+	Allocation      Allocation      `yaml:"allocation" json:"allocation"`
+	Budgets         Budgets         `yaml:"budgets" json:"budgets"`
+	Web             Web             `yaml:"web" json:"web"`
+	Carbon          Carbon          `yaml:"carbon" json:"carbon"`
+
+	// UsageDefaults are the usage assumed for resources missing usage tags,
+	// by service and usage tag, e.g. {"lambda": {"requests_per_month": 1000000}}.
+	UsageDefaults map[string]map[string]float64 `yaml:"usage_defaults" json:"usage_defaults"`
+}
+
+// Recommendations configures GetRecommendations.
+type Recommendations struct {
+	// MaxBatchSize is the maximum number of target resources (FINFOCUS_MAX_BATCH_SIZE).
+	MaxBatchSize *int `yaml:"max_batch_size" json:"max_batch_size"`
+
+	// StrictValidation fails on the first invalid resource (FINFOCUS_STRICT_VALIDATION).
+	StrictValidation *bool `yaml:"strict_validation" json:"strict_validation"`
+}
+
+// Pricing configures price data checks and account-level pricing.
+type Pricing struct {
+	// StaleAfterDays flags estimates as stale (FINFOCUS_PRICING_STALE_AFTER_DAYS).
+	StaleAfterDays *int `yaml:"stale_after_days" json:"stale_after_days"`
+
+	// MaxAgeDays is the hard price data age limit (FINFOCUS_PRICING_MAX_AGE_DAYS).
+	MaxAgeDays *int `yaml:"max_age_days" json:"max_age_days"`
+
+	// AccountFreeTier allocates the AWS Free Tier in BatchCost (FINFOCUS_ACCOUNT_FREE_TIER).
+	AccountFreeTier *bool `yaml:"account_free_tier" json:"account_free_tier"`
+}
+
+// Allocation configures cost allocation tags.
+type Allocation struct {
+	// Tags are the tag keys propagated for cost allocation (FINFOCUS_ALLOCATION_TAGS).
+	Tags []string `yaml:"tags" json:"tags"`
+}
+
+// Budgets locates the budget files of GetBudgets.
+type Budgets struct {
+	// File is the budgets file (FINFOCUS_BUDGETS_FILE).
+	File string `yaml:"file" json:"file"`
+
+	// ResourcesFile is the resources file (FINFOCUS_BUDGET_RESOURCES_FILE).
+	ResourcesFile string `yaml:"resources_file" json:"resources_file"`
+}
+
+// Files returns the budget files, with FINFOCUS_BUDGETS_FILE and
+// FINFOCUS_BUDGET_RESOURCES_FILE taking precedence.
+func (b Budgets) Files() budget.Files {
+	return budget.FilesFromEnv().Or(budget.Files{Budgets: b.File, Resources: b.ResourcesFile})
+}
+
+// Web configures the HTTP server. Changes take effect on restart.
+type Web struct {
+	// Enabled serves the HTTP API (FINFOCUS_PLUGIN_WEB_ENABLED).
+	Enabled *bool `yaml:"enabled" json:"enabled"`
+
+	// CORSAllowedOrigins are the allowed origins (FINFOCUS_CORS_ALLOWED_ORIGINS).
+	CORSAllowedOrigins []string `yaml:"cors_allowed_origins" json:"cors_allowed_origins"`
+
+	// CORSAllowCredentials allows credentials (FINFOCUS_CORS_ALLOW_CREDENTIALS).
+	CORSAllowCredentials *bool `yaml:"cors_allow_credentials" json:"cors_allow_credentials"`
+
+	// CORSMaxAge is the preflight cache duration in seconds (FINFOCUS_CORS_MAX_AGE).
+	CORSMaxAge *int `yaml:"cors_max_age" json:"cors_max_age"`
+
+	// HealthEndpoint serves /healthz (FINFOCUS_PLUGIN_HEALTH_ENDPOINT).
+	HealthEndpoint *bool `yaml:"health_endpoint" json:"health_endpoint"`
+}
+
+// Carbon configures carbon footprint estimation.
+type Carbon struct {
+	// Utilization is the CPU utilization (0-1] assumed when a request sets
+	// none; defaults to 0.5.
+	Utilization *float64 `yaml:"utilization" json:"utilization"`
+
+	// Embodied adds the amortized embodied carbon of the hardware to EC2
+	// carbon footprints.
+	Embodied *bool `yaml:"embodied" json:"embodied"`
+}
+
+// Load reads, parses and validates a configuration file.
+func Load(filename string) (*Config, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+	config, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid config file %s: %w", filename, err)
+	}
+	return config, nil
+}
+
+// LoadFromEnv loads the configuration file named by EnvConfigFile, returning
+// nil when it is unset.
+func LoadFromEnv() (*Config, error) {
+	filename := os.Getenv(EnvConfigFile)
+	if filename == "" {
+		return nil, nil //nolint:nilnil // no configuration file is not an error
+	}
+	return Load(filename)
+}
+
+// Parse parses and validates a configuration file in YAML or JSON. Unknown
+// keys are rejected, so misspelled settings are not silently ignored.
+func Parse(data []byte) (*Config, error) {
+	var config Config
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config: %w", err)
+	}
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// Validate checks every setting is within its range, reporting all invalid
+// settings by their key path.
+func (c *Config) Validate() error {
+	var errs []error
+	invalid := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: %s", key, fmt.Sprintf(format, args...)))
+	}
+
+	if n := c.Recommendations.MaxBatchSize; n != nil && (*n < 1 || *n > maxBatchSize) {
+		invalid("recommendations.max_batch_size", "must be from 1 to %d, got %d", maxBatchSize, *n)
+	}
+	if n := c.Pricing.StaleAfterDays; n != nil && *n < 0 {
+		invalid("pricing.stale_after_days", "must not be negative, got %d", *n)
+	}
+	if n := c.Pricing.MaxAgeDays; n != nil && *n < 0 {
+		invalid("pricing.max_age_days", "must not be negative, got %d", *n)
+	}
+	for i, tag := range c.Allocation.Tags {
+		if strings.TrimSpace(tag) == "" {
+			invalid(fmt.Sprintf("allocation.tags[%d]", i), "must not be empty")
+		}
+	}
+	if n := c.Web.CORSMaxAge; n != nil && *n < 0 {
+		invalid("web.cors_max_age", "must not be negative, got %d", *n)
+	}
+	if slices.Contains(c.Web.CORSAllowedOrigins, "*") && c.Web.CORSAllowCredentials != nil && *c.Web.CORSAllowCredentials {
+		invalid("web.cors_allow_credentials", "cannot be enabled with wildcard origin (*)")
+	}
+	if u := c.Carbon.Utilization; u != nil && (math.IsNaN(*u) || *u <= 0 || *u > 1) {
+		invalid("carbon.utilization", "must be above 0 and at most 1, got %g", *u)
+	}
+	for _, service := range sortedKeys(c.UsageDefaults) {
+		if strings.TrimSpace(service) == "" {
+			invalid("usage_defaults", "service must not be empty")
+		}
+		for _, tag := range sortedKeys(c.UsageDefaults[service]) {
+			value := c.UsageDefaults[service][tag]
+			if strings.TrimSpace(tag) == "" {
+				invalid("usage_defaults."+service, "usage tag must not be empty")
+			}
+			if math.IsNaN(value) || math.IsInf(value, 0) || value < 0 {
+				invalid("usage_defaults."+service+"."+tag, "must be a non-negative number, got %g", value)
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// sortedKeys returns the keys of a map in sorted order, for stable error order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
@@ -0,0 +1,208 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestParse verifies YAML and JSON configuration files parse to the same settings.
+func TestParse(t *testing.T) {
+	fromYAML, err := Parse([]byte(`
+test_mode: true
+recommendations:
+  max_batch_size: 25
+pricing:
+  stale_after_days: 30
+allocation:
+  tags: [cost-center, team]
+budgets:
+  file: /etc/finfocus/budgets.yaml
+web:
+  cors_allowed_origins: [https://app.example.com]
+carbon:
+  utilization: 0.3
+usage_defaults:
+  lambda:
+    requests_per_month: 1000000
+`))
+	require.NoError(t, err)
+	assert.True(t, *fromYAML.TestMode)
+	assert.Equal(t, 25, *fromYAML.Recommendations.MaxBatchSize)
+	assert.Nil(t, fromYAML.Recommendations.StrictValidation)
+	assert.Equal(t, 30, *fromYAML.Pricing.StaleAfterDays)
+	assert.Equal(t, []string{"cost-center", "team"}, fromYAML.Allocation.Tags)
+	assert.Equal(t, "/etc/finfocus/budgets.yaml", fromYAML.Budgets.File)
+	assert.Equal(t, []string{"https://app.example.com"}, fromYAML.Web.CORSAllowedOrigins)
+	assert.InDelta(t, 0.3, *fromYAML.Carbon.Utilization, 1e-9)
+	assert.InDelta(t, 1e6, fromYAML.UsageDefaults["lambda"]["requests_per_month"], 1e-9)
+
+	fromJSON, err := Parse([]byte(`{
+		"test_mode": true,
+		"recommendations": {"max_batch_size": 25},
+		"pricing": {"stale_after_days": 30},
+		"allocation": {"tags": ["cost-center", "team"]},
+		"budgets": {"file": "/etc/finfocus/budgets.yaml"},
+		"web": {"cors_allowed_origins": ["https://app.example.com"]},
+		"carbon": {"utilization": 0.3},
+		"usage_defaults": {"lambda": {"requests_per_month": 1000000}}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, fromYAML, fromJSON)
+
+	empty, err := Parse(nil)
+	require.NoError(t, err)
+	assert.Equal(t, &Config{}, empty)
+}
+
+// TestParse_Invalid verifies unknown keys and out-of-range settings are
+// rejected, with every invalid setting reported by its key path.
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse([]byte("pricing:\n  stale_after: 30\n"))
+	require.ErrorContains(t, err, "field stale_after not found")
+
+	_, err = Parse([]byte("recommendations: {max_batch_size: many}\n"))
+	require.Error(t, err)
+
+	_, err = Parse([]byte(`
+recommendations: {max_batch_size: 500}
+pricing: {stale_after_days: -1, max_age_days: -2}
+allocation: {tags: [team, " "]}
+web: {cors_allowed_origins: ["*"], cors_allow_credentials: true, cors_max_age: -1}
+carbon: {utilization: 1.5}
+usage_defaults: {lambda: {requests_per_month: -10}}
+`))
+	require.Error(t, err)
+	for _, key := range []string{
+		"recommendations.max_batch_size",
+		"pricing.stale_after_days",
+		"pricing.max_age_days",
+		"allocation.tags[1]",
+		"web.cors_allow_credentials",
+		"web.cors_max_age",
+		"carbon.utilization",
+		"usage_defaults.lambda.requests_per_month",
+	} {
+		assert.ErrorContains(t, err, key+":")
+	}
+}
+
+// TestLoad verifies the file name is reported with its errors.
+func TestLoad(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "finfocus.yaml")
+	_, err := Load(filename)
+	require.ErrorContains(t, err, "failed to read config file")
+
+	require.NoError(t, os.WriteFile(filename, []byte("carbon: {utilization: 0}\n"), 0o600))
+	_, err = Load(filename)
+	require.ErrorContains(t, err, filename)
+	require.ErrorContains(t, err, "carbon.utilization")
+}
+
+// TestSchema verifies the JSON Schema declares exactly the keys of Config, so
+// the two cannot drift apart.
+func TestSchema(t *testing.T) {
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(Schema, &schema))
+	assertSchemaMatches(t, "", schema, reflect.TypeFor[Config]())
+}
+
+// assertSchemaMatches compares the properties of a schema object with the
+// yaml keys of a struct type, recursing into nested structs.
+func assertSchemaMatches(t *testing.T, path string, schema map[string]any, typ reflect.Type) {
+	t.Helper()
+	properties, ok := schema["properties"].(map[string]any)
+	require.True(t, ok, "schema %q has no properties", path)
+	assert.Equal(t, false, schema["additionalProperties"], "schema %q allows unknown keys", path)
+
+	keys := make(map[string]bool, typ.NumField())
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		key, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		keys[key] = true
+		property, ok := properties[key].(map[string]any)
+		if !assert.True(t, ok, "schema %q missing key %q", path, key) {
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			assertSchemaMatches(t, path+"."+key, property, field.Type)
+		}
+	}
+	for key := range properties {
+		assert.True(t, keys[key], "schema %q declares unknown key %q", path, key)
+	}
+}
+
+// TestWatch verifies the file is applied once created and on every change,
+// and invalid versions are skipped until the file is fixed.
+func TestWatch(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "finfocus.yaml")
+	version := 0
+	write := func(data string) {
+		require.NoError(t, os.WriteFile(filename, []byte(data), 0o600))
+		// Move the modification time forward so coarse file system clocks
+		// still register the change.
+		version++
+		modTime := time.Now().Add(time.Duration(version) * time.Minute)
+		require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	}
+
+	var mu sync.Mutex
+	var applied []int
+	appliedSizes := func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int(nil), applied...)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		Watch(ctx, filename, 5*time.Millisecond, zerolog.Nop(), func(c *Config) error {
+			mu.Lock()
+			defer mu.Unlock()
+			size := 0
+			if c.Recommendations.MaxBatchSize != nil {
+				size = *c.Recommendations.MaxBatchSize
+			}
+			applied = append(applied, size)
+			return nil
+		})
+	}()
+	defer func() {
+		cancel()
+		<-done
+	}()
+
+	// The watcher may not have taken its first look at the file yet, so keep
+	// changing the file until a change is applied.
+	lastApplied := func() int {
+		sizes := appliedSizes()
+		if len(sizes) == 0 {
+			return 0
+		}
+		return sizes[len(sizes)-1]
+	}
+	require.Eventually(t, func() bool {
+		write("recommendations: {max_batch_size: 20}\n")
+		return lastApplied() == 20
+	}, time.Second, 20*time.Millisecond)
+
+	write("recommendations: {max_batch_size: 2000}\n")
+	require.Eventually(t, func() bool {
+		write("recommendations: {max_batch_size: 30}\n")
+		return lastApplied() == 30
+	}, time.Second, 20*time.Millisecond)
+	assert.NotContains(t, appliedSizes(), 2000)
+}
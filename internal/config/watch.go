@@ -0,0 +1,90 @@
+package config
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultWatchInterval is how often Watch checks the configuration file.
+const DefaultWatchInterval = 2 * time.Second
+
+// fileState identifies a version of the configuration file.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+// statFile returns the state of a file.
+func statFile(filename string) (fileState, error) {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return fileState{}, err
+	}
+	return fileState{modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+// Watch polls the configuration file every interval until ctx is done, and
+// calls apply with the new configuration whenever the file changes and has
+// settled. The file is polled rather than watched for events so that editors
+// replacing the file and Kubernetes ConfigMap symlink swaps are both picked up.
+//
+// A configuration that fails to parse, validate or apply is logged and
+// skipped, so the last valid configuration stays in effect until the file is
+// fixed.
+func Watch(
+	ctx context.Context,
+	filename string,
+	interval time.Duration,
+	logger zerolog.Logger,
+	apply func(*Config) error,
+) {
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+	logger = logger.With().Str("config_file", filename).Logger()
+
+	// seen is the state at the last poll; loaded is the state last loaded.
+	// A changed file is loaded once it is unchanged for a whole interval, so
+	// a file caught mid-write is not mistaken for an empty configuration.
+	seen, _ := statFile(filename)
+	loaded := seen
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		state, err := statFile(filename)
+		if err != nil {
+			if seen != (fileState{}) {
+				logger.Warn().Err(err).Msg("configuration file unavailable, keeping current configuration")
+			}
+			seen, loaded = fileState{}, fileState{}
+			continue
+		}
+		if state != seen {
+			seen = state
+			continue
+		}
+		if state == loaded {
+			continue
+		}
+		loaded = state
+
+		config, err := Load(filename)
+		if err == nil {
+			err = apply(config)
+		}
+		if err != nil {
+			logger.Error().Err(err).Msg("invalid configuration file, keeping current configuration")
+			continue
+		}
+		logger.Info().Msg("configuration file reloaded")
+	}
+}
@@ -226,6 +226,7 @@ func calculateRuntimeHours(from, to time.Time) (float64, error) {
 // The resolver parameter is optional. If provided, it's used to get the cached
 // service type. If nil, a new resolver is created internally (for backward compatibility).
 func (p *AWSPublicPlugin) getProjectedForResource(
+	settings *pluginSettings,
 	traceID string,
 	resource *pbc.ResourceDescriptor,
 	resolver *serviceResolver,
@@ -244,33 +245,8 @@ func (p *AWSPublicPlugin) getProjectedForResource(
 	// Route to appropriate estimator based on normalized resource type.
 	// For GetActualCost, we construct a minimal request with just the resource.
 	// This means UtilizationPercentage is 0, which falls through to default (50%).
-	switch serviceType {
-	case serviceEC2:
-		return p.estimateEC2(traceID, resource, &pbc.GetProjectedCostRequest{Resource: resource})
-	case serviceEBS:
-		return p.estimateEBS(traceID, resource)
-	case serviceEKS:
-		return p.estimateEKS(traceID, resource)
-	case serviceELB:
-		return p.estimateELB(traceID, resource)
-	case serviceNATGW:
-		return p.estimateNATGateway(traceID, resource)
-	case serviceCloudWatch:
-		return p.estimateCloudWatch(traceID, resource)
-	case serviceElastiCache:
-		return p.estimateElastiCache(traceID, resource)
-	case serviceS3:
-		return p.estimateS3(traceID, resource)
-	case serviceLambda:
-		return p.estimateLambda(traceID, resource)
-	case serviceRDS:
-		return p.estimateRDS(traceID, resource)
-	case serviceDynamoDB:
-		return p.estimateDynamoDB(traceID, resource)
-	case serviceVPC, serviceSecurityGroup, serviceSubnet, serviceIAM, serviceLaunchTmpl, serviceLaunchConfig:
-		// Zero-cost AWS networking, IAM, and configuration-only resources - no direct charges
-		return p.estimateZeroCostResource(traceID, resource, serviceType), nil
-	default:
+	estimator, ok := lookupEstimator(serviceType)
+	if !ok {
 		// Unknown resource type - return $0 with explanation
 		return &pbc.GetProjectedCostResponse{
 			CostPerMonth: 0,
@@ -282,6 +258,7 @@ func (p *AWSPublicPlugin) getProjectedForResource(
 			),
 		}, nil
 	}
+	return estimator.ProjectedCost(p, settings, traceID, resource, &pbc.GetProjectedCostRequest{Resource: resource})
 }
 
 // formatActualBillingDetail creates a human-readable billing detail string
@@ -289,3 +266,10 @@ func (p *AWSPublicPlugin) getProjectedForResource(
 func formatActualBillingDetail(projectedDetail string, runtimeHours float64, actualCost float64) string {
 	return fmt.Sprintf("Fallback estimate: %s × %.2f hours / 730 = $%.4f", projectedDetail, runtimeHours, actualCost)
 }
+
+// formatComponentBillingDetail explains the fallback calculation for one cost
+// component of an actual cost.
+func formatComponentBillingDetail(component CostComponent, runtimeHours float64, actualCost float64) string {
+	return fmt.Sprintf("Fallback estimate: %s %.4g %s × $%.6g × %.2f hours / 730 = $%.4f",
+		component.Dimension, component.Quantity, component.Unit, component.Rate, runtimeHours, actualCost)
+}
@@ -96,6 +96,10 @@ func (m *mockPricingClientActual) S3PricePerGBMonth(storageClass string) (float6
 	return price, ok
 }
 
+func (m *mockPricingClientActual) S3StorageTiers(_ string) ([]pricing.TierRate, bool) {
+	return nil, false
+}
+
 func (m *mockPricingClientActual) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
 	if m.rdsInstancePrices == nil {
 		return 0, false
@@ -164,6 +168,210 @@ func (m *mockPricingClientActual) ElastiCacheOnDemandPricePerHour(instanceType,
 	return 0.156, true // Default cache.m5.large pricing
 }
 
+func (m *mockPricingClientActual) SecretsManagerPricePerSecretMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SecretsManagerPricePerAPIRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) KMSPricePerKeyMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) KMSPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) OpenSearchInstancePricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) OpenSearchStoragePricePerGBMonth(volumeType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) OpenSearchServerlessOCUPricePerHour() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) RedshiftNodePricePerHour(nodeType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) RedshiftManagedStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) RedshiftServerlessPricePerRPUHour() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) ECRStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) BackupStoragePricePerGBMonth(_, _ string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) LambdaAddOnPrices(_ string) (*pricing.LambdaAddOnPrice, bool) {
+	return nil, false
+}
+
+func (m *mockPricingClientActual) StepFunctionsPricePerStateTransition() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) StepFunctionsExpressPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) StepFunctionsExpressDurationTiers() ([]pricing.TierRate, bool) {
+	return nil, false
+}
+
+func (m *mockPricingClientActual) EventBridgePricePerCustomEvent() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) EventBridgePipesPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) DocumentDBInstancePricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) DocumentDBStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) DocumentDBPricePerIORequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) DocumentDBBackupPricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) NeptuneInstancePricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) NeptuneStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) NeptunePricePerIORequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) NeptuneBackupPricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MemoryDBNodePricePerHour(nodeType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MemoryDBDataWrittenPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MemoryDBSnapshotPricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKBrokerPricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKThroughputPricePerMBpsMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKServerlessClusterPricePerHour() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKServerlessPartitionPricePerHour() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKServerlessDataInPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKServerlessDataOutPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) MSKServerlessStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SageMakerHostingPricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SageMakerNotebookPricePerHour(instanceType string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SageMakerNotebookStoragePricePerGBMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SageMakerServerlessPricePerGBSecond() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) SageMakerServerlessDataPricePerGB() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerWebACLMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerRuleMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) WAFPricePerRequest() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) ShieldAdvancedPricePerMonth() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) GlobalAcceleratorPricePerHour() (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) GlobalAcceleratorDTPremiumPricePerGB(source, destination string) (float64, bool) {
+	return 0, false
+}
+
+func (m *mockPricingClientActual) PriceVintages() []pricing.PriceVintage {
+	return nil
+}
+
+func (m *mockPricingClientActual) RateSource(_, _ string) (pricing.RateSource, bool) {
+	return pricing.RateSource{}, false
+}
+
+func (m *mockPricingClientActual) PriceListAges(_ time.Time) []pricing.PriceListAge {
+	return nil
+}
+
 func newTestPluginForActual() *AWSPublicPlugin {
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
 	return NewAWSPublicPlugin("us-east-1", "test-version", &mockPricingClientActual{
@@ -1570,10 +1778,14 @@ func TestGetActualCost_RoutingFix(t *testing.T) {
 		}
 		// Requests: 1M * $0.0000002 = $0.20
 		// Compute: (128/1024) * 0.1s * 1M * $0.0000166667 = $0.2083
-		// Total: $0.4083
+		// Total: $0.4083, one result per component
 		expected := 0.40833375
-		if diff := resp.GetResults()[0].GetCost() - expected; diff > 0.0001 || diff < -0.0001 {
-			t.Errorf("Lambda cost = %v, want %v", resp.GetResults()[0].GetCost(), expected)
+		var total float64
+		for _, result := range resp.GetResults() {
+			total += result.GetCost()
+		}
+		if diff := total - expected; diff > 0.0001 || diff < -0.0001 {
+			t.Errorf("Lambda cost = %v, want %v", total, expected)
 		}
 	})
 }
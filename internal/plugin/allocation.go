@@ -0,0 +1,157 @@
+package plugin
+
+import (
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// EnvAllocationTags is the environment variable listing, comma-separated, the
+// tag keys propagated to FOCUS records and projected cost metadata for cost
+// allocation. Keys are normalized, so "CostCenter" and "cost_center" both
+// select "cost-center". "none" disables propagation.
+const EnvAllocationTags = "FINFOCUS_ALLOCATION_TAGS"
+
+// defaultAllocationTags is the allow-list when EnvAllocationTags is unset.
+var defaultAllocationTags = []string{"cost-center", "team", "environment"}
+
+// Allocation markers.
+const (
+	// tagKeyAllocation flags a resource carrying none of the allow-listed tags.
+	tagKeyAllocation = "finfocus:allocation"
+
+	// allocationUnallocated is the tagKeyAllocation value of an untagged resource.
+	allocationUnallocated = "unallocated"
+
+	// metadataKeyAllocationTags is the allocation tags of a projected cost,
+	// as sorted comma-separated key=value pairs, e.g. "cost-center=cc-42,team=web",
+	// or "finfocus:allocation=unallocated".
+	metadataKeyAllocationTags = "allocation_tags"
+)
+
+// allocationPolicy is the allow-list of normalized tag keys propagated for
+// cost allocation. An empty allow-list disables propagation.
+type allocationPolicy struct {
+	keys []string
+}
+
+// parseAllocationPolicy reads the allow-list from the environment, falling
+// back to the configuration file, then to defaultAllocationTags when neither
+// lists any keys.
+func parseAllocationPolicy(logger zerolog.Logger, fromFile []string) allocationPolicy {
+	val := strings.TrimSpace(os.Getenv(EnvAllocationTags))
+	if val == "" {
+		val = strings.Join(fromFile, ",")
+	}
+	if strings.EqualFold(val, "none") {
+		return allocationPolicy{}
+	}
+	var keys []string
+	for key := range strings.SplitSeq(val, ",") {
+		if normalized := normalizeTagKey(key); normalized != "" && !slices.Contains(keys, normalized) {
+			keys = append(keys, normalized)
+		}
+	}
+	if len(keys) == 0 {
+		if val != "" {
+			logger.Warn().
+				Str("variable", EnvAllocationTags).
+				Str("value", val).
+				Msg("no allocation tag keys listed, using defaults")
+		}
+		keys = slices.Clone(defaultAllocationTags)
+	}
+	return allocationPolicy{keys: keys}
+}
+
+// normalizeTagKey returns the canonical form of a tag key: lowercase words
+// joined by hyphens, so "CostCenter", "costCenter", "cost_center", "Cost Center"
+// and "COST-CENTER" are all "cost-center". Namespace separators (":") are kept.
+func normalizeTagKey(key string) string {
+	var b strings.Builder
+	runes := []rune(strings.TrimSpace(key))
+	for i, r := range runes {
+		switch {
+		case r == '_' || r == '-' || r == '.' || unicode.IsSpace(r):
+			b.WriteByte('-')
+		case unicode.IsUpper(r):
+			// Split camel case words: "CostCenter", "costCenter", "HTTPServer"
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+				unicode.IsUpper(runes[i-1]) && i+1 < len(runes) && unicode.IsLower(runes[i+1])) {
+				b.WriteByte('-')
+			}
+			b.WriteRune(unicode.ToLower(r))
+		default:
+			b.WriteRune(r)
+		}
+	}
+	normalized := b.String()
+	for strings.Contains(normalized, "--") {
+		normalized = strings.ReplaceAll(normalized, "--", "-")
+	}
+	return strings.Trim(normalized, "-")
+}
+
+// tags returns the allow-listed tags of a resource under their normalized keys,
+// or the unallocated marker when it has none. Tags with empty values do not
+// allocate a resource. When several keys normalize to the same key, the first
+// in sorted order wins. Returns nil when propagation is disabled.
+func (a allocationPolicy) tags(resourceTags map[string]string) map[string]string {
+	if len(a.keys) == 0 {
+		return nil
+	}
+	allocated := make(map[string]string, len(a.keys))
+	for _, key := range slices.Sorted(maps.Keys(resourceTags)) {
+		value := strings.TrimSpace(resourceTags[key])
+		if value == "" {
+			continue
+		}
+		normalized := normalizeTagKey(key)
+		if _, seen := allocated[normalized]; !seen && slices.Contains(a.keys, normalized) {
+			allocated[normalized] = value
+		}
+	}
+	if len(allocated) == 0 {
+		return map[string]string{tagKeyAllocation: allocationUnallocated}
+	}
+	return allocated
+}
+
+// formatAllocationTags encodes allocation tags as sorted key=value pairs.
+func formatAllocationTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for _, key := range slices.Sorted(maps.Keys(tags)) {
+		pairs = append(pairs, key+"="+tags[key])
+	}
+	return strings.Join(pairs, ",")
+}
+
+// attachAllocationTags records the allocation tags of a resource, as allow-listed
+// by settings, in its projected cost metadata.
+func attachAllocationTags(
+	settings *pluginSettings,
+	resp *pbc.GetProjectedCostResponse,
+	resourceTags map[string]string,
+) {
+	tags := settings.allocation.tags(resourceTags)
+	if resp == nil || tags == nil {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 1)
+	}
+	resp.Metadata[metadataKeyAllocationTags] = formatAllocationTags(tags)
+}
+
+// withAllocationTags sets the FOCUS Tags of a record to the allocation tags.
+func withAllocationTags(record *pbc.FocusCostRecord, tags map[string]string) *pbc.FocusCostRecord {
+	if tags != nil {
+		record.Tags = maps.Clone(tags)
+	}
+	return record
+}
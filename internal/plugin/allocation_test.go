@@ -0,0 +1,111 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestNormalizeTagKey verifies tag key spellings normalize to one key.
+func TestNormalizeTagKey(t *testing.T) {
+	for key, want := range map[string]string{
+		"cost-center":    "cost-center",
+		"CostCenter":     "cost-center",
+		"costCenter":     "cost-center",
+		"cost_center":    "cost-center",
+		" Cost Center ":  "cost-center",
+		"COST-CENTER":    "cost-center",
+		"Cost__Center":   "cost-center",
+		"Environment":    "environment",
+		"HTTPServer":     "http-server",
+		"app:costCenter": "app:cost-center",
+		"team2Name":      "team2-name",
+		"":               "",
+		"_":              "",
+	} {
+		assert.Equal(t, want, normalizeTagKey(key), key)
+	}
+}
+
+// TestParseAllocationPolicy verifies the allow-list is read from the environment.
+func TestParseAllocationPolicy(t *testing.T) {
+	logger := zerolog.Nop()
+
+	t.Setenv(EnvAllocationTags, "")
+	assert.Equal(t, []string{"cost-center", "team", "environment"}, parseAllocationPolicy(logger, nil).keys)
+
+	t.Setenv(EnvAllocationTags, "CostCenter, owner,cost_center,,")
+	assert.Equal(t, []string{"cost-center", "owner"}, parseAllocationPolicy(logger, nil).keys)
+
+	t.Setenv(EnvAllocationTags, " , ")
+	assert.Equal(t, defaultAllocationTags, parseAllocationPolicy(logger, nil).keys)
+
+	t.Setenv(EnvAllocationTags, "None")
+	assert.Nil(t, parseAllocationPolicy(logger, nil).tags(map[string]string{"team": "web"}))
+}
+
+// TestAllocationPolicyTags verifies allow-listed tags are propagated under
+// normalized keys and untagged resources are marked unallocated.
+func TestAllocationPolicyTags(t *testing.T) {
+	policy := allocationPolicy{keys: defaultAllocationTags}
+
+	assert.Equal(t, map[string]string{"cost-center": "cc-42", "team": "web"}, policy.tags(map[string]string{
+		"CostCenter": "cc-42",
+		"Team":       " web ",
+		"size":       "100",
+		"Name":       "web-server",
+	}))
+	assert.Equal(t, map[string]string{"team": "data"}, policy.tags(map[string]string{
+		"Team": "data",
+		"team": "web",
+	}), "the first key in sorted order wins")
+
+	unallocated := map[string]string{tagKeyAllocation: allocationUnallocated}
+	assert.Equal(t, unallocated, policy.tags(nil))
+	assert.Equal(t, unallocated, policy.tags(map[string]string{"team": " ", "size": "100"}))
+}
+
+// TestAllocationTags_Propagated verifies allocation tags reach projected cost
+// metadata and every FOCUS record of an actual cost.
+func TestAllocationTags_Propagated(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	updateSettings(plugin, func(s *pluginSettings) { s.allocation = allocationPolicy{keys: defaultAllocationTags} })
+
+	projected, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "ec2",
+			Sku:          "t3.micro",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"cost_center": "cc-42", "Environment": "prod"},
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "cost-center=cc-42,environment=prod", projected.GetMetadata()[metadataKeyAllocationTags])
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	actual, err := plugin.GetActualCost(context.Background(), &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON(providerAWS, "aws:ebs/volume:Volume", "gp3", "us-east-1",
+			map[string]string{"size": "100"}),
+		Tags:  map[string]string{"Team": "storage"},
+		Start: timestamppb.New(from),
+		End:   timestamppb.New(from.Add(24 * time.Hour)),
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, actual.GetResults())
+	for _, result := range actual.GetResults() {
+		assert.Equal(t, map[string]string{"team": "storage"}, result.GetFocusRecord().GetTags())
+	}
+
+	untagged, err := plugin.GetActualCost(context.Background(), actualCostRequest("aws:ec2/instance:Instance",
+		"t3.micro", nil, 24))
+	require.NoError(t, err)
+	require.NotEmpty(t, untagged.GetResults())
+	assert.Equal(t, allocationUnallocated, untagged.GetResults()[0].GetFocusRecord().GetTags()[tagKeyAllocation])
+}
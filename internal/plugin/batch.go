@@ -0,0 +1,156 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Ensure AWSPublicPlugin implements the optional SDK batch interface.
+var _ pluginsdk.BatchCostHandler = (*AWSPublicPlugin)(nil)
+
+// BatchCost implements pluginsdk.BatchCostHandler. Each resource is priced the
+// same way as the single-resource RPC for the query type. Projected queries are
+// then treated as one account: tiered charges are re-priced on the batch totals
+// (see applyAggregateTiers) and, when FINFOCUS_ACCOUNT_FREE_TIER is enabled, the
+// AWS Free Tier allowances are allocated across the resources that consume them
+// (see applyAccountFreeTier).
+//
+// The SDK server validates the request and normalizes the query type before
+// calling this handler.
+func (p *AWSPublicPlugin) BatchCost(ctx context.Context, req *pbc.BatchCostRequest) (*pbc.BatchCostResponse, error) {
+	settings := p.config()
+	results := make([]*pbc.ResourceCostResult, len(req.GetResources()))
+	for i, resource := range req.GetResources() {
+		results[i] = p.batchCostForResource(ctx, settings, req, resource)
+	}
+
+	if !req.GetDryRun() && req.GetQueryType() == pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED {
+		traceID := p.getTraceID(ctx)
+		p.applyAggregateTiers(traceID, results)
+		if settings.accountFreeTier {
+			p.applyAccountFreeTier(traceID, results)
+		}
+	}
+
+	return pluginsdk.NewBatchCostResponse(pluginsdk.WithBatchResults(results)), nil
+}
+
+// batchCostForResource prices one resource of a batch for the requested query type.
+func (p *AWSPublicPlugin) batchCostForResource(
+	ctx context.Context,
+	settings *pluginSettings,
+	req *pbc.BatchCostRequest,
+	resource *pbc.ResourceDescriptor,
+) *pbc.ResourceCostResult {
+	if resource == nil {
+		return batchErrorResult(resource, status.Error(codes.InvalidArgument, "resource is required"))
+	}
+	if err := ctx.Err(); err != nil {
+		return batchErrorResult(resource, err)
+	}
+
+	var data *pbc.CostData
+	switch {
+	case req.GetDryRun():
+		resp, err := p.HandleDryRun(ctx, &pbc.DryRunRequest{Resource: resource})
+		if err != nil {
+			return batchErrorResult(resource, err)
+		}
+		data = &pbc.CostData{Data: &pbc.CostData_DryRunResult{DryRunResult: resp}}
+	case req.GetQueryType() == pbc.CostQueryType_COST_QUERY_TYPE_ACTUAL:
+		resp, err := p.GetActualCost(ctx, &pbc.GetActualCostRequest{
+			ResourceId: batchActualResourceID(resource),
+			Start:      req.GetStart(),
+			End:        req.GetEnd(),
+			Tags:       resource.GetTags(),
+			Arn:        resource.GetArn(),
+		})
+		if err != nil {
+			return batchErrorResult(resource, err)
+		}
+		data = &pbc.CostData{Data: &pbc.CostData_ActualCost{ActualCost: &pbc.ActualCostData{
+			Results:       resp.GetResults(),
+			FallbackHint:  resp.GetFallbackHint(),
+			NextPageToken: resp.GetNextPageToken(),
+			TotalCount:    resp.GetTotalCount(),
+		}}}
+	case req.GetQueryType() == pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED:
+		resp, err := p.projectedCost(ctx, settings, &pbc.GetProjectedCostRequest{Resource: resource})
+		if err != nil {
+			return batchErrorResult(resource, err)
+		}
+		data = &pbc.CostData{Data: &pbc.CostData_ProjectedCost{ProjectedCost: resp}}
+	default:
+		resp, err := p.EstimateCost(ctx, &pbc.EstimateCostRequest{ResourceType: resource.GetResourceType()})
+		if err != nil {
+			return batchErrorResult(resource, err)
+		}
+		data = &pbc.CostData{Data: &pbc.CostData_Estimate{Estimate: resp}}
+	}
+
+	return &pbc.ResourceCostResult{
+		Resource: cloneDescriptor(resource),
+		Result:   &pbc.ResourceCostResult_CostData{CostData: data},
+	}
+}
+
+// batchProjections returns the projected cost of each batch result, nil for
+// failed results, and the canonical service of each resource.
+func batchProjections(results []*pbc.ResourceCostResult) ([]*pbc.GetProjectedCostResponse, []string) {
+	responses := make([]*pbc.GetProjectedCostResponse, len(results))
+	services := make([]string, len(results))
+	for i, result := range results {
+		responses[i] = result.GetCostData().GetProjectedCost()
+		if responses[i] == nil {
+			continue
+		}
+		services[i] = detectService(result.GetResource().GetResourceType())
+		if canonical, ok := serviceAliases[services[i]]; ok {
+			services[i] = canonical
+		}
+	}
+	return responses, services
+}
+
+// batchActualResourceID identifies a batch resource for GetActualCost, preferring
+// its ID, then its ARN, then its resource type.
+func batchActualResourceID(resource *pbc.ResourceDescriptor) string {
+	switch {
+	case resource.GetId() != "":
+		return resource.GetId()
+	case resource.GetArn() != "":
+		return resource.GetArn()
+	default:
+		return resource.GetResourceType()
+	}
+}
+
+// batchErrorResult reports a per-resource failure with the gRPC code of err.
+func batchErrorResult(resource *pbc.ResourceDescriptor, err error) *pbc.ResourceCostResult {
+	var st *status.Status
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		st = status.FromContextError(err)
+	} else {
+		st = status.Convert(err)
+	}
+	return &pbc.ResourceCostResult{
+		Resource: cloneDescriptor(resource),
+		Result: &pbc.ResourceCostResult_Error{Error: pluginsdk.NewResourceError(
+			st.Code(), st.Message(), st.Code() == codes.Unimplemented)},
+	}
+}
+
+// cloneDescriptor returns a deep copy of resource, or nil.
+func cloneDescriptor(resource *pbc.ResourceDescriptor) *pbc.ResourceDescriptor {
+	if resource == nil {
+		return nil
+	}
+	cloned, _ := proto.Clone(resource).(*pbc.ResourceDescriptor)
+	return cloned
+}
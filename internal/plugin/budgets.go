@@ -0,0 +1,47 @@
+package plugin
+
+import (
+	"context"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+)
+
+// GetBudgets evaluates the budgets declared in FINFOCUS_BUDGETS_FILE against
+// the projected costs of the resources in FINFOCUS_BUDGET_RESOURCES_FILE.
+// Resources outside this plugin's region cannot be priced and are reported as
+// unpriced; the router evaluates budgets spanning regions. Returns no budgets
+// when no budgets file is configured, and FailedPrecondition when the files
+// cannot be read or are invalid.
+func (p *AWSPublicPlugin) GetBudgets(ctx context.Context, req *pbc.GetBudgetsRequest) (*pbc.GetBudgetsResponse, error) {
+	traceID := p.getTraceID(ctx)
+	settings := p.config()
+	config, resources, err := settings.budgetFiles.Load()
+	if err != nil {
+		return nil, p.newErrorWithID(traceID, codes.FailedPrecondition, err.Error(),
+			pbc.ErrorCode_ERROR_CODE_PLUGIN_NOT_CONFIGURED)
+	}
+
+	resp := budget.Evaluate(ctx, config, resources, req, func(ctx context.Context,
+		resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+		priced, priceErr := p.projectedCost(ctx, settings, &pbc.GetProjectedCostRequest{Resource: resource})
+		if priceErr != nil {
+			p.traceLogger(traceID, "GetBudgets").Debug().
+				Err(priceErr).
+				Str("resource_type", resource.GetResourceType()).
+				Str("aws_region", resource.GetRegion()).
+				Msg("unable to price budget resource")
+		}
+		return priced, priceErr
+	})
+
+	summary := resp.GetSummary()
+	p.traceLogger(traceID, "GetBudgets").Info().
+		Int32("total_budgets", summary.GetTotalBudgets()).
+		Int32("budgets_exceeded", summary.GetBudgetsExceeded()).
+		Int("resources", len(resources)).
+		Msg("budgets evaluated")
+	return resp, nil
+}
@@ -0,0 +1,86 @@
+package plugin
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+)
+
+// writeBudgetFiles writes a budgets file and a resources file and configures
+// the plugin's GetBudgets to read them.
+func writeBudgetFiles(t *testing.T, plugin *AWSPublicPlugin, budgets, resources string) {
+	t.Helper()
+	dir := t.TempDir()
+	files := budget.Files{
+		Budgets:   filepath.Join(dir, "budgets.yaml"),
+		Resources: filepath.Join(dir, "resources.json"),
+	}
+	require.NoError(t, os.WriteFile(files.Budgets, []byte(budgets), 0o600))
+	require.NoError(t, os.WriteFile(files.Resources, []byte(resources), 0o600))
+	updateSettings(plugin, func(s *pluginSettings) { s.budgetFiles = files })
+}
+
+// TestGetBudgets verifies declared budgets are evaluated against the
+// projected costs of the supplied resources.
+func TestGetBudgets(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	writeBudgetFiles(t, plugin, `
+budgets:
+  - id: web
+    limit: 9
+    scope:
+      tags: {team: web}
+  - id: storage
+    limit: 100
+    scope:
+      services: [ebs]
+`, `{"resources": [
+	{"id": "urn:pulumi:prod::shop::aws:ec2/instance:Instance::web",
+	 "resource_type": "aws:ec2/instance:Instance", "sku": "t3.micro", "region": "us-east-1",
+	 "tags": {"team": "web"}},
+	{"id": "web-db", "resource_type": "aws:ec2/instance:Instance", "sku": "t3.micro",
+	 "region": "eu-west-1", "tags": {"team": "web"}},
+	{"id": "data", "resource_type": "ebs", "sku": "gp3", "region": "us-east-1",
+	 "tags": {"size": "100"}}
+]}`)
+
+	resp, err := plugin.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{IncludeStatus: true})
+	require.NoError(t, err)
+	require.Len(t, resp.GetBudgets(), 2)
+
+	// 730 hours of t3.micro; the eu-west-1 instance is outside the plugin's region
+	web := resp.GetBudgets()[0]
+	assert.InDelta(t, 730*0.0104, web.GetStatus().GetForecastedSpend(), 1e-9)
+	assert.Equal(t, pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_WARNING, web.GetStatus().GetHealth())
+	assert.Equal(t, "1", web.GetMetadata()[budget.MetadataKeyUnpricedResources])
+	assert.Contains(t, web.GetMetadata()[budget.MetadataKeyTopContributors], "urn:pulumi:prod::shop")
+
+	storage := resp.GetBudgets()[1]
+	assert.InDelta(t, 100*0.08, storage.GetStatus().GetForecastedSpend(), 1e-9)
+	assert.Equal(t, pbc.BudgetHealthStatus_BUDGET_HEALTH_STATUS_OK, storage.GetStatus().GetHealth())
+	assert.Equal(t, int32(2), resp.GetSummary().GetTotalBudgets())
+}
+
+// TestGetBudgets_NotConfigured verifies no budgets are returned without a
+// budgets file, and invalid files are reported.
+func TestGetBudgets_NotConfigured(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	resp, err := plugin.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{IncludeStatus: true})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetBudgets())
+
+	writeBudgetFiles(t, plugin, `{"budgets": [{"id": "web", "limit": -1}]}`, `{}`)
+	_, err = plugin.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+}
@@ -7,7 +7,8 @@ type ServiceClassification struct {
 	// GrowthType specifies the cost growth pattern for forecasting
 	GrowthType pbc.GrowthType
 
-	// AffectedByDevMode indicates if dev mode multipliers affect this service
+	// AffectedByDevMode indicates the service is billed by running hours, so a
+	// usage schedule ("schedule" or "hours_per_month" tag) reduces its cost
 	AffectedByDevMode bool
 
 	// ParentTagKeys defines priority order for extracting parent resource identifiers
@@ -39,6 +40,20 @@ var serviceClassifications = map[string]ServiceClassification{
 		AffectedByDevMode: true, // Cluster hours
 		ParentTagKeys:     nil,
 	},
+	"aws:eks:nodegroup": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: true, // Node instance hours
+		ParentTagKeys:     []string{"cluster_name", "clusterName"},
+		ParentType:        "aws:eks:cluster:Cluster",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:eks:fargateprofile": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Pods are billed while running, not by the profile
+		ParentTagKeys:     []string{"cluster_name", "clusterName"},
+		ParentType:        "aws:eks:cluster:Cluster",
+		Relationship:      RelationshipWithin,
+	},
 	"aws:s3:bucket": {
 		GrowthType:        pbc.GrowthType_GROWTH_TYPE_LINEAR,
 		AffectedByDevMode: false, // Storage is not time-based
@@ -80,6 +95,115 @@ var serviceClassifications = map[string]ServiceClassification{
 		ParentType:        "aws:ec2:vpc:Vpc",
 		Relationship:      RelationshipWithin,
 	},
+	"aws:secretsmanager:secret": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Flat monthly fee per secret
+		ParentTagKeys:     nil,
+	},
+	"aws:kms:key": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Flat monthly fee per key
+		ParentTagKeys:     nil,
+	},
+	"aws:opensearch:domain": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Domains cannot be stopped
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:redshift:cluster": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: true, // Node hours
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:ecr:repository": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_LINEAR,
+		AffectedByDevMode: false, // Image storage accumulates
+		ParentTagKeys:     nil,
+	},
+	"aws:backup:vault": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_LINEAR,
+		AffectedByDevMode: false, // Recovery points accumulate
+		ParentTagKeys:     nil,
+	},
+	"aws:sfn:statemachine": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Usage-based
+		ParentTagKeys:     nil,
+	},
+	"aws:eventbridge:eventbus": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Usage-based
+		ParentTagKeys:     nil,
+	},
+	"aws:eventbridge:pipe": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Usage-based
+		ParentTagKeys:     nil,
+	},
+	"aws:docdb:cluster": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: true, // Instance hours
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:neptune:cluster": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: true, // Instance hours
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:memorydb:cluster": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Clusters cannot be stopped
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:msk:cluster": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Brokers cannot be stopped
+		ParentTagKeys:     []string{"vpc_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:sagemaker:endpoint": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Endpoints cannot be stopped
+		ParentTagKeys:     nil,
+	},
+	"aws:sagemaker:notebookinstance": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: true, // Instance hours
+		ParentTagKeys:     []string{"vpc_id", "subnet_id"},
+		ParentType:        "aws:ec2:vpc:Vpc",
+		Relationship:      RelationshipWithin,
+	},
+	"aws:sagemaker:serverlessendpoint": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Usage-based
+		ParentTagKeys:     nil,
+	},
+	"aws:wafv2:webacl": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Monthly web ACL and rule fees
+		ParentTagKeys:     nil,
+	},
+	"aws:shield:subscription": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Monthly subscription fee
+		ParentTagKeys:     nil,
+	},
+	"aws:globalaccelerator:accelerator": {
+		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
+		AffectedByDevMode: false, // Billed while provisioned, even when disabled
+		ParentTagKeys:     nil,
+	},
 	"aws:rds:instance": {
 		GrowthType:        pbc.GrowthType_GROWTH_TYPE_NONE,
 		AffectedByDevMode: true, // Instance hours
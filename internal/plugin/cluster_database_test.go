@@ -0,0 +1,315 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_ClusterDatabase verifies DocumentDB and Neptune instance-hours
+// plus storage, I/O and backup beyond the free allowance.
+func TestGetProjectedCost_ClusterDatabase(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "DocumentDB defaults",
+			resourceType: "aws:docdb/cluster:Cluster",
+			sku:          "db.r6g.large",
+			tags:         nil,
+			wantCost:     0.249 * 730,
+			wantDetail:   "DocumentDB db.r6g.large, 1 instance",
+			wantDefaults: "instance_count=1,storage_gb=0,io_requests_per_month=0",
+		},
+		{
+			name:         "DocumentDB storage, I/O and backup within allowance",
+			resourceType: "aws:docdb/cluster:Cluster",
+			sku:          "db.r6g.large",
+			tags: map[string]string{
+				"instance_count":        "3",
+				"storage_gb":            "100",
+				"io_requests_per_month": "10000000",
+				"backup_storage_gb":     "80",
+			},
+			wantCost:   0.249*3*730 + 100*0.10 + 10000000*0.0000002,
+			wantDetail: "3 instances",
+		},
+		{
+			name:         "Neptune backup beyond cluster size",
+			resourceType: "aws:neptune/cluster:Cluster",
+			sku:          "db.r5.large",
+			tags: map[string]string{
+				"storage_gb":            "100",
+				"io_requests_per_month": "0",
+				"backup_storage_gb":     "250",
+			},
+			wantCost:     0.348*730 + 100*0.10 + 150*0.021,
+			wantDetail:   "150GB backup storage beyond the free allowance",
+			wantDefaults: "instance_count=1",
+		},
+		{
+			name:         "Neptune cluster instance",
+			resourceType: "aws:neptune/clusterInstance:ClusterInstance",
+			sku:          "db.r6g.large",
+			tags:         map[string]string{"storage_gb": "500"},
+			wantCost:     0.313 * 730,
+			wantDetail:   "storage billed on the cluster",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("missing instance class is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:docdb/cluster:Cluster",
+				Sku:          "",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"storage_gb": "10"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetProjectedCost_MemoryDB verifies node pricing across shards and replicas plus
+// data written and snapshot storage.
+func TestGetProjectedCost_MemoryDB(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+	}{
+		{
+			name:         "defaults to one shard with one replica",
+			tags:         nil,
+			wantCost:     0.309 * 2 * 730,
+			wantDefaults: "num_shards=1,num_replicas_per_shard=1,data_written_gb_per_month=0",
+		},
+		{
+			name: "two shards, two replicas, writes and snapshots",
+			tags: map[string]string{
+				"num_shards":                "2",
+				"num_replicas_per_shard":    "2",
+				"data_written_gb_per_month": "100",
+				"snapshot_storage_gb":       "50",
+			},
+			wantCost: 0.309*6*730 + 100*0.20 + 50*0.021,
+		},
+		{
+			name:         "no replicas",
+			tags:         map[string]string{"num_replicas_per_shard": "0", "data_written_gb_per_month": "0"},
+			wantCost:     0.309 * 730,
+			wantDefaults: "num_shards=1",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:memorydb/cluster:Cluster",
+					Sku:          "db.r6g.large",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.InDelta(t, 0.309, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "MemoryDB db.r6g.large")
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("too many replicas is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:memorydb/cluster:Cluster",
+				Sku:          "db.r6g.large",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"num_replicas_per_shard": "6"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestDetectService_ClusterDatabase verifies Pulumi resource types route to the
+// DocumentDB, Neptune and MemoryDB estimators.
+func TestDetectService_ClusterDatabase(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"aws:docdb/cluster:Cluster", serviceDocDB},
+		{"aws:docdb/clusterInstance:ClusterInstance", serviceDocDB},
+		{"docdb", serviceDocDB},
+		{"aws:neptune/cluster:Cluster", serviceNeptune},
+		{"aws:neptune/clusterInstance:ClusterInstance", serviceNeptune},
+		{"neptune", serviceNeptune},
+		{"aws:memorydb/cluster:Cluster", serviceMemoryDB},
+		{"memorydb", serviceMemoryDB},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectService(normalizeResourceType(tt.resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_ClusterDatabaseConfiguration verifies subnet, parameter and
+// user resources are not priced as clusters.
+func TestGetProjectedCost_ClusterDatabaseConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:docdb/subnetGroup:SubnetGroup",
+		"aws:docdb/clusterParameterGroup:ClusterParameterGroup",
+		"aws:neptune/parameterGroup:ParameterGroup",
+		"aws:neptune/subnetGroup:SubnetGroup",
+		"aws:memorydb/subnetGroup:SubnetGroup",
+		"aws:memorydb/user:User",
+	)
+}
+
+// TestGetPricingSpec_ClusterDatabase verifies hourly specs for all three services.
+func TestGetPricingSpec_ClusterDatabase(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+	}{
+		{"aws:docdb/cluster:Cluster", "db.r6g.large", 0.249},
+		{"aws:neptune/cluster:Cluster", "db.r5.large", 0.348},
+		{"aws:memorydb/cluster:Cluster", "db.r6g.large", 0.309},
+		{"aws:docdb/cluster:Cluster", "db.x9.large", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.sku, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "per_hour", resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, "hour", resp.GetSpec().GetUnit())
+		})
+	}
+}
+
+// TestGetRecommendations_ClusterDatabase verifies generation and Graviton
+// recommendations are made only for classes the service prices at the same or lower cost.
+func TestGetRecommendations_ClusterDatabase(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		want         map[string]string // modification type → recommended class
+		wantSavings  float64           // total savings across recommendations
+	}{
+		{
+			name:         "DocumentDB r5 has no r6i, so only Graviton",
+			resourceType: "aws:docdb/cluster:Cluster",
+			sku:          "db.r5.large",
+			tags:         map[string]string{"instance_count": "2"},
+			want:         map[string]string{modTypeGraviton: "db.r6g.large"},
+			wantSavings:  (0.277 - 0.249) * 2 * 730,
+		},
+		{
+			name:         "DocumentDB r4 to r5 at the same price",
+			resourceType: "aws:docdb/cluster:Cluster",
+			sku:          "db.r4.large",
+			want:         map[string]string{modTypeGenUpgrade: "db.r5.large"},
+			wantSavings:  0,
+		},
+		{
+			name:         "Neptune r5 gets both",
+			resourceType: "aws:neptune/cluster:Cluster",
+			sku:          "db.r5.large",
+			want:         map[string]string{modTypeGenUpgrade: "db.r6i.large", modTypeGraviton: "db.r6g.large"},
+			wantSavings:  (0.348 - 0.313) * 730,
+		},
+		{
+			name:         "MemoryDB r7g costs more than r6g",
+			resourceType: "aws:memorydb/cluster:Cluster",
+			sku:          "db.r6g.large",
+			want:         map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{
+					{
+						Provider:     "aws",
+						ResourceType: tt.resourceType,
+						Sku:          tt.sku,
+						Region:       "us-east-1",
+						Tags:         tt.tags,
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.GetRecommendations(), len(tt.want))
+
+			var savings float64
+			for _, rec := range resp.GetRecommendations() {
+				modify := rec.GetModify()
+				wantClass, ok := tt.want[modify.GetModificationType()]
+				require.True(t, ok, "unexpected modification type %s", modify.GetModificationType())
+				assert.Equal(t, wantClass, modify.GetRecommendedConfig()["instance_class"])
+				savings += rec.GetImpact().GetEstimatedSavings()
+			}
+			assert.InDelta(t, tt.wantSavings, savings, 1e-6)
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package plugin
+
+import (
+	"encoding/json"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// metadataKeyCostComponents is the metadata key holding the JSON-encoded cost
+// component breakdown of a projected cost.
+const metadataKeyCostComponents = "cost_components"
+
+// CostComponent is one priced charge within a monthly estimate, such as the
+// instance-hours or the storage of an RDS instance. Subtotal is Quantity × Rate;
+// the subtotals of a response sum to its CostPerMonth.
+type CostComponent struct {
+	// Dimension names the charge (e.g., "instance", "storage", "lcu").
+	Dimension string `json:"dimension"`
+
+	// Quantity is the monthly billed quantity in Unit.
+	Quantity float64 `json:"quantity"`
+
+	// Unit is the pricing unit of Quantity and Rate (e.g., "Hours", "GB-Mo").
+	Unit string `json:"unit"`
+
+	// Rate is the public on-demand price per Unit in USD.
+	Rate float64 `json:"rate"`
+
+	// Subtotal is the monthly cost of the component in USD.
+	Subtotal float64 `json:"subtotal"`
+
+	// SKU identifies the price list entry the rate was looked up by
+	// (e.g., "t3.micro/Linux/Shared", "gp3").
+	SKU string `json:"sku,omitempty"`
+
+	// PriceSource traces the rate to the AWS price list. Filled in after the
+	// estimator runs, from the service's offer codes.
+	PriceSource
+}
+
+// CostBreakdown accumulates the cost components of one estimate, in the order the
+// estimator priced them.
+type CostBreakdown struct {
+	components []CostComponent
+}
+
+// Add records a component priced at quantity × rate and returns its subtotal, so
+// estimators can sum the return values into CostPerMonth.
+func (b *CostBreakdown) Add(dimension string, quantity float64, unit string, rate float64, sku string) float64 {
+	subtotal := quantity * rate
+	b.components = append(b.components, CostComponent{
+		Dimension: dimension,
+		Quantity:  quantity,
+		Unit:      unit,
+		Rate:      rate,
+		Subtotal:  subtotal,
+		SKU:       sku,
+	})
+	return subtotal
+}
+
+// AddSubtotal records a component whose subtotal is not a single quantity × rate,
+// such as a tiered charge. Rate is reported as the effective average rate.
+func (b *CostBreakdown) AddSubtotal(dimension string, quantity float64, unit string, subtotal float64, sku string) float64 {
+	rate := 0.0
+	if quantity > 0 {
+		rate = subtotal / quantity
+	}
+	b.components = append(b.components, CostComponent{
+		Dimension: dimension,
+		Quantity:  quantity,
+		Unit:      unit,
+		Rate:      rate,
+		Subtotal:  subtotal,
+		SKU:       sku,
+	})
+	return subtotal
+}
+
+// Scale multiplies every component's quantity and subtotal by factor, for
+// estimators that price one unit and multiply by a count (e.g., nodes).
+func (b *CostBreakdown) Scale(factor float64) {
+	for i := range b.components {
+		b.components[i].Quantity *= factor
+		b.components[i].Subtotal *= factor
+	}
+}
+
+// Append adds the components of other after those already recorded.
+func (b *CostBreakdown) Append(other *CostBreakdown) {
+	b.components = append(b.components, other.components...)
+}
+
+// Components returns the recorded components.
+func (b *CostBreakdown) Components() []CostComponent {
+	return b.components
+}
+
+// attachCostComponents stores the breakdown in the response metadata. Components
+// with a zero subtotal are kept so the breakdown shows every priced dimension.
+// Nothing is stored for an empty breakdown.
+func attachCostComponents(resp *pbc.GetProjectedCostResponse, b *CostBreakdown) {
+	setCostComponents(resp, b.components)
+}
+
+// setCostComponents replaces the cost components in the response metadata.
+// Nothing is stored for an empty list.
+func setCostComponents(resp *pbc.GetProjectedCostResponse, components []CostComponent) {
+	if resp == nil || len(components) == 0 {
+		return
+	}
+	encoded, err := json.Marshal(components)
+	if err != nil {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string)
+	}
+	resp.Metadata[metadataKeyCostComponents] = string(encoded)
+}
+
+// ensureCostComponents attaches a single component covering the whole cost when
+// the estimator did not report a breakdown. Responses with no cost are left
+// without components.
+func ensureCostComponents(resp *pbc.GetProjectedCostResponse, unit, sku string) {
+	if resp == nil || resp.GetCostPerMonth() <= 0 {
+		return
+	}
+	if _, ok := resp.GetMetadata()[metadataKeyCostComponents]; ok {
+		return
+	}
+	var b CostBreakdown
+	if rate := resp.GetUnitPrice(); rate > 0 {
+		b.AddSubtotal("usage", resp.GetCostPerMonth()/rate, unit, resp.GetCostPerMonth(), sku)
+	} else {
+		b.AddSubtotal("usage", 1, "Month", resp.GetCostPerMonth(), sku)
+	}
+	attachCostComponents(resp, &b)
+}
+
+// costComponentsFromMetadata decodes the breakdown stored by attachCostComponents.
+// Returns nil if none is present or it cannot be decoded.
+func costComponentsFromMetadata(metadata map[string]string) []CostComponent {
+	encoded, ok := metadata[metadataKeyCostComponents]
+	if !ok {
+		return nil
+	}
+	var components []CostComponent
+	if err := json.Unmarshal([]byte(encoded), &components); err != nil {
+		return nil
+	}
+	return components
+}
@@ -0,0 +1,237 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// TestCostBreakdown verifies component accumulation, scaling and appending.
+func TestCostBreakdown(t *testing.T) {
+	var b CostBreakdown
+	assert.InDelta(t, 7.592, b.Add("instance", 730, "Hours", 0.0104, "t3.micro/Linux/Shared"), 1e-9)
+	assert.InDelta(t, 3.0, b.AddSubtotal("ingestion", 6, "GB", 3.0, "logs-ingestion"), 1e-9)
+	assert.InDelta(t, 0.0, b.AddSubtotal("metrics", 0, "Metrics", 0, "metrics"), 1e-9)
+
+	components := b.Components()
+	require.Len(t, components, 3)
+	assert.InDelta(t, 0.5, components[1].Rate, 1e-9, "AddSubtotal reports the average rate")
+	assert.Zero(t, components[2].Rate, "zero quantity must not divide by zero")
+
+	var addOns CostBreakdown
+	addOns.Add("storage", 8, "GB-Mo", 0.10, "gp2")
+	b.Append(&addOns)
+	b.Scale(2)
+
+	components = b.Components()
+	require.Len(t, components, 4)
+	assert.InDelta(t, 1460, components[0].Quantity, 1e-9)
+	assert.InDelta(t, 15.184, components[0].Subtotal, 1e-9)
+	assert.InDelta(t, 0.0104, components[0].Rate, 1e-9, "scaling keeps the rate")
+	assert.Equal(t, "storage", components[3].Dimension)
+	assert.InDelta(t, 1.6, components[3].Subtotal, 1e-9)
+}
+
+// TestAttachCostComponents verifies the metadata encoding round-trips.
+func TestAttachCostComponents(t *testing.T) {
+	t.Run("empty breakdown stores nothing", func(t *testing.T) {
+		resp := &pbc.GetProjectedCostResponse{CostPerMonth: 1}
+		attachCostComponents(resp, &CostBreakdown{})
+		assert.Nil(t, resp.GetMetadata())
+	})
+
+	t.Run("round trip alongside existing metadata", func(t *testing.T) {
+		resp := &pbc.GetProjectedCostResponse{
+			Metadata: map[string]string{metadataKeyEstimateQuality: qualityMedium},
+		}
+		var b CostBreakdown
+		b.Add("hourly", 730, "Hours", 0.0225, "alb")
+		b.Add("lcu", 730, "LCU-Hours", 0.008, "")
+		attachCostComponents(resp, &b)
+
+		assert.Equal(t, qualityMedium, resp.GetMetadata()[metadataKeyEstimateQuality])
+		assert.Equal(t, b.Components(), costComponentsFromMetadata(resp.GetMetadata()))
+		assert.NotContains(t, resp.GetMetadata()[metadataKeyCostComponents], `"sku":""`,
+			"empty SKUs are omitted")
+	})
+
+	t.Run("invalid metadata decodes to nil", func(t *testing.T) {
+		assert.Nil(t, costComponentsFromMetadata(map[string]string{metadataKeyCostComponents: "{"}))
+		assert.Nil(t, costComponentsFromMetadata(nil))
+	})
+}
+
+// TestEnsureCostComponents verifies the single-component fallback.
+func TestEnsureCostComponents(t *testing.T) {
+	tests := []struct {
+		name         string
+		resp         *pbc.GetProjectedCostResponse
+		wantQuantity float64
+		wantUnit     string
+	}{
+		{
+			name:         "derives quantity from unit price",
+			resp:         &pbc.GetProjectedCostResponse{CostPerMonth: 73, UnitPrice: 0.1},
+			wantQuantity: 730,
+			wantUnit:     "Hours",
+		},
+		{
+			name:         "no unit price bills one month",
+			resp:         &pbc.GetProjectedCostResponse{CostPerMonth: 5},
+			wantQuantity: 1,
+			wantUnit:     "Month",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ensureCostComponents(tt.resp, "Hours", "sku")
+			components := costComponentsFromMetadata(tt.resp.GetMetadata())
+			require.Len(t, components, 1)
+			assert.Equal(t, "usage", components[0].Dimension)
+			assert.InDelta(t, tt.wantQuantity, components[0].Quantity, 1e-9)
+			assert.Equal(t, tt.wantUnit, components[0].Unit)
+			assert.InDelta(t, tt.resp.GetCostPerMonth(), components[0].Subtotal, 1e-9)
+		})
+	}
+
+	t.Run("zero cost has no components", func(t *testing.T) {
+		resp := &pbc.GetProjectedCostResponse{}
+		ensureCostComponents(resp, "Hours", "sku")
+		assert.Nil(t, resp.GetMetadata())
+	})
+
+	t.Run("estimator breakdown is kept", func(t *testing.T) {
+		resp := &pbc.GetProjectedCostResponse{CostPerMonth: 2}
+		var b CostBreakdown
+		b.Add("a", 1, "Units", 1, "")
+		b.Add("b", 1, "Units", 1, "")
+		attachCostComponents(resp, &b)
+		ensureCostComponents(resp, "Hours", "sku")
+		assert.Len(t, costComponentsFromMetadata(resp.GetMetadata()), 2)
+	})
+}
+
+// TestProjectedCostComponents verifies multi-charge estimators report one component
+// per charge and that the subtotals sum to CostPerMonth.
+func TestProjectedCostComponents(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		resourceType   string
+		sku            string
+		tags           map[string]string
+		wantDimensions []string
+	}{
+		{
+			name:           "RDS instance and storage",
+			resourceType:   "aws:rds/instance:Instance",
+			sku:            "db.t3.micro",
+			tags:           map[string]string{"storage_size": "100"},
+			wantDimensions: []string{"instance", "storage"},
+		},
+		{
+			name:           "ALB hourly and LCU",
+			resourceType:   "aws:lb/loadBalancer:LoadBalancer",
+			sku:            "alb",
+			wantDimensions: []string{"hourly", "lcu"},
+		},
+		{
+			name:           "CloudWatch ingestion and storage",
+			resourceType:   "aws:cloudwatch/logGroup:LogGroup",
+			sku:            "logs",
+			tags:           map[string]string{"log_ingestion_gb": "10", "log_storage_gb": "50"},
+			wantDimensions: []string{"log_ingestion", "log_storage"},
+		},
+		{
+			name:           "Secrets Manager secret and API calls",
+			resourceType:   "aws:secretsmanager/secret:Secret",
+			sku:            "secret",
+			tags:           map[string]string{"api_calls_per_month": "100000"},
+			wantDimensions: []string{"secret", "api_calls"},
+		},
+		{
+			name:           "OpenSearch data nodes and storage",
+			resourceType:   "aws:opensearch/domain:Domain",
+			sku:            "r6g.large.search",
+			tags:           map[string]string{"instance_count": "2", "volume_size": "100"},
+			wantDimensions: []string{"data_nodes", "storage"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     providerAWS,
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+
+			components := costComponentsFromMetadata(resp.GetMetadata())
+			dimensions := make([]string, 0, len(components))
+			var sum float64
+			for _, component := range components {
+				dimensions = append(dimensions, component.Dimension)
+				assert.InDelta(t, component.Quantity*component.Rate, component.Subtotal, 1e-9)
+				sum += component.Subtotal
+			}
+			assert.Equal(t, tt.wantDimensions, dimensions)
+			assert.InDelta(t, resp.GetCostPerMonth(), sum, 1e-9)
+		})
+	}
+}
+
+// TestGetActualCost_CostComponents verifies GetActualCost returns one prorated
+// FOCUS record per cost component.
+func TestGetActualCost_CostComponents(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(73 * time.Hour) // one tenth of a month
+
+	resp, err := plugin.GetActualCost(ctx, &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON("aws", "aws:rds/instance:Instance", "db.t3.micro", "us-east-1",
+			map[string]string{"storage_size": "100"}),
+		Start: timestamppb.New(from),
+		End:   timestamppb.New(to),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 2)
+
+	instance := resp.GetResults()[0]
+	assert.InDelta(t, 0.017*73, instance.GetCost(), 1e-9)
+	assert.InDelta(t, 73, instance.GetUsageAmount(), 1e-9)
+	assert.Equal(t, "Hours", instance.GetUsageUnit())
+	assert.Contains(t, instance.GetSource(), "instance")
+
+	record := instance.GetFocusRecord()
+	assert.Equal(t, "instance", record.GetSkuMeter())
+	assert.Equal(t, "db.t3.micro/MySQL", record.GetSkuPriceId())
+	assert.Equal(t, "db.t3.micro", record.GetSkuId())
+	assert.InDelta(t, 0.017, record.GetListUnitPrice(), 1e-9)
+	assert.Equal(t, "Hours", record.GetPricingUnit())
+	assert.InDelta(t, 73, record.GetPricingQuantity(), 1e-9)
+	assert.InDelta(t, 73, record.GetConsumedQuantity(), 1e-9)
+	assert.Equal(t, "Hours", record.GetConsumedUnit())
+	assert.InDelta(t, instance.GetCost(), record.GetBilledCost(), 1e-9)
+	assert.Contains(t, record.GetChargeDescription(), "(instance)")
+
+	storage := resp.GetResults()[1]
+	assert.InDelta(t, 100*0.115*0.1, storage.GetCost(), 1e-9)
+	assert.InDelta(t, 10, storage.GetUsageAmount(), 1e-9)
+	assert.Equal(t, "GB-Mo", storage.GetUsageUnit())
+	assert.Equal(t, "storage", storage.GetFocusRecord().GetSkuMeter())
+	assert.Equal(t, "GB-Mo", storage.GetFocusRecord().GetPricingUnit())
+}
@@ -19,9 +19,26 @@ const (
 	serviceCloudWatch   = "cloudwatch"
 	serviceElastiCache  = "elasticache"
 	serviceNATGW        = "natgw"
+	serviceSecrets      = "secretsmanager"
+	serviceKMS          = "kms"
+	serviceOpenSearch   = "opensearch"
+	serviceRedshift     = "redshift"
+	serviceECR          = "ecr"
+	serviceBackup       = "backup"
+	serviceStepFuncs    = "sfn"
+	serviceEventBridge  = "eventbridge"
+	serviceDocDB        = "docdb"
+	serviceNeptune      = "neptune"
+	serviceMemoryDB     = "memorydb"
+	serviceMSK          = "msk"
+	serviceSageMaker    = "sagemaker"
+	serviceWAF          = "waf"
+	serviceShield       = "shield"
+	serviceGlobalAccel  = "globalaccelerator"
 	serviceIAM          = "iam"
 	serviceLaunchTmpl   = "launchtemplate"
 	serviceLaunchConfig = "launchconfiguration"
+	serviceConfigOnly   = "configuration"
 )
 
 // Default values for EC2 attributes.
@@ -91,7 +108,7 @@ const RelationshipManagedBy = "managed_by"
 // Use IsZeroCostService() for membership checks.
 //
 // When adding new zero-cost resources:
-// 1. Add the canonical service name here
+// 1. Add the canonical service name here and register it with zeroCostEstimator in serviceRegistry
 // 2. Add the Pulumi pattern to ZeroCostPulumiPatterns below, OR implement dedicated prefix matching in normalizeResourceType().
 var ZeroCostServices = map[string]bool{
 	serviceVPC:           true,
@@ -100,6 +117,7 @@ var ZeroCostServices = map[string]bool{
 	serviceIAM:           true,
 	serviceLaunchTmpl:    true,
 	serviceLaunchConfig:  true,
+	serviceConfigOnly:    true,
 }
 
 // ZeroCostPulumiPatterns maps Pulumi resource type path segments to canonical service names.
@@ -113,6 +131,35 @@ var ZeroCostPulumiPatterns = map[string]string{
 	"ec2/launchconfiguration": serviceLaunchConfig,
 }
 
+// BilledPulumiResources lists, by Pulumi module, the resource type path
+// segments that carry the module's charges. Any other resource of a listed
+// module (an alias, policy, version or association) configures a billed
+// resource and is routed to serviceConfigOnly by normalizeResourceType(), so it
+// is not priced as the resource it configures.
+var BilledPulumiResources = map[string][]string{
+	"secretsmanager": {"secretsmanager/secret"},
+	"kms":            {"kms/key", "kms/externalkey", "kms/replicakey", "kms/replicaexternalkey"},
+	"opensearch":     {"opensearch/domain", "opensearch/serverlesscollection"},
+	"elasticsearch":  {"elasticsearch/domain"},
+
+	"opensearchserverless": {"opensearchserverless/collection"},
+	"redshift":             {"redshift/cluster"},
+	"redshiftserverless":   {"redshiftserverless/workgroup"},
+	"ecr":                  {"ecr/repository"},
+	"backup":               {"backup/vault"},
+	"sfn":                  {"sfn/statemachine"},
+	"docdb":                {"docdb/cluster", "docdb/clusterinstance"},
+	"neptune":              {"neptune/cluster", "neptune/clusterinstance"},
+	"memorydb":             {"memorydb/cluster"},
+	"msk":                  {"msk/cluster", "msk/serverlesscluster"},
+	"sagemaker":            {"sagemaker/endpoint", "sagemaker/notebookinstance"},
+	"wafv2":                {"wafv2/webacl"},
+	"wafregional":          {"wafregional/webacl"},
+	"waf":                  {"waf/webacl"},
+	"shield":               {"shield/subscription"},
+	"globalaccelerator":    {"globalaccelerator/accelerator", "globalaccelerator/customroutingaccelerator"},
+}
+
 // IsZeroCostService returns true if the canonical service name has no direct AWS charges.
 func IsZeroCostService(service string) bool {
 	return ZeroCostServices[service]
@@ -0,0 +1,439 @@
+package plugin
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// CostDiffRequest describes a change to one resource, given either as the
+// ResourceDescriptors before and after the change (Old, New) or as the Pulumi
+// resource type with its old and new inputs (ResourceType, OldInputs, NewInputs).
+// A missing side prices as $0, describing a resource being created or deleted.
+type CostDiffRequest struct {
+	Old *pbc.ResourceDescriptor
+	New *pbc.ResourceDescriptor
+
+	ResourceType string
+	OldInputs    *structpb.Struct
+	NewInputs    *structpb.Struct
+}
+
+// CostDiff is the monthly cost of a resource before and after a change.
+type CostDiff struct {
+	Old   CostDiffSide `json:"old"`
+	New   CostDiffSide `json:"new"`
+	Delta float64      `json:"delta"` // New.CostPerMonth - Old.CostPerMonth
+
+	// InputChanges lists the SKU and tags, or the Pulumi inputs, that differ between the two states.
+	InputChanges []InputChange `json:"input_changes,omitempty"`
+
+	// Changes lists the cost components whose SKU, quantity or cost changed,
+	// explaining Delta.
+	Changes []ComponentChange `json:"changes,omitempty"`
+}
+
+// CostDiffSide is the estimate of one state of the resource.
+type CostDiffSide struct {
+	Present         bool            `json:"present"`
+	CostPerMonth    float64         `json:"cost_per_month"`
+	BillingDetail   string          `json:"billing_detail,omitempty"`
+	DefaultsApplied string          `json:"defaults_applied,omitempty"`
+	EstimateQuality string          `json:"estimate_quality,omitempty"`
+	Components      []CostComponent `json:"cost_components,omitempty"`
+}
+
+// InputChange is a SKU, tag or Pulumi input value that differs between the two states.
+// Old or New is empty when the value is only set on one side.
+type InputChange struct {
+	Field string `json:"field"`
+	Old   string `json:"old,omitempty"`
+	New   string `json:"new,omitempty"`
+}
+
+// ComponentChange is the change of one cost component dimension, such as the
+// instance type of "instance" or the size of "storage".
+type ComponentChange struct {
+	Dimension   string  `json:"dimension"`
+	Unit        string  `json:"unit,omitempty"`
+	OldSKU      string  `json:"old_sku,omitempty"`
+	NewSKU      string  `json:"new_sku,omitempty"`
+	OldQuantity float64 `json:"old_quantity"`
+	NewQuantity float64 `json:"new_quantity"`
+	CostDelta   float64 `json:"cost_delta"`
+
+	// Summary describes the change, e.g. "instance: m5.large → m6i.large (+$12.41/mo)"
+	// or "storage: +100 GB-Mo (+$8.00/mo)".
+	Summary string `json:"summary"`
+}
+
+// ParseCostDiffRequest decodes a JSON cost diff request:
+//
+//	{"old": <ResourceDescriptor>, "new": <ResourceDescriptor>}
+//	{"resource_type": "aws:ec2/instance:Instance", "old_inputs": {...}, "new_inputs": {...}}
+//
+// Descriptors use the protobuf JSON mapping, e.g. {"resource_type": "...", "sku": "...", "tags": {...}}.
+func ParseCostDiffRequest(data []byte) (*CostDiffRequest, error) {
+	var raw struct {
+		Old          json.RawMessage `json:"old"`
+		New          json.RawMessage `json:"new"`
+		ResourceType string          `json:"resource_type"`
+		OldInputs    json.RawMessage `json:"old_inputs"`
+		NewInputs    json.RawMessage `json:"new_inputs"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("invalid cost diff request: %w", err)
+	}
+
+	req := &CostDiffRequest{ResourceType: raw.ResourceType}
+	for _, field := range []struct {
+		name string
+		raw  json.RawMessage
+		dst  **pbc.ResourceDescriptor
+	}{{"old", raw.Old, &req.Old}, {"new", raw.New, &req.New}} {
+		if isJSONNull(field.raw) {
+			continue
+		}
+		resource := &pbc.ResourceDescriptor{}
+		if err := protojson.Unmarshal(field.raw, resource); err != nil {
+			return nil, fmt.Errorf("invalid %q resource: %w", field.name, err)
+		}
+		*field.dst = resource
+	}
+	for _, field := range []struct {
+		name string
+		raw  json.RawMessage
+		dst  **structpb.Struct
+	}{{"old_inputs", raw.OldInputs, &req.OldInputs}, {"new_inputs", raw.NewInputs, &req.NewInputs}} {
+		if isJSONNull(field.raw) {
+			continue
+		}
+		inputs := &structpb.Struct{}
+		if err := protojson.Unmarshal(field.raw, inputs); err != nil {
+			return nil, fmt.Errorf("invalid %q: %w", field.name, err)
+		}
+		*field.dst = inputs
+	}
+	return req, nil
+}
+
+// isJSONNull reports whether a raw JSON value is absent or null.
+func isJSONNull(raw json.RawMessage) bool {
+	return len(raw) == 0 || string(raw) == "null"
+}
+
+// diffState is one state of the resource being compared. Resources mapped from
+// Pulumi inputs keep their resolver, inputs and the defaults applied while mapping.
+type diffState struct {
+	resource *pbc.ResourceDescriptor
+	resolver *serviceResolver
+	inputs   *structpb.Struct
+	defaults DefaultsTracker
+}
+
+// DiffCost prices a resource before and after a change and explains the
+// difference by the inputs and cost components that changed. Each side is
+// priced as GetProjectedCost (descriptors) or EstimateCost (Pulumi inputs)
+// would price it, and carries its own defaults_applied and estimate_quality,
+// so a sparse old state priced on defaults can be told apart from a real change.
+func (p *AWSPublicPlugin) DiffCost(ctx context.Context, req *CostDiffRequest) (*CostDiff, error) {
+	traceID := p.getTraceID(ctx)
+	if req == nil {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument, "missing request",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	oldState, newState := diffState{resource: req.Old}, diffState{resource: req.New}
+	if req.ResourceType != "" {
+		if req.Old != nil || req.New != nil {
+			return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+				"set either the old and new resources or the resource type with its inputs, not both",
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		var err error
+		if oldState, err = p.diffInputsState(traceID, req.ResourceType, req.OldInputs); err != nil {
+			return nil, err
+		}
+		if newState, err = p.diffInputsState(traceID, req.ResourceType, req.NewInputs); err != nil {
+			return nil, err
+		}
+	}
+	if oldState.resource == nil && newState.resource == nil {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument, "no old or new resource state to compare",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	settings := p.config()
+	oldSide, err := p.diffSide(ctx, settings, traceID, &oldState)
+	if err != nil {
+		return nil, err
+	}
+	newSide, err := p.diffSide(ctx, settings, traceID, &newState)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &CostDiff{
+		Old:     oldSide,
+		New:     newSide,
+		Delta:   newSide.CostPerMonth - oldSide.CostPerMonth,
+		Changes: componentChanges(oldSide.Components, newSide.Components),
+	}
+	if req.ResourceType != "" {
+		diff.InputChanges = pulumiInputChanges(req.OldInputs, req.NewInputs)
+	} else {
+		diff.InputChanges = descriptorChanges(req.Old, req.New)
+	}
+
+	p.traceLogger(traceID, "DiffCost").Info().
+		Str("resource_type", cmp.Or(req.ResourceType, req.New.GetResourceType(), req.Old.GetResourceType())).
+		Float64("old_cost_monthly", oldSide.CostPerMonth).
+		Float64("new_cost_monthly", newSide.CostPerMonth).
+		Int("changes", len(diff.Changes)).
+		Msg("cost diff computed")
+
+	return diff, nil
+}
+
+// diffInputsState maps Pulumi inputs onto a ResourceDescriptor the way
+// EstimateCost does. Nil inputs mean the resource does not exist in that state.
+func (p *AWSPublicPlugin) diffInputsState(traceID, resourceType string, inputs *structpb.Struct) (diffState, error) {
+	if inputs == nil {
+		return diffState{}, nil
+	}
+	info, err := parsePulumiResourceType(resourceType)
+	if err != nil {
+		return diffState{}, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid resource_type format: %v", err), pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	if info.provider != providerAWS {
+		return diffState{}, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("unsupported provider %q", info.provider), pbc.ErrorCode_ERROR_CODE_INVALID_PROVIDER)
+	}
+	region := attributesRegion(inputs, p.region)
+	if region != p.region {
+		return diffState{}, p.RegionMismatchError(traceID, region)
+	}
+	resolver := newServiceResolver(resourceType)
+	resource, defaults := resourceFromAttributes(resourceType, region, resolver.ServiceType(), inputs)
+	return diffState{resource: resource, resolver: resolver, inputs: inputs, defaults: defaults}, nil
+}
+
+// diffSide prices one state of the resource. A missing resource is not present
+// and costs $0.
+func (p *AWSPublicPlugin) diffSide(
+	ctx context.Context,
+	settings *pluginSettings,
+	traceID string,
+	state *diffState,
+) (CostDiffSide, error) {
+	if state.resource == nil {
+		return CostDiffSide{}, nil
+	}
+
+	var resp *pbc.GetProjectedCostResponse
+	var err error
+	if state.resolver != nil {
+		resp, err = p.getProjectedForResource(settings, traceID, state.resource, state.resolver)
+	} else {
+		resp, err = p.projectedCost(ctx, settings, &pbc.GetProjectedCostRequest{Resource: state.resource})
+	}
+	if err != nil {
+		var pue *PricingUnavailableError
+		if !errors.As(err, &pue) {
+			return CostDiffSide{}, err
+		}
+		resp = &pbc.GetProjectedCostResponse{Currency: "USD", BillingDetail: pue.BillingDetail}
+	}
+
+	md := estimateCostMetadata(&state.defaults, resp.GetMetadata())
+	return CostDiffSide{
+		Present:         true,
+		CostPerMonth:    resp.GetCostPerMonth(),
+		BillingDetail:   resp.GetBillingDetail(),
+		DefaultsApplied: md[metadataKeyDefaultsApplied],
+		EstimateQuality: cmp.Or(md[metadataKeyEstimateQuality], qualityHigh),
+		Components:      costComponentsFromMetadata(resp.GetMetadata()),
+	}, nil
+}
+
+// descriptorChanges lists the resource type, SKU and tags that differ between
+// two descriptors. Nothing is listed when a side is missing.
+func descriptorChanges(oldResource, newResource *pbc.ResourceDescriptor) []InputChange {
+	if oldResource == nil || newResource == nil {
+		return nil
+	}
+	var changes []InputChange
+	if oldResource.GetResourceType() != newResource.GetResourceType() {
+		changes = append(changes, InputChange{
+			Field: "resource_type", Old: oldResource.GetResourceType(), New: newResource.GetResourceType(),
+		})
+	}
+	if oldResource.GetSku() != newResource.GetSku() {
+		changes = append(changes, InputChange{Field: "sku", Old: oldResource.GetSku(), New: newResource.GetSku()})
+	}
+	return append(changes, valueChanges(oldResource.GetTags(), newResource.GetTags())...)
+}
+
+// pulumiInputChanges lists the Pulumi inputs that differ between two states,
+// with nested inputs named by their dotted path, e.g. "rootBlockDevice.volumeSize".
+// Nothing is listed when a side is missing.
+func pulumiInputChanges(oldInputs, newInputs *structpb.Struct) []InputChange {
+	if oldInputs == nil || newInputs == nil {
+		return nil
+	}
+	oldValues, newValues := make(map[string]string), make(map[string]string)
+	flattenInputs("", oldInputs, oldValues)
+	flattenInputs("", newInputs, newValues)
+	return valueChanges(oldValues, newValues)
+}
+
+// flattenInputs renders the leaf values of inputs into values keyed by their
+// dotted path.
+func flattenInputs(prefix string, inputs *structpb.Struct, values map[string]string) {
+	for key, value := range inputs.GetFields() {
+		if nested := value.GetStructValue(); nested != nil {
+			flattenInputs(prefix+key+".", nested, values)
+			continue
+		}
+		if rendered := renderAttribute(value); rendered != "" {
+			values[prefix+key] = rendered
+		}
+	}
+}
+
+// valueChanges lists the keys whose values differ between two maps, sorted by key.
+func valueChanges(oldValues, newValues map[string]string) []InputChange {
+	keys := make([]string, 0, len(oldValues)+len(newValues))
+	for key := range oldValues {
+		keys = append(keys, key)
+	}
+	for key := range newValues {
+		if _, ok := oldValues[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var changes []InputChange
+	for _, key := range keys {
+		if oldValues[key] != newValues[key] {
+			changes = append(changes, InputChange{Field: key, Old: oldValues[key], New: newValues[key]})
+		}
+	}
+	return changes
+}
+
+// componentTotal sums the components of one dimension.
+type componentTotal struct {
+	unit     string
+	skus     []string
+	quantity float64
+	subtotal float64
+}
+
+// sku returns the SKUs of the dimension joined by "+".
+func (t componentTotal) sku() string {
+	return strings.Join(t.skus, "+")
+}
+
+// totalsByDimension sums components by dimension, returning the dimensions in
+// the order they are first priced.
+func totalsByDimension(components []CostComponent) ([]string, map[string]componentTotal) {
+	var order []string
+	totals := make(map[string]componentTotal)
+	for _, component := range components {
+		total, seen := totals[component.Dimension]
+		if !seen {
+			order = append(order, component.Dimension)
+			total.unit = component.Unit
+		}
+		if component.SKU != "" && !slices.Contains(total.skus, component.SKU) {
+			total.skus = append(total.skus, component.SKU)
+		}
+		total.quantity += component.Quantity
+		total.subtotal += component.Subtotal
+		totals[component.Dimension] = total
+	}
+	return order, totals
+}
+
+// componentChanges lists the dimensions whose SKU, quantity or cost differ,
+// old dimensions first, then dimensions only priced in the new state.
+func componentChanges(oldComponents, newComponents []CostComponent) []ComponentChange {
+	oldOrder, oldTotals := totalsByDimension(oldComponents)
+	newOrder, newTotals := totalsByDimension(newComponents)
+
+	order := oldOrder
+	for _, dimension := range newOrder {
+		if _, ok := oldTotals[dimension]; !ok {
+			order = append(order, dimension)
+		}
+	}
+
+	const epsilon = 1e-9
+	var changes []ComponentChange
+	for _, dimension := range order {
+		oldTotal, inOld := oldTotals[dimension]
+		newTotal, inNew := newTotals[dimension]
+		change := ComponentChange{
+			Dimension:   dimension,
+			Unit:        cmp.Or(newTotal.unit, oldTotal.unit),
+			OldSKU:      oldTotal.sku(),
+			NewSKU:      newTotal.sku(),
+			OldQuantity: oldTotal.quantity,
+			NewQuantity: newTotal.quantity,
+			CostDelta:   newTotal.subtotal - oldTotal.subtotal,
+		}
+		if inOld && inNew && change.OldSKU == change.NewSKU &&
+			math.Abs(change.NewQuantity-change.OldQuantity) < epsilon && math.Abs(change.CostDelta) < epsilon {
+			continue
+		}
+
+		var what string
+		switch {
+		case !inOld:
+			what = fmt.Sprintf("added %s %s", formatQuantity(change.NewQuantity), change.Unit)
+		case !inNew:
+			what = "removed"
+		case change.OldSKU != change.NewSKU:
+			what = fmt.Sprintf("%s → %s", change.OldSKU, change.NewSKU)
+		case math.Abs(change.NewQuantity-change.OldQuantity) >= epsilon:
+			what = fmt.Sprintf("%+g %s", roundQuantity(change.NewQuantity-change.OldQuantity), change.Unit)
+		default:
+			what = "rate changed"
+		}
+		change.Summary = fmt.Sprintf("%s: %s (%s/mo)", dimension, what, formatCostDelta(change.CostDelta))
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+// formatQuantity formats a quantity without trailing zeros.
+func formatQuantity(quantity float64) string {
+	return strconv.FormatFloat(roundQuantity(quantity), 'f', -1, 64)
+}
+
+// roundQuantity rounds a quantity to four decimal places for display.
+func roundQuantity(quantity float64) float64 {
+	return math.Round(quantity*1e4) / 1e4
+}
+
+// formatCostDelta formats a signed monthly cost, e.g. "+$12.41" or "-$3.00".
+func formatCostDelta(delta float64) string {
+	if delta < 0 {
+		return fmt.Sprintf("-$%.2f", -delta)
+	}
+	return fmt.Sprintf("+$%.2f", delta)
+}
@@ -0,0 +1,150 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestDiffCost_Descriptors verifies an instance type change is explained by
+// the SKU input and the instance component.
+func TestDiffCost_Descriptors(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	plugin.pricing.(*mockPricingClient).ec2Prices["m6i.large/Linux/Shared"] = 0.096
+
+	instance := func(sku string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:ec2/instance:Instance",
+			Sku:          sku,
+			Region:       "us-east-1",
+		}
+	}
+	diff, err := plugin.DiffCost(context.Background(), &CostDiffRequest{
+		Old: instance("t3.micro"),
+		New: instance("m6i.large"),
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 0.0104*730, diff.Old.CostPerMonth, 1e-9)
+	assert.InDelta(t, 0.096*730, diff.New.CostPerMonth, 1e-9)
+	assert.InDelta(t, (0.096-0.0104)*730, diff.Delta, 1e-9)
+	assert.Equal(t, qualityHigh, diff.New.EstimateQuality)
+	assert.Equal(t, []InputChange{{Field: "sku", Old: "t3.micro", New: "m6i.large"}}, diff.InputChanges)
+
+	require.Len(t, diff.Changes, 1)
+	assert.Equal(t, "instance", diff.Changes[0].Dimension)
+	assert.Equal(t, "instance: t3.micro/Linux/Shared → m6i.large/Linux/Shared (+$62.49/mo)", diff.Changes[0].Summary)
+}
+
+// TestDiffCost_PulumiInputs verifies Pulumi inputs are mapped as EstimateCost
+// maps them and each side reports its own defaults.
+func TestDiffCost_PulumiInputs(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	oldInputs, err := structpb.NewStruct(map[string]any{"type": "gp3"})
+	require.NoError(t, err)
+	newInputs, err := structpb.NewStruct(map[string]any{"type": "gp3", "size": float64(108)})
+	require.NoError(t, err)
+
+	diff, err := plugin.DiffCost(context.Background(), &CostDiffRequest{
+		ResourceType: "aws:ebs/volume:Volume",
+		OldInputs:    oldInputs,
+		NewInputs:    newInputs,
+	})
+	require.NoError(t, err)
+
+	assert.InDelta(t, 8*0.08, diff.Old.CostPerMonth, 1e-9)
+	assert.InDelta(t, 108*0.08, diff.New.CostPerMonth, 1e-9)
+	assert.Contains(t, diff.Old.DefaultsApplied, "size")
+	assert.Empty(t, diff.New.DefaultsApplied)
+	assert.Equal(t, []InputChange{{Field: "size", New: "108"}}, diff.InputChanges)
+
+	require.Len(t, diff.Changes, 1)
+	assert.InDelta(t, 100.0, diff.Changes[0].NewQuantity-diff.Changes[0].OldQuantity, 1e-9)
+	assert.Equal(t, "storage: +100 GB-Mo (+$8.00/mo)", diff.Changes[0].Summary)
+}
+
+// TestDiffCost_CreateAndDelete verifies a missing side is priced at $0.
+func TestDiffCost_CreateAndDelete(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	instance := &pbc.ResourceDescriptor{
+		Provider:     providerAWS,
+		ResourceType: "aws:ec2/instance:Instance",
+		Sku:          "t3.micro",
+		Region:       "us-east-1",
+	}
+
+	created, err := plugin.DiffCost(context.Background(), &CostDiffRequest{New: instance})
+	require.NoError(t, err)
+	assert.False(t, created.Old.Present)
+	assert.True(t, created.New.Present)
+	assert.InDelta(t, 0.0104*730, created.Delta, 1e-9)
+	require.Len(t, created.Changes, 1)
+	assert.Equal(t, "instance: added 730 Hours (+$7.59/mo)", created.Changes[0].Summary)
+
+	deleted, err := plugin.DiffCost(context.Background(), &CostDiffRequest{Old: instance})
+	require.NoError(t, err)
+	assert.InDelta(t, -0.0104*730, deleted.Delta, 1e-9)
+	require.Len(t, deleted.Changes, 1)
+	assert.Equal(t, "instance: removed (-$7.59/mo)", deleted.Changes[0].Summary)
+}
+
+// TestDiffCost_InvalidRequest verifies malformed diff requests are rejected.
+func TestDiffCost_InvalidRequest(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	inputs, err := structpb.NewStruct(map[string]any{"region": "eu-west-1"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		req  *CostDiffRequest
+		code codes.Code
+	}{
+		{"nil request", nil, codes.InvalidArgument},
+		{"no state", &CostDiffRequest{}, codes.InvalidArgument},
+		{"both forms", &CostDiffRequest{
+			Old:          &pbc.ResourceDescriptor{},
+			ResourceType: "aws:ebs/volume:Volume",
+		}, codes.InvalidArgument},
+		{"bad resource type", &CostDiffRequest{ResourceType: "ebs", NewInputs: inputs}, codes.InvalidArgument},
+		{"other region", &CostDiffRequest{ResourceType: "aws:ebs/volume:Volume", NewInputs: inputs},
+			codes.FailedPrecondition},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := plugin.DiffCost(context.Background(), tt.req)
+			require.Error(t, err)
+			assert.Equal(t, tt.code, status.Code(err))
+		})
+	}
+}
+
+// TestParseCostDiffRequest verifies both JSON request forms are decoded.
+func TestParseCostDiffRequest(t *testing.T) {
+	req, err := ParseCostDiffRequest([]byte(`{
+		"old": {"resource_type": "aws:ec2/instance:Instance", "sku": "m5.large", "region": "us-east-1"},
+		"new": null
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "m5.large", req.Old.GetSku())
+	assert.Nil(t, req.New)
+
+	req, err = ParseCostDiffRequest([]byte(`{
+		"resource_type": "aws:ebs/volume:Volume",
+		"old_inputs": {"size": 8},
+		"new_inputs": {"size": 108, "type": "gp3"}
+	}`))
+	require.NoError(t, err)
+	assert.Equal(t, "aws:ebs/volume:Volume", req.ResourceType)
+	assert.InDelta(t, 108.0, req.NewInputs.GetFields()["size"].GetNumberValue(), 1e-9)
+
+	_, err = ParseCostDiffRequest([]byte(`{"old": {"sku": 1}}`))
+	assert.Error(t, err)
+}
@@ -0,0 +1,239 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_ECR verifies storage pricing, the linear growth type and the
+// monthly_growth_gb hint.
+func TestGetProjectedCost_ECR(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+		wantGrowth   string
+	}{
+		{
+			name:         "no storage hint",
+			tags:         nil,
+			wantCost:     0,
+			wantDefaults: "storage_gb=0",
+			wantGrowth:   "",
+		},
+		{
+			name:         "storage with growth hint",
+			tags:         map[string]string{"storage_gb": "50", "monthly_growth_gb": "5.5"},
+			wantCost:     50 * 0.10,
+			wantDefaults: "",
+			wantGrowth:   "5.5",
+		},
+		{
+			name:         "invalid growth hint is ignored",
+			tags:         map[string]string{"storage_gb": "50", "monthly_growth_gb": "-1"},
+			wantCost:     50 * 0.10,
+			wantDefaults: "",
+			wantGrowth:   "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:ecr/repository:Repository",
+					Sku:          "repository",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.InDelta(t, 0.10, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "ECR repository")
+			assert.Equal(t, pbc.GrowthType_GROWTH_TYPE_LINEAR, resp.GetGrowthType())
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+			assert.Equal(t, tt.wantGrowth, resp.GetMetadata()[metadataKeyMonthlyGrowthGB])
+		})
+	}
+}
+
+// TestGetProjectedCost_Backup verifies warm/cold storage pricing per protected
+// resource type and the linear growth hint.
+func TestGetProjectedCost_Backup(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantUnit     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "defaults to EBS with no storage",
+			sku:          "vault",
+			tags:         nil,
+			wantCost:     0,
+			wantUnit:     0.05,
+			wantDetail:   "AWS Backup EBS",
+			wantDefaults: "resource_type=EBS,warm_storage_gb=0",
+		},
+		{
+			name:         "EFS warm and cold tiers",
+			sku:          "vault",
+			tags:         map[string]string{"resource_type": "efs", "warm_storage_gb": "100", "cold_storage_gb": "500"},
+			wantCost:     100*0.05 + 500*0.01,
+			wantUnit:     0.05,
+			wantDetail:   "500GB cold storage",
+			wantDefaults: "",
+		},
+		{
+			name:         "resource type from SKU",
+			sku:          "RDS",
+			tags:         map[string]string{"warm_storage_gb": "200"},
+			wantCost:     200 * 0.095,
+			wantUnit:     0.095,
+			wantDetail:   "AWS Backup RDS",
+			wantDefaults: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:backup/vault:Vault",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.InDelta(t, tt.wantUnit, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, pbc.GrowthType_GROWTH_TYPE_LINEAR, resp.GetGrowthType())
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("growth hint is surfaced", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:backup/vault:Vault",
+				Sku:          "EBS",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"warm_storage_gb": "100", "monthly_growth_gb": "20"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "20", resp.GetMetadata()[metadataKeyMonthlyGrowthGB])
+	})
+
+	t.Run("unsupported resource_type is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:backup/vault:Vault",
+				Sku:          "vault",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"resource_type": "fsx"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("cold tier without pricing", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:backup/vault:Vault",
+				Sku:          "RDS",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"cold_storage_gb": "100"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), "cold storage")
+	})
+}
+
+// TestDetectService_ECRBackup verifies Pulumi resource types route to ECR and Backup.
+func TestDetectService_ECRBackup(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"aws:ecr/repository:Repository", serviceECR},
+		{"ecr", serviceECR},
+		{"aws:backup/vault:Vault", serviceBackup},
+		{"backup", serviceBackup},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectService(normalizeResourceType(tt.resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_ECRBackupConfiguration verifies lifecycle policies, backup
+// plans and selections are not priced as repositories or vaults.
+func TestGetProjectedCost_ECRBackupConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:ecr/lifecyclePolicy:LifecyclePolicy",
+		"aws:ecr/repositoryPolicy:RepositoryPolicy",
+		"aws:backup/plan:Plan",
+		"aws:backup/selection:Selection",
+		"aws:backup/vaultPolicy:VaultPolicy",
+	)
+}
+
+// TestGetPricingSpec_ECRBackup verifies both services expose per-GB-month specs.
+func TestGetPricingSpec_ECRBackup(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+	}{
+		{"aws:ecr/repository:Repository", "repository", 0.10},
+		{"aws:backup/vault:Vault", "EFS", 0.05},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp.GetSpec())
+			assert.Equal(t, "per_gb_month", resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, "GB-month", resp.GetSpec().GetUnit())
+		})
+	}
+}
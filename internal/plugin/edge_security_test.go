@@ -0,0 +1,252 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetProjectedCost_EdgeSecurity verifies the web ACL, rule and request charges
+// for WAF, the Shield Advanced subscription fee, and the fixed fee plus DT-Premium
+// for Global Accelerator.
+func TestGetProjectedCost_EdgeSecurity(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "waf web acl defaults",
+			resourceType: "aws:wafv2/webAcl:WebAcl",
+			wantCost:     5.00,
+			wantDetail:   "AWS WAF web ACL, 0 rule(s), 0 requests/month",
+			wantDefaults: "rule_count=0,requests_per_month=0",
+		},
+		{
+			name:         "waf rule count and requests from tags",
+			resourceType: "aws:wafv2/webAcl:WebAcl",
+			tags:         map[string]string{"rule_count": "4", "requests_per_month": "10000000"},
+			wantCost:     5.00 + 4*1.00 + 10000000*0.0000006,
+			wantDetail:   "AWS WAF web ACL, 4 rule(s), 10000000 requests/month",
+		},
+		{
+			name:         "waf rules counted from attribute",
+			resourceType: "aws:wafv2/webAcl:WebAcl",
+			tags: map[string]string{
+				"rules": "[map[name:rate-limit priority:1 statement:map[rateBasedStatement:map[limit:100]]] " +
+					"map[name:geo priority:2]]",
+				"requests_per_month": "0",
+			},
+			wantCost:   5.00 + 2*1.00,
+			wantDetail: "2 rule(s)",
+		},
+		{
+			name:         "waf classic web acl",
+			resourceType: "aws:waf/webAcl:WebAcl",
+			tags:         map[string]string{"rule_count": "1", "requests_per_month": "0"},
+			wantCost:     6.00,
+			wantDetail:   "AWS WAF web ACL, 1 rule(s)",
+		},
+		{
+			name:         "shield advanced subscription",
+			resourceType: "aws:shield/subscription:Subscription",
+			wantCost:     3000,
+			wantDetail:   "Shield Advanced subscription, $3000/month per organization",
+		},
+		{
+			name:         "global accelerator defaults",
+			resourceType: "aws:globalaccelerator/accelerator:Accelerator",
+			wantCost:     0.025 * 730,
+			wantDetail:   "Global Accelerator, 730 hrs/month ($0.025/hr)",
+			wantDefaults: "data_transfer_premium_gb_per_month=0",
+		},
+		{
+			name:         "global accelerator with default geographies",
+			resourceType: "aws:globalaccelerator/accelerator:Accelerator",
+			tags:         map[string]string{"data_transfer_premium_gb_per_month": "1000"},
+			wantCost:     0.025*730 + 1000*0.015,
+			wantDetail:   "+ 1000GB DT-Premium North America to North America",
+			wantDefaults: "source_geography=North America,destination_geography=North America",
+		},
+		{
+			name:         "custom routing accelerator with destination",
+			resourceType: "aws:globalaccelerator/customRoutingAccelerator:CustomRoutingAccelerator",
+			tags: map[string]string{
+				"data_transfer_premium_gb_per_month": "500",
+				"destination_geography":              "Asia Pacific",
+			},
+			wantCost:     0.025*730 + 500*0.035,
+			wantDetail:   "DT-Premium North America to Asia Pacific",
+			wantDefaults: "source_geography=North America",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          "default",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("unknown DT-Premium route returns $0 with explanation", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:globalaccelerator/accelerator:Accelerator",
+				Sku:          "default",
+				Region:       "us-east-1",
+				Tags: map[string]string{
+					"data_transfer_premium_gb_per_month": "100",
+					"destination_geography":              "Antarctica",
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), `"North America to Antarctica" not found`)
+	})
+}
+
+// TestCountSerializedList verifies top-level element counting for Go fmt and JSON
+// list serializations.
+func TestCountSerializedList(t *testing.T) {
+	tests := []struct {
+		input     string
+		wantCount int
+		wantOK    bool
+	}{
+		{"[]", 0, true},
+		{"[map[name:a]]", 1, true},
+		{"[map[name:a action:map[block:map[]]] map[name:b]]", 2, true},
+		{`[{"name":"a","statement":{"x":[1,2]}},{"name":"b"}]`, 2, true},
+		{"3", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			count, ok := countSerializedList(tt.input)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantCount, count)
+		})
+	}
+}
+
+// TestDetectService_EdgeSecurity verifies Pulumi resource types route to the WAF,
+// Shield and Global Accelerator estimators.
+func TestDetectService_EdgeSecurity(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"aws:wafv2/webAcl:WebAcl", serviceWAF},
+		{"aws:wafregional/webAcl:WebAcl", serviceWAF},
+		{"aws:waf/webAcl:WebAcl", serviceWAF},
+		{"waf", serviceWAF},
+		{"aws:shield/subscription:Subscription", serviceShield},
+		{"aws:globalaccelerator/customRoutingAccelerator:CustomRoutingAccelerator", serviceGlobalAccel},
+		{"aws:globalaccelerator/accelerator:Accelerator", serviceGlobalAccel},
+		{"globalaccelerator", serviceGlobalAccel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectService(normalizeResourceType(tt.resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_EdgeSecurityConfiguration verifies WAF supporting resources,
+// Shield protections and Global Accelerator listeners and endpoint groups are not
+// priced as web ACLs, subscriptions or accelerators.
+func TestGetProjectedCost_EdgeSecurityConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:wafv2/ipSet:IpSet",
+		"aws:wafv2/regexPatternSet:RegexPatternSet",
+		"aws:wafv2/webAclAssociation:WebAclAssociation",
+		"aws:wafregional/ipSet:IpSet",
+		"aws:shield/protection:Protection",
+		"aws:shield/protectionGroup:ProtectionGroup",
+		"aws:globalaccelerator/listener:Listener",
+		"aws:globalaccelerator/endpointGroup:EndpointGroup",
+	)
+}
+
+// TestSupports_EdgeSecurity verifies the new services are reported as supported.
+func TestSupports_EdgeSecurity(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	for _, resourceType := range []string{
+		"aws:wafv2/webAcl:WebAcl",
+		"aws:shield/subscription:Subscription",
+		"aws:globalaccelerator/accelerator:Accelerator",
+	} {
+		t.Run(resourceType, func(t *testing.T) {
+			resp, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: resourceType,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.True(t, resp.GetSupported())
+		})
+	}
+}
+
+// TestGetPricingSpec_EdgeSecurity verifies the headline rate and unit of each spec,
+// and that supporting resources are zero cost.
+func TestGetPricingSpec_EdgeSecurity(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		wantMode     string
+		wantRate     float64
+		wantUnit     string
+	}{
+		{"aws:wafv2/webAcl:WebAcl", "per_month_plus_requests", 5.00, "web-acl-month"},
+		{"aws:wafv2/ipSet:IpSet", "zero_cost", 0, ""},
+		{"aws:shield/subscription:Subscription", "per_month", 3000, "month"},
+		{"aws:shield/protection:Protection", "zero_cost", 0, ""},
+		{"aws:globalaccelerator/accelerator:Accelerator", "per_hour_plus_data", 0.025, "hour"},
+		{"aws:globalaccelerator/endpointGroup:EndpointGroup", "zero_cost", 0, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          "default",
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
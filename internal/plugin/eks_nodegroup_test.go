@@ -0,0 +1,252 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_EKSNodeGroup verifies node groups are priced as desired_size
+// EC2 instances plus one node disk each, and Fargate profiles have no direct cost.
+func TestGetProjectedCost_EKSNodeGroup(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+		wantCategory pbc.FocusPricingCategory
+	}{
+		{
+			name:         "node group defaults",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			sku:          "nodegroup",
+			wantCost:     0.0416*2*730 + 20*0.10*2,
+			wantDetail:   "EKS node group ON_DEMAND t3.medium, 2 node(s), 730 hrs/month + 20GB gp2 disk per node",
+			wantDefaults: "instance_types=t3.medium,desired_size=2,capacity_type=ON_DEMAND,disk_size=20",
+		},
+		{
+			name:         "instance types and scaling config from attributes",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			sku:          "nodegroup",
+			tags: map[string]string{
+				"instanceTypes": "[m5.large m5.xlarge]",
+				"scalingConfig": "map[desiredSize:4 maxSize:6 minSize:1]",
+				"capacityType":  "ON_DEMAND",
+				"diskSize":      "50",
+			},
+			wantCost:   0.096*4*730 + 50*0.10*4,
+			wantDetail: "EKS node group ON_DEMAND m5.large, 4 node(s)",
+		},
+		{
+			name:         "spot capacity priced at the assumed spot discount",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			sku:          "m5.large",
+			tags: map[string]string{
+				"desired_size":  "3",
+				"capacity_type": "spot",
+				"disk_size":     "0",
+			},
+			wantCost:     0.096 * 0.3 * 3 * 730,
+			wantDetail:   "spot capacity priced at an assumed 70% discount to the on-demand rate",
+			wantDefaults: "spot_discount=0.7",
+			wantCategory: pbc.FocusPricingCategory_FOCUS_PRICING_CATEGORY_DYNAMIC,
+		},
+		{
+			name:         "windows ami uses windows rate and disk default",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			sku:          "m5.large",
+			tags: map[string]string{
+				"desired_size":  "1",
+				"capacity_type": "ON_DEMAND",
+				"ami_type":      "WINDOWS_CORE_2022_x86_64",
+			},
+			wantCost:     0.188*730 + 50*0.10,
+			wantDetail:   "+ 50GB gp2 disk per node",
+			wantDefaults: "disk_size=50",
+		},
+		{
+			name:         "scaled to zero",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			sku:          "m5.large",
+			tags:         map[string]string{"desired_size": "0", "capacity_type": "ON_DEMAND", "disk_size": "20"},
+			wantCost:     0,
+			wantDetail:   "0 node(s)",
+		},
+		{
+			name:         "fargate profile has no direct cost",
+			resourceType: "aws:eks/fargateProfile:FargateProfile",
+			sku:          "fargate",
+			wantCost:     0,
+			wantDetail:   "EKS Fargate profile has no direct cost",
+		},
+		{
+			name:         "cluster still prices control plane",
+			resourceType: "aws:eks/cluster:Cluster",
+			sku:          "cluster",
+			wantCost:     0.10 * 730,
+			wantDetail:   "EKS cluster (standard support)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+			assert.Equal(t, tt.wantCategory, resp.GetPricingCategory())
+		})
+	}
+
+	t.Run("spot capacity is a low quality estimate", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:eks/nodeGroup:NodeGroup",
+				Sku:          "m5.large",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"desired_size": "1", "capacity_type": "SPOT", "disk_size": "20"},
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, qualityLow, resp.GetMetadata()[metadataKeyEstimateQuality])
+		assert.InDelta(t, 0.096*0.3, resp.GetUnitPrice(), 1e-9)
+	})
+
+	t.Run("invalid capacity type is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:eks/nodeGroup:NodeGroup",
+				Sku:          "m5.large",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"capacity_type": "RESERVED"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown instance type returns $0 with explanation", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:eks/nodeGroup:NodeGroup",
+				Sku:          "x9.large",
+				Region:       "us-east-1",
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), `EC2 instance type "x9.large" not found`)
+	})
+}
+
+// TestGetProjectedCost_EKSNodeGroupCarbon verifies node group carbon comes from the
+// EC2 estimator and scales with desired_size.
+func TestGetProjectedCost_EKSNodeGroupCarbon(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	carbonFor := func(t *testing.T, tags map[string]string) float64 {
+		t.Helper()
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:eks/nodeGroup:NodeGroup",
+				Sku:          "m5.large",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		require.NoError(t, err)
+		for _, metric := range resp.GetImpactMetrics() {
+			if metric.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+				assert.Equal(t, "gCO2e", metric.GetUnit())
+				return metric.GetValue()
+			}
+		}
+		t.Fatal("carbon footprint metric missing")
+		return 0
+	}
+
+	one := carbonFor(t, map[string]string{"desired_size": "1", "disk_size": "0"})
+	instanceCarbon, ok := plugin.carbonEstimator.EstimateCarbonGrams(
+		"m5.large", "us-east-1", carbon.DefaultUtilization, carbon.HoursPerMonth,
+	)
+	require.True(t, ok)
+	assert.InDelta(t, instanceCarbon, one, 1e-6)
+
+	three := carbonFor(t, map[string]string{"desired_size": "3", "disk_size": "0"})
+	assert.InDelta(t, one*3, three, 1e-6)
+
+	withDisk := carbonFor(t, map[string]string{"desired_size": "3", "disk_size": "100"})
+	assert.Greater(t, withDisk, three)
+}
+
+// TestServiceClassification_EKSNodeGroup verifies node groups and Fargate profiles
+// link to their parent cluster.
+func TestServiceClassification_EKSNodeGroup(t *testing.T) {
+	for _, key := range []string{"aws:eks:nodegroup", "aws:eks:fargateprofile"} {
+		t.Run(key, func(t *testing.T) {
+			classification, ok := GetServiceClassification(key)
+			require.True(t, ok)
+			assert.Equal(t, []string{"cluster_name", "clusterName"}, classification.ParentTagKeys)
+			assert.Equal(t, "aws:eks:cluster:Cluster", classification.ParentType)
+			assert.Equal(t, RelationshipWithin, classification.Relationship)
+		})
+	}
+}
+
+// TestGetPricingSpec_EKSNodeGroup verifies the per-node hourly rate for node groups
+// and zero cost for Fargate profiles.
+func TestGetPricingSpec_EKSNodeGroup(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantMode     string
+		wantRate     float64
+	}{
+		{"aws:eks/nodeGroup:NodeGroup", "m5.large", "per_hour", 0.096},
+		{"aws:eks/nodeGroup:NodeGroup", "nodegroup", "per_hour", 0.0416},
+		{"aws:eks/fargateProfile:FargateProfile", "fargate", "zero_cost", 0},
+		{"aws:eks/cluster:Cluster", "cluster", "per_hour", 0.10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.sku, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package plugin
 
 import (
+	"strconv"
+
 	"github.com/rs/zerolog"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 )
@@ -37,3 +39,26 @@ func setGrowthHint(logger zerolog.Logger, serviceType string, resp *pbc.GetProje
 			Msg("applied growth hint")
 	}
 }
+
+// metadataKeyMonthlyGrowthGB is the metadata key carrying the expected storage growth
+// per month for accumulating (GROWTH_TYPE_LINEAR) resources. Core projects month N as
+// cost_per_month + N × monthly_growth_gb × unit_price.
+const metadataKeyMonthlyGrowthGB = "monthly_growth_gb"
+
+// setMonthlyGrowthHint copies a valid "monthly_growth_gb" tag into the response metadata
+// so Core's forecasting can project linear storage growth. Missing, negative or
+// non-numeric values are ignored.
+func setMonthlyGrowthHint(tags map[string]string, resp *pbc.GetProjectedCostResponse) {
+	if resp == nil {
+		return
+	}
+
+	growthGB, ok := parseNonNegativeTag(tags, metadataKeyMonthlyGrowthGB)
+	if !ok {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 1)
+	}
+	resp.Metadata[metadataKeyMonthlyGrowthGB] = strconv.FormatFloat(growthGB, 'f', -1, 64)
+}
@@ -10,15 +10,20 @@ import (
 
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
-
-	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
 )
 
 // EstimateCost returns an estimated monthly cost for a resource based on its
 // type and configuration attributes. This is the preferred method for pre-deployment
 // cost estimation as it works with Pulumi resource types directly.
+//
+// Attributes are mapped onto a ResourceDescriptor (see resourceFromAttributes) and
+// priced by the same registered estimator as GetProjectedCost, so every service
+// GetProjectedCost supports is supported here. A region other than the plugin's
+// returns an UNSUPPORTED_REGION error, matching GetProjectedCost.
 func (p *AWSPublicPlugin) EstimateCost(
 	ctx context.Context,
 	req *pbc.EstimateCostRequest,
@@ -76,50 +81,128 @@ func (p *AWSPublicPlugin) EstimateCost(
 	}
 
 	// Get region from attributes or use plugin's region
-	region := p.region
-	if regionVal, ok := getStringAttr(attrs, "region"); ok && regionVal != "" {
-		region = regionVal
-	} else if availZone, azFound := getStringAttr(attrs, "availabilityZone"); azFound && availZone != "" {
-		// Extract region from AZ (e.g., "us-east-1a" -> "us-east-1")
-		if len(availZone) > 1 {
-			region = availZone[:len(availZone)-1]
-		}
-	}
+	region := attributesRegion(attrs, p.region)
 
 	// Check region match
 	if region != p.region {
-		// Return $0 for wrong region (let the correct plugin handle it)
-		return &pbc.EstimateCostResponse{
-			Currency:    "USD",
-			CostMonthly: 0,
-		}, nil
+		err := p.RegionMismatchError(traceID, region)
+		p.logErrorWithID(traceID, "EstimateCost", err, pbc.ErrorCode_ERROR_CODE_UNSUPPORTED_REGION)
+		return nil, err
 	}
 
-	var costMonthly float64
+	// Map Pulumi inputs onto the descriptor GetProjectedCost consumes, so both
+	// RPCs share one estimator per service.
+	resolver := newServiceResolver(req.GetResourceType())
+	resource, attrDefaults := resourceFromAttributes(req.GetResourceType(), region, resolver.ServiceType(), attrs)
+
+	projected, err := p.getProjectedForResource(p.config(), traceID, resource, resolver)
+	if err != nil {
+		var pue *PricingUnavailableError
+		if !errors.As(err, &pue) {
+			p.logErrorWithID(traceID, "EstimateCost", err, extractErrorCode(err))
+			return nil, err
+		}
+		projected = &pbc.GetProjectedCostResponse{
+			Currency:      "USD",
+			BillingDetail: pue.BillingDetail,
+		}
+	}
 
-	switch resourceInfo.module {
-	case serviceEC2:
-		costMonthly = p.estimateEC2FromAttrs(traceID, resourceInfo.resource, attrs)
-	case serviceEBS:
-		costMonthly = p.estimateEBSFromAttrs(traceID, resourceInfo.resource, attrs)
-	default:
-		// Unsupported module - return $0
-		costMonthly = 0
+	// EstimateCostResponse has no metadata field, so defaults, estimate quality,
+	// the cost components with their price sources, the price data age and the
+	// usage schedule are returned as response header metadata under the same keys.
+	md := estimateCostMetadata(&attrDefaults, projected.GetMetadata())
+	for _, key := range estimateHeaderKeys {
+		value, ok := projected.GetMetadata()[key]
+		if !ok {
+			continue
+		}
+		if md == nil {
+			md = make(map[string]string, len(estimateHeaderKeys))
+		}
+		md[key] = value
+	}
+	if len(md) > 0 {
+		if headerErr := grpc.SetHeader(ctx, metadata.New(md)); headerErr != nil {
+			p.traceLogger(traceID, "EstimateCost").Debug().
+				Err(headerErr).
+				Msg("unable to attach estimate metadata headers")
+		}
 	}
 
 	p.traceLogger(traceID, "EstimateCost").Info().
 		Str("pulumi_type", req.GetResourceType()).
+		Str("aws_service", resolver.ServiceType()).
 		Str("aws_region", region).
-		Float64(pluginsdk.FieldCostMonthly, costMonthly).
+		Str("billing_detail", projected.GetBillingDetail()).
+		Str(metadataKeyDefaultsApplied, md[metadataKeyDefaultsApplied]).
+		Float64(pluginsdk.FieldCostMonthly, projected.GetCostPerMonth()).
 		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
 		Msg("cost estimated")
 
 	return &pbc.EstimateCostResponse{
-		Currency:    "USD",
-		CostMonthly: costMonthly,
+		Currency:        "USD",
+		CostMonthly:     projected.GetCostPerMonth(),
+		PricingCategory: projected.GetPricingCategory(),
 	}, nil
 }
 
+// estimateHeaderKeys are the projected cost metadata keys EstimateCost passes
+// through as response headers unchanged.
+var estimateHeaderKeys = []string{
+	metadataKeyCostComponents,
+	metadataKeyPriceDataAgeDays,
+	metadataKeyPricingStale,
+	metadataKeyPriceDataCheckedAt,
+	metadataKeyStalePriceLists,
+	metadataKeyUsageSchedule,
+	metadataKeyHoursPerMonth,
+	metadataKeyCostRangeLow,
+	metadataKeyCostRangeExpected,
+	metadataKeyCostRangeHigh,
+	metadataKeyCostRangeBasis,
+	metadataKeyCostForecast,
+	metadataKeyCostForecastTotal,
+	metadataKeyCostForecastError,
+	metadataKeyAllocationTags,
+	metadataKeyUsageAssumptions,
+}
+
+// estimateCostMetadata combines the defaults applied while mapping attributes with
+// the estimator's defaults metadata. Quality is the lower of the two. Returns nil
+// when no defaults were applied.
+func estimateCostMetadata(attrDefaults *DefaultsTracker, projected map[string]string) map[string]string {
+	md := attrDefaults.Metadata()
+	applied := projected[metadataKeyDefaultsApplied]
+	if applied == "" {
+		return md
+	}
+	if md == nil {
+		return map[string]string{
+			metadataKeyDefaultsApplied: applied,
+			metadataKeyEstimateQuality: projected[metadataKeyEstimateQuality],
+		}
+	}
+	md[metadataKeyDefaultsApplied] += "," + applied
+	if projected[metadataKeyEstimateQuality] == qualityLow {
+		md[metadataKeyEstimateQuality] = qualityLow
+	}
+	return md
+}
+
+// attributesRegion returns the region of a resource from its "region" or
+// "availabilityZone" attribute, or fallback when neither is set.
+func attributesRegion(attrs *structpb.Struct, fallback string) string {
+	if regionVal, ok := getStringAttr(attrs, "region"); ok && regionVal != "" {
+		return regionVal
+	}
+	if availZone, azFound := getStringAttr(attrs, "availabilityZone"); azFound && len(availZone) > 1 {
+		// Extract region from AZ (e.g., "us-east-1a" -> "us-east-1")
+		return availZone[:len(availZone)-1]
+	}
+	return fallback
+}
+
 // resourceTypeInfo holds parsed Pulumi resource type information.
 type resourceTypeInfo struct {
 	provider string // e.g., "aws"
@@ -193,72 +276,3 @@ func getNumberAttr(attrs *structpb.Struct, key string) (float64, bool) {
 	}
 	return 0, false
 }
-
-// estimateEC2FromAttrs calculates EC2 cost from Pulumi attributes.
-func (p *AWSPublicPlugin) estimateEC2FromAttrs(traceID, resourceName string, attrs *structpb.Struct) float64 {
-	// Only handle Instance resources
-	if resourceName != "Instance" {
-		return 0
-	}
-
-	// Get instance type from attributes
-	instanceType, ok := getStringAttr(attrs, "instanceType")
-	if !ok || instanceType == "" {
-		p.traceLogger(traceID, "EstimateCost").Debug().
-			Msg("EC2 instance missing instanceType attribute")
-		return 0
-	}
-
-	// Extract OS and tenancy using shared helper (FR-001, FR-003)
-	ec2Attrs := ExtractEC2AttributesFromStruct(attrs)
-
-	hourlyRate, found := p.pricing.EC2OnDemandPricePerHour(instanceType, ec2Attrs.OS, ec2Attrs.Tenancy)
-	if !found {
-		p.traceLogger(traceID, "EstimateCost").Debug().
-			Str("instance_type", instanceType).
-			Msg("EC2 instance type not found in pricing data")
-		return 0
-	}
-
-	costMonthly := hourlyRate * carbon.HoursPerMonth
-
-	// Add root EBS volume cost when rootBlockDevice attribute is present
-	rootVol := ExtractRootVolumeFromStruct(attrs, *p.traceLogger(traceID, "EstimateCost"))
-	if rootVol.Present {
-		if ebsRate, ebsFound := p.pricing.EBSPricePerGBMonth(rootVol.VolumeType); ebsFound {
-			costMonthly += ebsRate * float64(rootVol.SizeGB)
-		}
-	}
-
-	return costMonthly
-}
-
-// estimateEBSFromAttrs calculates EBS cost from Pulumi attributes.
-func (p *AWSPublicPlugin) estimateEBSFromAttrs(traceID, resourceName string, attrs *structpb.Struct) float64 {
-	// Handle Volume resources
-	if resourceName != "Volume" {
-		return 0
-	}
-
-	// Get volume type from attributes (default to gp2)
-	volumeType, ok := getStringAttr(attrs, "type")
-	if !ok || volumeType == "" {
-		volumeType = defaultRootVolumeType
-	}
-
-	// Get size from attributes (default to 8 GB)
-	sizeGB := float64(defaultEBSGB)
-	if size, sizeFound := getNumberAttr(attrs, "size"); sizeFound && size > 0 {
-		sizeGB = size
-	}
-
-	ratePerGBMonth, found := p.pricing.EBSPricePerGBMonth(volumeType)
-	if !found {
-		p.traceLogger(traceID, "EstimateCost").Debug().
-			Str("volume_type", volumeType).
-			Msg("EBS volume type not found in pricing data")
-		return 0
-	}
-
-	return ratePerGBMonth * sizeGB
-}
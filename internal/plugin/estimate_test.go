@@ -8,7 +8,9 @@ import (
 	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/structpb"
 )
@@ -348,7 +350,8 @@ func TestEstimateCost_RegionFromAvailabilityZone(t *testing.T) {
 	assert.InDelta(t, 7.592, resp.GetCostMonthly(), 0.001)
 }
 
-// TestEstimateCost_WrongRegion verifies $0 is returned for wrong region.
+// TestEstimateCost_WrongRegion verifies a region mismatch is an error, as in
+// GetProjectedCost, rather than a silent $0.
 func TestEstimateCost_WrongRegion(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
 	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
@@ -361,13 +364,16 @@ func TestEstimateCost_WrongRegion(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	resp, err := plugin.EstimateCost(context.Background(), &pbc.EstimateCostRequest{
+	_, err = plugin.EstimateCost(context.Background(), &pbc.EstimateCostRequest{
 		ResourceType: "aws:ec2/instance:Instance",
 		Attributes:   attrs,
 	})
 
-	require.NoError(t, err)
-	assert.Equal(t, float64(0), resp.GetCostMonthly())
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	assert.Equal(t, pbc.ErrorCode_ERROR_CODE_UNSUPPORTED_REGION, extractErrorCode(err))
 }
 
 // TestEstimateCost_NonAWSProvider verifies $0 for non-AWS providers.
@@ -392,7 +398,7 @@ func TestEstimateCost_UnsupportedModule(t *testing.T) {
 	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
 
 	resp, err := plugin.EstimateCost(context.Background(), &pbc.EstimateCostRequest{
-		ResourceType: "aws:rds/instance:Instance",
+		ResourceType: "aws:apigateway/restApi:RestApi",
 		Attributes:   &structpb.Struct{Fields: make(map[string]*structpb.Value)},
 	})
 
@@ -606,3 +612,257 @@ func TestEstimateCost_NonInstanceEC2Resource(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, float64(0), resp.GetCostMonthly())
 }
+
+// TestEstimateCost_ProjectedCostParity verifies EstimateCost maps Pulumi inputs
+// onto the same estimator as GetProjectedCost for services beyond EC2 and EBS.
+func TestEstimateCost_ProjectedCostParity(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		resourceType string
+		attrs        map[string]any
+		sku          string
+		tags         map[string]string
+		wantCategory pbc.FocusPricingCategory
+	}{
+		{
+			name:         "RDS instance",
+			resourceType: "aws:rds/instance:Instance",
+			attrs: map[string]any{
+				"instanceClass":    "db.t3.micro",
+				"engine":           "mysql",
+				"allocatedStorage": float64(100),
+				"storageType":      "gp2",
+			},
+			sku:  "db.t3.micro",
+			tags: map[string]string{"engine": "mysql", "storage_size": "100", "storage_type": "gp2"},
+		},
+		{
+			name:         "Lambda function",
+			resourceType: "aws:lambda/function:Function",
+			attrs:        map[string]any{"memorySize": float64(512), "requestsPerMonth": float64(1000000)},
+			sku:          "512",
+			tags:         map[string]string{"requests_per_month": "1000000"},
+		},
+		{
+			name:         "DynamoDB on-demand table",
+			resourceType: "aws:dynamodb/table:Table",
+			attrs:        map[string]any{"billingMode": "PAY_PER_REQUEST", "storageGb": float64(10)},
+			sku:          "on-demand",
+			tags:         map[string]string{"storage_gb": "10"},
+		},
+		{
+			name:         "ALB",
+			resourceType: "aws:lb/loadBalancer:LoadBalancer",
+			attrs:        map[string]any{"loadBalancerType": "application"},
+			sku:          "alb",
+		},
+		{
+			name:         "ElastiCache cluster",
+			resourceType: "aws:elasticache/cluster:Cluster",
+			attrs:        map[string]any{"nodeType": "cache.m5.large", "engine": "redis", "numCacheNodes": float64(2)},
+			sku:          "cache.m5.large",
+			tags:         map[string]string{"engine": "redis", "num_cache_nodes": "2"},
+		},
+		{
+			name:         "OpenSearch domain with nested cluster config",
+			resourceType: "aws:opensearch/domain:Domain",
+			attrs: map[string]any{
+				"clusterConfig": map[string]any{"instanceType": "r6g.large.search", "instanceCount": float64(3)},
+				"ebsOptions":    map[string]any{"volumeSize": float64(100), "volumeType": "gp3"},
+			},
+			sku:  "r6g.large.search",
+			tags: map[string]string{"instance_count": "3", "volume_size": "100", "volume_type": "gp3"},
+		},
+		{
+			name:         "MSK cluster with deeply nested storage",
+			resourceType: "aws:msk/cluster:Cluster",
+			attrs: map[string]any{
+				"numberOfBrokerNodes": float64(3),
+				"brokerNodeGroupInfo": map[string]any{
+					"instanceType": "kafka.m5.large",
+					"storageInfo": map[string]any{
+						"ebsStorageInfo": map[string]any{"volumeSize": float64(500)},
+					},
+				},
+			},
+			sku:  "kafka.m5.large",
+			tags: map[string]string{"number_of_broker_nodes": "3", "volume_size": "500"},
+		},
+		{
+			name:         "EKS spot node group",
+			resourceType: "aws:eks/nodeGroup:NodeGroup",
+			attrs: map[string]any{
+				"instanceTypes": []any{"t3.micro"},
+				"scalingConfig": map[string]any{"desiredSize": float64(3), "maxSize": float64(5)},
+				"capacityType":  "SPOT",
+				"diskSize":      float64(30),
+			},
+			sku:          "t3.micro",
+			tags:         map[string]string{"desired_size": "3", "capacity_type": "SPOT", "disk_size": "30"},
+			wantCategory: pbc.FocusPricingCategory_FOCUS_PRICING_CATEGORY_DYNAMIC,
+		},
+		{
+			name:         "Redshift cluster",
+			resourceType: "aws:redshift/cluster:Cluster",
+			attrs:        map[string]any{"nodeType": "ra3.large", "numberOfNodes": float64(2)},
+			sku:          "ra3.large",
+			tags:         map[string]string{"number_of_nodes": "2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs, err := structpb.NewStruct(tt.attrs)
+			require.NoError(t, err)
+
+			estimated, err := plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+				ResourceType: tt.resourceType,
+				Attributes:   attrs,
+			})
+			require.NoError(t, err)
+
+			projected, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+
+			assert.Positive(t, estimated.GetCostMonthly())
+			assert.InDelta(t, projected.GetCostPerMonth(), estimated.GetCostMonthly(), 1e-9,
+				projected.GetBillingDetail())
+			assert.Equal(t, tt.wantCategory, estimated.GetPricingCategory())
+		})
+	}
+}
+
+// TestEstimateCost_EstimatorErrorsPropagate verifies validation errors from the
+// shared estimator are returned rather than swallowed as $0.
+func TestEstimateCost_EstimatorErrorsPropagate(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"instanceTypes": []any{"t3.micro"},
+		"capacityType":  "RESERVED",
+	})
+	require.NoError(t, err)
+
+	_, err = plugin.EstimateCost(context.Background(), &pbc.EstimateCostRequest{
+		ResourceType: "aws:eks/nodeGroup:NodeGroup",
+		Attributes:   attrs,
+	})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+// headerCaptureStream records response headers set through grpc.SetHeader.
+type headerCaptureStream struct {
+	header metadata.MD
+}
+
+func (s *headerCaptureStream) Method() string { return "/finfocus.v1.CostSourceService/EstimateCost" }
+
+func (s *headerCaptureStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerCaptureStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerCaptureStream) SetTrailer(metadata.MD) error { return nil }
+
+// TestEstimateCost_DefaultsMetadata verifies defaults applied while mapping
+// attributes and by the estimator are returned as response header metadata.
+func TestEstimateCost_DefaultsMetadata(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	plugin.pricing.(*mockPricingClient).ebsPrices["gp2"] = 0.10
+
+	tests := []struct {
+		name         string
+		resourceType string
+		attrs        map[string]any
+		wantDefaults string
+		wantQuality  string
+	}{
+		{
+			name:         "attribute mapping default",
+			resourceType: "aws:ebs/volume:Volume",
+			attrs:        map[string]any{"size": float64(100)},
+			wantDefaults: "type=gp2",
+			wantQuality:  qualityMedium,
+		},
+		{
+			name:         "estimator defaults",
+			resourceType: "aws:s3/bucket:Bucket",
+			attrs:        map[string]any{},
+			wantDefaults: "storage_class=STANDARD,size=1",
+			wantQuality:  qualityMedium,
+		},
+		{
+			name:         "usage default lowers quality",
+			resourceType: "aws:lambda/function:Function",
+			attrs:        map[string]any{"memorySize": float64(512)},
+			wantQuality:  qualityLow,
+		},
+		{
+			name:         "explicit inputs",
+			resourceType: "aws:ec2/instance:Instance",
+			attrs:        map[string]any{"instanceType": "t3.micro"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attrs, err := structpb.NewStruct(tt.attrs)
+			require.NoError(t, err)
+
+			stream := &headerCaptureStream{}
+			ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+			_, err = plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+				ResourceType: tt.resourceType,
+				Attributes:   attrs,
+			})
+			require.NoError(t, err)
+
+			quality := stream.header.Get(metadataKeyEstimateQuality)
+			if tt.wantQuality == "" {
+				assert.Empty(t, quality)
+				return
+			}
+			require.Len(t, quality, 1)
+			assert.Equal(t, tt.wantQuality, quality[0])
+			if tt.wantDefaults != "" {
+				assert.Equal(t, []string{tt.wantDefaults}, stream.header.Get(metadataKeyDefaultsApplied))
+			}
+		})
+	}
+}
+
+// TestEstimateCost_SageMakerEndpointDefaultInstanceType verifies an endpoint,
+// whose instance type lives on its endpoint configuration, is priced with the
+// default instance type at low quality rather than rejected.
+func TestEstimateCost_SageMakerEndpointDefaultInstanceType(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	attrs, err := structpb.NewStruct(map[string]any{"endpointConfigName": "inference-config"})
+	require.NoError(t, err)
+
+	stream := &headerCaptureStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	resp, err := plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+		ResourceType: "aws:sagemaker/endpoint:Endpoint",
+		Attributes:   attrs,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 0.115*730, resp.GetCostMonthly(), 1e-6)
+	assert.Equal(t, []string{qualityLow}, stream.header.Get(metadataKeyEstimateQuality))
+	assert.Equal(t, []string{"instance_type=ml.m5.large,instance_count=1"},
+		stream.header.Get(metadataKeyDefaultsApplied))
+}
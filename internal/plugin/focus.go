@@ -8,21 +8,6 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-// AWS service name mappings for FOCUS ServiceName field.
-// These follow AWS's official service naming conventions.
-var awsServiceNames = map[string]string{
-	serviceEC2:        "Amazon EC2",
-	serviceEBS:        "Amazon EBS",
-	serviceS3:         "Amazon S3",
-	serviceRDS:        "Amazon RDS",
-	serviceLambda:     "AWS Lambda",
-	serviceDynamoDB:   "Amazon DynamoDB",
-	serviceEKS:        "Amazon EKS",
-	serviceELB:        "Elastic Load Balancing",
-	serviceNATGW:      "Amazon VPC NAT Gateway",
-	serviceCloudWatch: "Amazon CloudWatch",
-}
-
 // buildFocusRecord creates a FocusCostRecord for public pricing estimates.
 //
 // For public pricing fallback estimates:
@@ -95,40 +80,49 @@ func buildFocusRecord(
 	}
 }
 
+// buildComponentFocusRecord creates the FocusCostRecord for one cost component of
+// an actual cost. It extends buildFocusRecord with the component's meter, pricing
+// and consumed quantities; cost and quantity are the prorated values for the
+// charge period. The component SKU, when present, is reported as SkuPriceId.
+func buildComponentFocusRecord(
+	serviceType, resourceType, region string,
+	component CostComponent,
+	cost, quantity float64,
+	start, end time.Time,
+	sku string,
+) *pbc.FocusCostRecord {
+	record := buildFocusRecord(serviceType, resourceType, region, cost, component.Rate, component.Unit,
+		start, end, sku)
+	record.SkuMeter = component.Dimension
+	record.SkuPriceId = component.SKU
+	if component.RateCode != "" {
+		record.SkuPriceId = component.RateCode
+	}
+	record.ExtendedColumns = focusProvenanceColumns(component.PriceSource)
+	record.PricingQuantity = quantity
+	record.ConsumedQuantity = quantity
+	record.ConsumedUnit = component.Unit
+	record.ChargeDescription = fmt.Sprintf("Public pricing estimate for %s (%s) in %s",
+		resourceType, component.Dimension, region)
+	return record
+}
+
 // mapServiceCategory maps AWS service types to FOCUS service categories.
-// This follows the FinOps FOCUS 1.2 standard service category definitions.
-//
-// Categories are based on the primary function of each AWS service:
-//   - COMPUTE: Processing resources (EC2, Lambda, EKS worker nodes)
-//   - STORAGE: Data persistence (S3, EBS)
-//   - DATABASE: Managed database services (RDS, DynamoDB)
-//   - NETWORK: Networking infrastructure (ELB, NAT Gateway)
-//   - MANAGEMENT: Monitoring and operations (CloudWatch)
+// This follows the FinOps FOCUS 1.2 standard service category definitions; each
+// service declares its category in serviceRegistry based on its primary function.
+// Unregistered services map to OTHER.
 func mapServiceCategory(serviceType string) pbc.FocusServiceCategory {
-	switch serviceType {
-	case serviceEC2, serviceLambda:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE
-	case serviceEBS, serviceS3:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE
-	case serviceRDS, serviceDynamoDB:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE
-	case serviceELB, serviceNATGW:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_NETWORK
-	case serviceCloudWatch:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_MANAGEMENT
-	case serviceEKS:
-		// EKS control plane is compute; worker nodes would be EC2
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE
-	default:
-		return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_OTHER
+	if estimator, ok := lookupEstimator(serviceType); ok {
+		return estimator.Category()
 	}
+	return pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_OTHER
 }
 
 // getServiceName returns the AWS service display name for FOCUS ServiceName.
 // Falls back to a formatted version of the service type if not found.
 func getServiceName(serviceType string) string {
-	if name, ok := awsServiceNames[serviceType]; ok {
-		return name
+	if estimator, ok := lookupEstimator(serviceType); ok && estimator.ServiceName() != "" {
+		return estimator.ServiceName()
 	}
 	// Fallback: capitalize and prefix with AWS
 	return fmt.Sprintf("AWS %s", serviceType)
@@ -137,18 +131,8 @@ func getServiceName(serviceType string) string {
 // getPricingUnitForService returns the appropriate pricing unit for a service.
 // This is used when the caller doesn't have a specific pricing unit available.
 func getPricingUnitForService(serviceType string) string {
-	switch serviceType {
-	case serviceEC2, serviceRDS, serviceEKS, serviceELB, serviceALB, serviceNLB, serviceNATGW:
-		return "Hours"
-	case serviceEBS, serviceS3:
-		return "GB-Mo"
-	case serviceLambda:
-		return "GB-Seconds"
-	case serviceDynamoDB:
-		return "Requests" // Simplified; actual has RCU/WCU
-	case serviceCloudWatch:
-		return "GB" // For log ingestion
-	default:
-		return "Units"
+	if estimator, ok := lookupEstimator(serviceType); ok {
+		return estimator.PricingUnit()
 	}
+	return "Units"
 }
@@ -0,0 +1,207 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Tags requesting a multi-month cost forecast of an accumulating resource.
+const (
+	// tagMonthlyGrowthPercent is the compound storage growth per month in
+	// percent. The linear alternative is metadataKeyMonthlyGrowthGB.
+	tagMonthlyGrowthPercent = "monthly_growth_percent"
+
+	// tagForecastMonths is the number of months in the forecast, including
+	// the current month.
+	tagForecastMonths = "forecast_months"
+)
+
+// Metadata keys of a cost forecast.
+const (
+	// metadataKeyCostForecast is the JSON-encoded forecast series, one
+	// forecastMonth per month starting with the current month.
+	metadataKeyCostForecast = "cost_forecast"
+
+	// metadataKeyCostForecastTotal is the total cost over the forecast months.
+	metadataKeyCostForecastTotal = "cost_forecast_total"
+
+	// metadataKeyCostForecastError explains why a requested forecast could not
+	// be built. The projected cost itself is still returned.
+	metadataKeyCostForecastError = "cost_forecast_error"
+)
+
+// Limits of the forecast_months tag.
+const (
+	defaultForecastMonths = 12
+	maxForecastMonths     = 60
+)
+
+// forecastMonth is one month of a cost forecast.
+type forecastMonth struct {
+	Month        int     `json:"month"`
+	StorageGB    float64 `json:"storage_gb"`
+	CostPerMonth float64 `json:"cost_per_month"`
+	CarbonGrams  float64 `json:"carbon_grams,omitempty"`
+}
+
+// storageGrowth is the monthly growth of a resource's stored volume, either
+// linear (GB per month) or compound (percent per month).
+type storageGrowth struct {
+	gb      float64
+	percent float64
+}
+
+// sizeAt returns the stored volume after the given number of months of growth.
+func (g storageGrowth) sizeAt(base float64, months int) float64 {
+	if g.percent > 0 {
+		return base * math.Pow(1+g.percent/100, float64(months))
+	}
+	return base + g.gb*float64(months)
+}
+
+// parseForecastTags returns the storage growth and forecast length requested
+// by the tags. ok is false when no growth tag is set.
+func parseForecastTags(tags map[string]string) (storageGrowth, int, bool, error) {
+	gbVal, hasGB := tags[metadataKeyMonthlyGrowthGB]
+	percentVal, hasPercent := tags[tagMonthlyGrowthPercent]
+	if !hasGB && !hasPercent {
+		return storageGrowth{}, 0, false, nil
+	}
+	if hasGB && hasPercent {
+		return storageGrowth{}, 0, false, fmt.Errorf("set only one of '%s' and '%s'",
+			metadataKeyMonthlyGrowthGB, tagMonthlyGrowthPercent)
+	}
+
+	var growth storageGrowth
+	var err error
+	if hasGB {
+		growth.gb, err = parseUsageBound(metadataKeyMonthlyGrowthGB, gbVal)
+	} else {
+		growth.percent, err = parseUsageBound(tagMonthlyGrowthPercent, percentVal)
+	}
+	if err != nil {
+		return storageGrowth{}, 0, false, err
+	}
+
+	months := defaultForecastMonths
+	if val, ok := tags[tagForecastMonths]; ok {
+		months, err = strconv.Atoi(strings.TrimSpace(val))
+		if err != nil || months < 1 || months > maxForecastMonths {
+			return storageGrowth{}, 0, false, fmt.Errorf(
+				"invalid value for '%s': %q must be a whole number of months from 1 to %d",
+				tagForecastMonths, val, maxForecastMonths)
+		}
+	}
+	return growth, months, true, nil
+}
+
+// attachForecast adds a multi-month cost series to the projected cost of an
+// accumulating resource tagged with monthly_growth_gb or monthly_growth_percent.
+// Month 1 is the current estimate; each later month re-prices the resource with
+// its storage tag set to the grown volume, so tiered storage moves to cheaper
+// tiers as it crosses tier boundaries and carbon follows the volume. The
+// estimator's growth field names the storage tag; services without one are
+// not forecast. Invalid growth tags are reported as InvalidArgument; a month that
+// cannot be priced leaves the series out and records metadataKeyCostForecastError.
+func (e *funcEstimator) attachForecast(
+	p *AWSPublicPlugin,
+	settings *pluginSettings,
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
+	resp *pbc.GetProjectedCostResponse,
+) error {
+	if e.growth == "" || resp == nil {
+		return nil
+	}
+	growth, months, ok, err := parseForecastTags(resource.GetTags())
+	if err != nil {
+		return p.newErrorWithID(traceID, codes.InvalidArgument, err.Error(),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	if !ok {
+		return nil
+	}
+
+	base := 0.0
+	for _, component := range costComponentsFromMetadata(resp.GetMetadata()) {
+		if component.Dimension == "storage" {
+			base += component.Quantity
+		}
+	}
+
+	series := make([]forecastMonth, 0, months)
+	total := 0.0
+	for month := 1; month <= months; month++ {
+		size := growth.sizeAt(base, month-1)
+		monthResp := resp
+		if month > 1 {
+			priced, cloned := proto.Clone(resource).(*pbc.ResourceDescriptor)
+			if !cloned {
+				setForecastError(resp, "unable to copy resource")
+				return nil
+			}
+			tags := make(map[string]string, len(priced.GetTags())+1)
+			for k, v := range priced.GetTags() {
+				tags[k] = v
+			}
+			tags[e.growth] = formatUsage(size)
+			priced.Tags = tags
+
+			if monthResp, err = e.projected(p, settings, traceID, priced, req); err != nil {
+				p.traceLogger(traceID, "GetProjectedCost").Debug().
+					Err(err).
+					Int("month", month).
+					Msg("unable to price cost forecast")
+				setForecastError(resp, fmt.Sprintf("unable to price month %d: %v", month, err))
+				return nil
+			}
+		}
+
+		total += monthResp.GetCostPerMonth()
+		series = append(series, forecastMonth{
+			Month:        month,
+			StorageGB:    roundQuantity(size),
+			CostPerMonth: math.Round(monthResp.GetCostPerMonth()*100) / 100,
+			CarbonGrams:  math.Round(carbonGrams(monthResp)*100) / 100,
+		})
+	}
+
+	encoded, err := json.Marshal(series)
+	if err != nil {
+		setForecastError(resp, fmt.Sprintf("unable to encode forecast: %v", err))
+		return nil
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 3)
+	}
+	resp.Metadata[metadataKeyCostForecast] = string(encoded)
+	resp.Metadata[metadataKeyCostForecastTotal] = formatMonthlyCost(total)
+	setMonthlyGrowthHint(resource.GetTags(), resp)
+	return nil
+}
+
+// setForecastError records why the forecast of resp was left out.
+func setForecastError(resp *pbc.GetProjectedCostResponse, reason string) {
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 1)
+	}
+	resp.Metadata[metadataKeyCostForecastError] = reason
+}
+
+// carbonGrams returns the monthly carbon footprint reported by a projected cost.
+func carbonGrams(resp *pbc.GetProjectedCostResponse) float64 {
+	for _, metric := range resp.GetImpactMetrics() {
+		if metric.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+			return metric.GetValue()
+		}
+	}
+	return 0
+}
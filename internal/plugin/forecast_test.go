@@ -0,0 +1,204 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// s3StandardTiers are the S3 Standard volume tiers: first 50 TB, next 450 TB, over 500 TB.
+var s3StandardTiers = []pricing.TierRate{
+	{UpTo: 51_200, Rate: 0.023},
+	{UpTo: 512_000, Rate: 0.022},
+	{UpTo: math.MaxFloat64, Rate: 0.021},
+}
+
+// decodeForecast decodes the cost_forecast metadata of a projected cost.
+func decodeForecast(t *testing.T, resp *pbc.GetProjectedCostResponse) []forecastMonth {
+	t.Helper()
+	var series []forecastMonth
+	require.NoError(t, json.Unmarshal([]byte(resp.GetMetadata()[metadataKeyCostForecast]), &series))
+	return series
+}
+
+// TestGetProjectedCost_S3Forecast verifies a linear S3 forecast moves to the
+// next volume tier once the bucket crosses 50 TB.
+func TestGetProjectedCost_S3Forecast(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	plugin.pricing.(*mockPricingClient).s3Tiers = map[string][]pricing.TierRate{"STANDARD": s3StandardTiers}
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:s3/bucket:Bucket",
+			Sku:          "STANDARD",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"size": "51000", "monthly_growth_gb": "100", "forecast_months": "4"},
+		},
+	})
+	require.NoError(t, err)
+
+	series := decodeForecast(t, resp)
+	require.Len(t, series, 4)
+	wantSizes := []float64{51_000, 51_100, 51_200, 51_300}
+	for i, month := range series {
+		assert.Equal(t, i+1, month.Month)
+		assert.InDelta(t, wantSizes[i], month.StorageGB, 1e-9)
+		assert.Positive(t, month.CarbonGrams)
+	}
+	assert.InDelta(t, resp.GetCostPerMonth(), series[0].CostPerMonth, 0.005)
+	assert.InDelta(t, 51_100*0.023, series[1].CostPerMonth, 0.005)
+	assert.InDelta(t, 51_200*0.023+100*0.022, series[3].CostPerMonth, 0.005)
+	assert.Equal(t, formatMonthlyCost((51_000+51_100+51_200+51_200)*0.023+100*0.022),
+		resp.GetMetadata()[metadataKeyCostForecastTotal])
+	assert.Equal(t, "100", resp.GetMetadata()[metadataKeyMonthlyGrowthGB])
+}
+
+// TestGetProjectedCost_DynamoDBForecast verifies compound growth and the
+// default forecast length.
+func TestGetProjectedCost_DynamoDBForecast(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:dynamodb/table:Table",
+			Sku:          "on-demand",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"storage_gb": "100", "monthly_growth_percent": "10"},
+		},
+	})
+	require.NoError(t, err)
+
+	series := decodeForecast(t, resp)
+	require.Len(t, series, defaultForecastMonths)
+	assert.InDelta(t, 100*0.25, series[0].CostPerMonth, 1e-9)
+	assert.InDelta(t, 110*0.25, series[1].CostPerMonth, 1e-9)
+	assert.InDelta(t, 121*0.25, series[2].CostPerMonth, 1e-9)
+	assert.InDelta(t, 100*math.Pow(1.1, 11), series[11].StorageGB, 1e-3)
+	assert.NotContains(t, resp.GetMetadata(), metadataKeyMonthlyGrowthGB)
+}
+
+// TestGetProjectedCost_ForecastNotRequested verifies forecasts are only added
+// to storage services tagged with a growth rate.
+func TestGetProjectedCost_ForecastNotRequested(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name     string
+		resource *pbc.ResourceDescriptor
+	}{
+		{"no growth tag", &pbc.ResourceDescriptor{
+			Provider: providerAWS, ResourceType: "aws:s3/bucket:Bucket", Sku: "STANDARD", Region: "us-east-1",
+			Tags: map[string]string{"size": "100"},
+		}},
+		{"service without storage growth", &pbc.ResourceDescriptor{
+			Provider: providerAWS, ResourceType: "aws:ec2/instance:Instance", Sku: "t3.micro", Region: "us-east-1",
+			Tags: map[string]string{"monthly_growth_gb": "10"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: tt.resource})
+			require.NoError(t, err)
+			assert.NotContains(t, resp.GetMetadata(), metadataKeyCostForecast)
+		})
+	}
+}
+
+// TestGetProjectedCost_InvalidForecastTags verifies invalid growth tags are rejected.
+func TestGetProjectedCost_InvalidForecastTags(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name string
+		tags map[string]string
+	}{
+		{"both growth tags", map[string]string{"monthly_growth_gb": "10", "monthly_growth_percent": "5"}},
+		{"negative growth", map[string]string{"monthly_growth_gb": "-10"}},
+		{"zero months", map[string]string{"monthly_growth_gb": "10", "forecast_months": "0"}},
+		{"too many months", map[string]string{"monthly_growth_gb": "10", "forecast_months": "61"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     providerAWS,
+					ResourceType: "aws:s3/bucket:Bucket",
+					Sku:          "STANDARD",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+// TestAttachForecast_PricingFailure verifies a month that cannot be priced
+// leaves the forecast out and records why, keeping the current estimate.
+func TestAttachForecast_PricingFailure(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	estimator := &funcEstimator{
+		growth: "size",
+		projected: func(
+			_ *AWSPublicPlugin,
+			_ *pluginSettings,
+			_ string,
+			_ *pbc.ResourceDescriptor,
+			_ *pbc.GetProjectedCostRequest,
+		) (*pbc.GetProjectedCostResponse, error) {
+			return nil, errors.New("price list unavailable")
+		},
+	}
+	resource := &pbc.ResourceDescriptor{
+		Provider:     providerAWS,
+		ResourceType: "aws:s3/bucket:Bucket",
+		Sku:          "STANDARD",
+		Region:       "us-east-1",
+		Tags:         map[string]string{"size": "100", "monthly_growth_gb": "10", "forecast_months": "3"},
+	}
+	resp := &pbc.GetProjectedCostResponse{CostPerMonth: 2.3, Currency: "USD"}
+
+	err := estimator.attachForecast(plugin, plugin.config(), "trace", resource,
+		&pbc.GetProjectedCostRequest{Resource: resource}, resp)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.3, resp.GetCostPerMonth(), 1e-9)
+	assert.NotContains(t, resp.GetMetadata(), metadataKeyCostForecast)
+	assert.NotContains(t, resp.GetMetadata(), metadataKeyCostForecastTotal)
+	assert.Equal(t, "unable to price month 2: price list unavailable",
+		resp.GetMetadata()[metadataKeyCostForecastError])
+}
+
+// TestGetProjectedCost_S3VolumeTiers verifies buckets over the first tier are
+// priced at the blended tier rate.
+func TestGetProjectedCost_S3VolumeTiers(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	plugin.pricing.(*mockPricingClient).s3Tiers = map[string][]pricing.TierRate{"STANDARD": s3StandardTiers}
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:s3/bucket:Bucket",
+			Sku:          "STANDARD",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"size": "600000"},
+		},
+	})
+	require.NoError(t, err)
+
+	want := 51_200*0.023 + (512_000-51_200)*0.022 + (600_000-512_000)*0.021
+	assert.InDelta(t, want, resp.GetCostPerMonth(), 1e-6)
+	assert.Contains(t, resp.GetBillingDetail(), "blended across volume tiers")
+}
@@ -0,0 +1,146 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// EnvAccountFreeTier is the environment variable enabling account-level AWS
+// Free Tier modelling in BatchCost projected queries. Accepts the values of
+// parseBoolVal; disabled by default, so estimates are list price.
+const EnvAccountFreeTier = "FINFOCUS_ACCOUNT_FREE_TIER"
+
+// Metadata keys reported on projected costs reduced by the account free tier.
+const (
+	// metadataKeyGrossCost is the monthly cost before the free tier credit.
+	metadataKeyGrossCost = "gross_cost_per_month"
+
+	// metadataKeyFreeTierCredit is the monthly free tier credit allocated to the resource.
+	metadataKeyFreeTierCredit = "free_tier_credit"
+
+	// metadataKeyNetCost is the monthly cost after the credit; equal to cost_per_month.
+	metadataKeyNetCost = "net_cost_per_month"
+
+	// metadataKeyFreeTierAllocations lists the free quantity allocated to the
+	// resource per cost component, as comma-separated "dimension=quantity" pairs.
+	metadataKeyFreeTierAllocations = "free_tier_allocations"
+
+	// metadataKeyAccountFreeTier reports in GetPluginInfo whether the account free tier is applied.
+	metadataKeyAccountFreeTier = "account_free_tier"
+)
+
+// freeTierDimensionSuffix names the credit component of a free tier allocation,
+// e.g. "requests_free_tier".
+const freeTierDimensionSuffix = "_free_tier"
+
+// freeTierAllowance is a monthly AWS Free Tier allowance, shared by every
+// resource of the service in the account. An allowance covering several cost
+// components (e.g., CloudWatch Logs ingestion and storage) is one pool drawn on
+// by all of them.
+type freeTierAllowance struct {
+	service    string
+	dimensions []string // cost component dimensions drawing on the allowance
+	quantity   float64  // free quantity per month, in the components' unit
+}
+
+// accountFreeTier lists the always-free allowances, which do not expire after
+// the first 12 months. They are per account across all regions; the plugin
+// prices one region, so a batch is assumed to be the whole account.
+var accountFreeTier = []freeTierAllowance{
+	{service: serviceLambda, dimensions: []string{"requests"}, quantity: 1_000_000},
+	{service: serviceLambda, dimensions: []string{"duration"}, quantity: 400_000},
+	{service: serviceDynamoDB, dimensions: []string{"storage"}, quantity: 25},
+	{service: serviceDynamoDB, dimensions: []string{"read_capacity"}, quantity: 25 * carbon.HoursPerMonth},
+	{service: serviceDynamoDB, dimensions: []string{"write_capacity"}, quantity: 25 * carbon.HoursPerMonth},
+	{service: serviceCloudWatch, dimensions: []string{"log_ingestion", "log_storage"}, quantity: 5},
+	{service: serviceCloudWatch, dimensions: []string{"custom_metrics"}, quantity: 10},
+	{service: serviceKMS, dimensions: []string{"requests"}, quantity: 20_000},
+	{service: serviceStepFuncs, dimensions: []string{"state_transitions"}, quantity: 4_000},
+}
+
+// freeTierConsumer is one cost component drawing on a free tier allowance.
+type freeTierConsumer struct {
+	result    int // index of the batch result
+	component CostComponent
+}
+
+// applyAccountFreeTier allocates the account free tier across the projected
+// costs of a batch. Each allowance is shared in proportion to the quantity each
+// resource consumes, so the allocation does not depend on resource order. A
+// resource receiving a credit gets a negative "<dimension>_free_tier" cost
+// component per allocation, cost_per_month reduced to the net cost, and the
+// gross cost, credit and net cost in its metadata.
+func (p *AWSPublicPlugin) applyAccountFreeTier(traceID string, results []*pbc.ResourceCostResult) {
+	responses, services := batchProjections(results)
+
+	credits := make(map[int][]CostComponent)
+	for _, allowance := range accountFreeTier {
+		var consumers []freeTierConsumer
+		total := 0.0
+		for i, resp := range responses {
+			if resp == nil || services[i] != allowance.service {
+				continue
+			}
+			for _, component := range costComponentsFromMetadata(resp.GetMetadata()) {
+				if slices.Contains(allowance.dimensions, component.Dimension) && component.Quantity > 0 {
+					consumers = append(consumers, freeTierConsumer{result: i, component: component})
+					total += component.Quantity
+				}
+			}
+		}
+		if total == 0 {
+			continue
+		}
+
+		share := math.Min(1, allowance.quantity/total)
+		for _, consumer := range consumers {
+			credit := consumer.component
+			credit.Dimension += freeTierDimensionSuffix
+			credit.Quantity = -consumer.component.Quantity * share
+			credit.Subtotal = credit.Quantity * credit.Rate
+			credits[consumer.result] = append(credits[consumer.result], credit)
+		}
+	}
+
+	for i, components := range credits {
+		applyFreeTierCredits(responses[i], components)
+	}
+
+	p.traceLogger(traceID, "BatchCost").Debug().
+		Int("resources", len(results)).
+		Int("credited_resources", len(credits)).
+		Msg("account free tier applied")
+}
+
+// applyFreeTierCredits reduces a projected cost by its free tier credit components.
+func applyFreeTierCredits(resp *pbc.GetProjectedCostResponse, credits []CostComponent) {
+	gross := resp.GetCostPerMonth()
+	credit := 0.0
+	allocations := make([]string, 0, len(credits))
+	for _, component := range credits {
+		credit -= component.Subtotal
+		allocations = append(allocations, fmt.Sprintf("%s=%s",
+			strings.TrimSuffix(component.Dimension, freeTierDimensionSuffix),
+			strconv.FormatFloat(-component.Quantity, 'f', -1, 64)))
+	}
+	net := math.Max(0, gross-credit)
+
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 5)
+	}
+	setCostComponents(resp, append(costComponentsFromMetadata(resp.GetMetadata()), credits...))
+	resp.Metadata[metadataKeyGrossCost] = formatMonthlyCost(gross)
+	resp.Metadata[metadataKeyFreeTierCredit] = formatMonthlyCost(credit)
+	resp.Metadata[metadataKeyNetCost] = formatMonthlyCost(net)
+	resp.Metadata[metadataKeyFreeTierAllocations] = strings.Join(allocations, ",")
+
+	resp.CostPerMonth = net
+	resp.BillingDetail += fmt.Sprintf(" (less $%.2f AWS Free Tier credit)", credit)
+}
@@ -0,0 +1,165 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// freeTierBatch is a small sandbox account: two functions sharing the Lambda
+// request allowance, a table over the free storage and an EC2 instance.
+func freeTierBatch() []*pbc.ResourceDescriptor {
+	lambda := func(id string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Id:           id,
+			Provider:     providerAWS,
+			ResourceType: "aws:lambda/function:Function",
+			Sku:          "x86_64",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"requests_per_month": "800000"},
+		}
+	}
+	return []*pbc.ResourceDescriptor{
+		lambda("fn-a"),
+		lambda("fn-b"),
+		{
+			Id:           "table",
+			Provider:     providerAWS,
+			ResourceType: "aws:dynamodb/table:Table",
+			Sku:          "on-demand",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"storage_gb": "30"},
+		},
+		{
+			Id:           "instance",
+			Provider:     providerAWS,
+			ResourceType: "aws:ec2/instance:Instance",
+			Sku:          "t3.micro",
+			Region:       "us-east-1",
+		},
+	}
+}
+
+// TestBatchCost_AccountFreeTier verifies the account free tier is shared across
+// the resources of a batch and reported as gross, credit and net costs.
+func TestBatchCost_AccountFreeTier(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	updateSettings(plugin, func(s *pluginSettings) { s.accountFreeTier = true })
+
+	resp, err := plugin.BatchCost(context.Background(), &pbc.BatchCostRequest{
+		Resources: freeTierBatch(),
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 4)
+
+	// 1.6M requests share the 1M free requests: 500k each. 10,000 GB-seconds
+	// per function fit in the 400,000 free GB-seconds.
+	for _, result := range resp.GetResults()[:2] {
+		projected := result.GetCostData().GetProjectedCost()
+		require.NotNil(t, projected)
+		md := projected.GetMetadata()
+
+		durationCost := 800_000 * 0.1 * 0.125 * 0.0000166667
+		assert.InDelta(t, 800_000*2e-7-500_000*2e-7, projected.GetCostPerMonth(), 1e-9)
+		assert.Equal(t, formatMonthlyCost(800_000*2e-7+durationCost), md[metadataKeyGrossCost])
+		assert.Equal(t, formatMonthlyCost(500_000*2e-7+durationCost), md[metadataKeyFreeTierCredit])
+		assert.Equal(t, formatMonthlyCost(projected.GetCostPerMonth()), md[metadataKeyNetCost])
+		assert.Equal(t, "requests=500000,duration=10000", md[metadataKeyFreeTierAllocations])
+		assert.Contains(t, projected.GetBillingDetail(), "AWS Free Tier credit")
+
+		var sum float64
+		for _, component := range costComponentsFromMetadata(md) {
+			sum += component.Subtotal
+		}
+		assert.InDelta(t, projected.GetCostPerMonth(), sum, 1e-9)
+	}
+
+	table := resp.GetResults()[2].GetCostData().GetProjectedCost()
+	assert.InDelta(t, 5*0.25, table.GetCostPerMonth(), 1e-9)
+	assert.Equal(t, "storage=25", table.GetMetadata()[metadataKeyFreeTierAllocations])
+
+	instance := resp.GetResults()[3].GetCostData().GetProjectedCost()
+	assert.InDelta(t, 0.0104*730, instance.GetCostPerMonth(), 1e-9)
+	assert.NotContains(t, instance.GetMetadata(), metadataKeyFreeTierCredit)
+}
+
+// TestBatchCost_FreeTierSharedAllowance verifies CloudWatch Logs ingestion and
+// storage draw on one shared 5 GB allowance rather than 5 GB each.
+func TestBatchCost_FreeTierSharedAllowance(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	updateSettings(plugin, func(s *pluginSettings) { s.accountFreeTier = true })
+
+	resp, err := plugin.BatchCost(context.Background(), &pbc.BatchCostRequest{
+		Resources: []*pbc.ResourceDescriptor{{
+			Id:           "log-group",
+			Provider:     providerAWS,
+			ResourceType: "aws:cloudwatch/logGroup:LogGroup",
+			Sku:          "logs",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"log_ingestion_gb": "10", "log_storage_gb": "10"},
+		}},
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 1)
+
+	// 20 GB of ingestion and storage share the 5 GB allowance: 2.5 GB each.
+	projected := resp.GetResults()[0].GetCostData().GetProjectedCost()
+	require.NotNil(t, projected)
+	md := projected.GetMetadata()
+	assert.Equal(t, "log_ingestion=2.5,log_storage=2.5", md[metadataKeyFreeTierAllocations])
+	assert.Equal(t, formatMonthlyCost(10*0.50+10*0.03), md[metadataKeyGrossCost])
+	assert.Equal(t, formatMonthlyCost(2.5*0.50+2.5*0.03), md[metadataKeyFreeTierCredit])
+	assert.InDelta(t, 7.5*0.50+7.5*0.03, projected.GetCostPerMonth(), 1e-9)
+}
+
+// TestBatchCost_FreeTierDisabled verifies batches are priced at list price
+// unless the account free tier is enabled.
+func TestBatchCost_FreeTierDisabled(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	resp, err := plugin.BatchCost(context.Background(), &pbc.BatchCostRequest{
+		Resources: freeTierBatch(),
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	})
+	require.NoError(t, err)
+
+	table := resp.GetResults()[2].GetCostData().GetProjectedCost()
+	assert.InDelta(t, 30*0.25, table.GetCostPerMonth(), 1e-9)
+	assert.NotContains(t, table.GetMetadata(), metadataKeyGrossCost)
+}
+
+// TestBatchCost_QueryTypes verifies each query type is answered by the matching
+// single-resource RPC and failures are reported per resource.
+func TestBatchCost_QueryTypes(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	updateSettings(plugin, func(s *pluginSettings) { s.accountFreeTier = true })
+	ctx := context.Background()
+	resources := freeTierBatch()[2:]
+
+	resp, err := plugin.BatchCost(ctx, &pbc.BatchCostRequest{Resources: resources, DryRun: true})
+	require.NoError(t, err)
+	for _, result := range resp.GetResults() {
+		assert.True(t, result.GetCostData().GetDryRunResult().GetResourceTypeSupported())
+	}
+
+	resp, err = plugin.BatchCost(ctx, &pbc.BatchCostRequest{
+		Resources: resources,
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_ESTIMATE,
+	})
+	require.NoError(t, err)
+	for _, result := range resp.GetResults() {
+		assert.NotNil(t, result.GetCostData().GetEstimate(), result.GetResource().GetId())
+	}
+
+	resp, err = plugin.BatchCost(ctx, &pbc.BatchCostRequest{
+		Resources: []*pbc.ResourceDescriptor{nil},
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	})
+	require.NoError(t, err)
+	assert.NotNil(t, resp.GetResults()[0].GetError())
+}
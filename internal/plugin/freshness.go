@@ -0,0 +1,244 @@
+package plugin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+)
+
+const (
+	// EnvPricingStaleAfterDays is the environment variable setting the price list
+	// age in days after which estimates are flagged as stale. "0" disables the flag.
+	EnvPricingStaleAfterDays = "FINFOCUS_PRICING_STALE_AFTER_DAYS"
+	// EnvPricingMaxAgeDays is the environment variable setting the hard price list
+	// age limit in days. When exceeded, DryRun reports an invalid configuration and
+	// the health check fails. Unset or "0" disables the limit.
+	EnvPricingMaxAgeDays = "FINFOCUS_PRICING_MAX_AGE_DAYS"
+	// defaultPricingStaleAfterDays is the default staleness threshold.
+	defaultPricingStaleAfterDays = 90
+)
+
+// Metadata keys for price data freshness.
+const (
+	// metadataKeyPriceDataAgeDays is the age in whole days of the oldest price list
+	// behind an estimate (or, in GetPluginInfo, of any loaded price list).
+	metadataKeyPriceDataAgeDays = "price_data_age_days"
+
+	// metadataKeyPricingStale is "true" when that price list is older than the
+	// staleness threshold, "false" otherwise.
+	metadataKeyPricingStale = "pricing_stale"
+
+	// metadataKeyPriceDataCheckedAt is the RFC 3339 time the age was evaluated.
+	metadataKeyPriceDataCheckedAt = "price_data_checked_at"
+
+	// metadataKeyStalePriceLists lists the offer codes of stale price lists.
+	metadataKeyStalePriceLists = "stale_price_lists"
+
+	// metadataKeyPricingStaleAfterDays and metadataKeyPricingMaxAgeDays report the
+	// configured thresholds in GetPluginInfo.
+	metadataKeyPricingStaleAfterDays = "pricing_stale_after_days"
+	metadataKeyPricingMaxAgeDays     = "pricing_max_age_days"
+)
+
+// Ensure AWSPublicPlugin implements the optional SDK DryRun and health interfaces.
+var (
+	_ pluginsdk.DryRunHandler = (*AWSPublicPlugin)(nil)
+	_ pluginsdk.HealthChecker = (*AWSPublicPlugin)(nil)
+)
+
+// dryRunFocusFields are the FOCUS fields populated on actual cost records
+// (see buildFocusRecord and buildComponentFocusRecord).
+var dryRunFocusFields = []string{
+	"billed_cost", "effective_cost", "list_cost", "list_unit_price",
+	"service_category", "service_name", "service_provider_name",
+	"charge_category", "charge_class", "charge_frequency", "charge_description",
+	"charge_period_start", "charge_period_end",
+	"pricing_category", "pricing_unit", "pricing_quantity",
+	"consumed_quantity", "consumed_unit",
+	"region_id", "billing_currency", "resource_type",
+	"sku_id", "sku_price_id", "sku_meter", "extended_columns", "tags",
+}
+
+// freshnessPolicy holds the price data age thresholds in days. Zero disables a threshold.
+type freshnessPolicy struct {
+	staleAfterDays int
+	maxAgeDays     int
+}
+
+// parseFreshnessPolicy reads the staleness thresholds from the environment,
+// falling back to the configuration file, logging and ignoring invalid values.
+func parseFreshnessPolicy(logger zerolog.Logger, fromFile config.Pricing) freshnessPolicy {
+	policy := freshnessPolicy{staleAfterDays: defaultPricingStaleAfterDays}
+	if fromFile.StaleAfterDays != nil {
+		policy.staleAfterDays = *fromFile.StaleAfterDays
+	}
+	if fromFile.MaxAgeDays != nil {
+		policy.maxAgeDays = *fromFile.MaxAgeDays
+	}
+	return freshnessPolicy{
+		staleAfterDays: parseDaysEnv(logger, EnvPricingStaleAfterDays, policy.staleAfterDays),
+		maxAgeDays:     parseDaysEnv(logger, EnvPricingMaxAgeDays, policy.maxAgeDays),
+	}
+}
+
+// parseDaysEnv returns the non-negative day count in the named environment
+// variable, or fallback when it is unset or invalid.
+func parseDaysEnv(logger zerolog.Logger, name string, fallback int) int {
+	val := os.Getenv(name)
+	if val == "" {
+		return fallback
+	}
+	days, err := strconv.Atoi(strings.TrimSpace(val))
+	if err != nil || days < 0 {
+		logger.Warn().
+			Str("variable", name).
+			Str("value", val).
+			Int("default", fallback).
+			Msg("invalid day count, using default")
+		return fallback
+	}
+	return days
+}
+
+// priceFreshness summarizes the ages of a set of price lists at one point in time.
+type priceFreshness struct {
+	checkedAt time.Time
+	oldest    time.Duration
+	stale     []string // offer codes older than the staleness threshold
+	expired   []string // offer codes older than the hard limit
+}
+
+// ageDays returns a duration in whole days.
+func ageDays(age time.Duration) int {
+	return int(age / (hoursPerDay * time.Hour))
+}
+
+// priceFreshness evaluates the age of the given offers' price lists, or of every
+// loaded price list when offerCodes is empty, against the freshness policy of
+// settings. ok is false when none of them has a known publication date.
+func (p *AWSPublicPlugin) priceFreshness(settings *pluginSettings, offerCodes []string) (priceFreshness, bool) {
+	now := time.Now().UTC()
+	result := priceFreshness{checkedAt: now}
+	found := false
+	policy := settings.freshness
+	for _, listAge := range p.pricing.PriceListAges(now) {
+		if len(offerCodes) > 0 && !slices.Contains(offerCodes, listAge.OfferCode) {
+			continue
+		}
+		found = true
+		result.oldest = max(result.oldest, listAge.Age)
+		days := ageDays(listAge.Age)
+		if policy.staleAfterDays > 0 && days > policy.staleAfterDays {
+			result.stale = append(result.stale, listAge.OfferCode)
+		}
+		if policy.maxAgeDays > 0 && days > policy.maxAgeDays {
+			result.expired = append(result.expired, listAge.OfferCode)
+		}
+	}
+	return result, found
+}
+
+// metadata returns the freshness metadata of an estimate: the age of its oldest
+// price list, whether that is stale and when it was checked.
+func (f priceFreshness) metadata() map[string]string {
+	md := map[string]string{
+		metadataKeyPriceDataAgeDays:   strconv.Itoa(ageDays(f.oldest)),
+		metadataKeyPricingStale:       strconv.FormatBool(len(f.stale) > 0),
+		metadataKeyPriceDataCheckedAt: f.checkedAt.Format(time.RFC3339),
+	}
+	if len(f.stale) > 0 {
+		md[metadataKeyStalePriceLists] = strings.Join(f.stale, ",")
+	}
+	return md
+}
+
+// attachPriceFreshness records how old the price lists behind a projected cost
+// are, so every estimate carries evidence of the data it was built on.
+func (p *AWSPublicPlugin) attachPriceFreshness(
+	settings *pluginSettings,
+	resp *pbc.GetProjectedCostResponse,
+	offerCodes []string,
+) {
+	if resp == nil || len(offerCodes) == 0 {
+		return
+	}
+	freshness, ok := p.priceFreshness(settings, offerCodes)
+	if !ok {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string)
+	}
+	for key, value := range freshness.metadata() {
+		resp.Metadata[key] = value
+	}
+}
+
+// expiredPriceDataError describes the price lists, in offer code order, older
+// than the hard age limit. Returns "" when none are.
+func (p *AWSPublicPlugin) expiredPriceDataError(settings *pluginSettings) string {
+	freshness, ok := p.priceFreshness(settings, nil)
+	if !ok || len(freshness.expired) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("price data for %s is older than the %d day limit (%s)",
+		strings.Join(freshness.expired, ", "), settings.freshness.maxAgeDays, EnvPricingMaxAgeDays)
+}
+
+// Check implements pluginsdk.HealthChecker. The plugin is unhealthy when any
+// loaded price list is older than FINFOCUS_PRICING_MAX_AGE_DAYS.
+func (p *AWSPublicPlugin) Check(_ context.Context) error {
+	if msg := p.expiredPriceDataError(p.config()); msg != "" {
+		return fmt.Errorf("stale pricing data: %s", msg)
+	}
+	return nil
+}
+
+// HandleDryRun implements pluginsdk.DryRunHandler. It reports the FOCUS fields
+// populated for supported resources and marks the configuration invalid when
+// the price data is older than FINFOCUS_PRICING_MAX_AGE_DAYS.
+func (p *AWSPublicPlugin) HandleDryRun(ctx context.Context, req *pbc.DryRunRequest) (*pbc.DryRunResponse, error) {
+	traceID := p.getTraceID(ctx)
+
+	supported := false
+	if req.GetResource() != nil {
+		supports, err := p.Supports(ctx, &pbc.SupportsRequest{Resource: req.GetResource()})
+		if err != nil {
+			return nil, err
+		}
+		supported = supports.GetSupported()
+	}
+
+	mappings := pluginsdk.AllFieldsWithStatus(pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_UNSUPPORTED)
+	if supported {
+		for _, field := range dryRunFocusFields {
+			mappings = pluginsdk.SetFieldStatus(mappings, field,
+				pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_SUPPORTED)
+		}
+	}
+
+	opts := []pluginsdk.DryRunResponseOption{
+		pluginsdk.WithFieldMappings(mappings),
+		pluginsdk.WithResourceTypeSupported(supported),
+		pluginsdk.WithConfigurationValid(true),
+	}
+	if msg := p.expiredPriceDataError(p.config()); msg != "" {
+		p.traceLogger(traceID, "HandleDryRun").Warn().
+			Str("reason", msg).
+			Msg("price data exceeds maximum age")
+		opts = append(opts,
+			pluginsdk.WithConfigurationValid(false),
+			pluginsdk.WithConfigurationErrors([]string{msg}))
+	}
+	return pluginsdk.NewDryRunResponse(opts...), nil
+}
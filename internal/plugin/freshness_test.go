@@ -0,0 +1,226 @@
+package plugin
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// createFreshnessMockPlugin returns the conformance mock with an RDS price list
+// published ageDays ago and an EC2 price list published 10 days ago.
+func createFreshnessMockPlugin(t *testing.T, rdsAgeDays int) *AWSPublicPlugin {
+	t.Helper()
+	plugin := createConformanceMockPlugin("us-east-1")
+	published := func(days int) string {
+		return time.Now().UTC().AddDate(0, 0, -days).Format(time.RFC3339)
+	}
+	plugin.pricing.(*mockPricingClient).priceVintages = []pricing.PriceVintage{
+		{OfferCode: "AmazonEC2", Version: "20250601000000", PublicationDate: published(10)},
+		{OfferCode: "AmazonRDS", Version: "20250101000000", PublicationDate: published(rdsAgeDays)},
+	}
+	return plugin
+}
+
+// TestParseFreshnessPolicy verifies the staleness thresholds are read from the
+// environment, falling back to the defaults for invalid values.
+func TestParseFreshnessPolicy(t *testing.T) {
+	tests := []struct {
+		name       string
+		staleAfter string
+		maxAge     string
+		want       freshnessPolicy
+	}{
+		{"defaults", "", "", freshnessPolicy{staleAfterDays: defaultPricingStaleAfterDays}},
+		{"configured", "30", "180", freshnessPolicy{staleAfterDays: 30, maxAgeDays: 180}},
+		{"zero disables", "0", "0", freshnessPolicy{}},
+		{"invalid values", "-5", "soon", freshnessPolicy{staleAfterDays: defaultPricingStaleAfterDays}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(EnvPricingStaleAfterDays, tt.staleAfter)
+			t.Setenv(EnvPricingMaxAgeDays, tt.maxAge)
+			assert.Equal(t, tt.want, parseFreshnessPolicy(zerolog.Nop(), config.Pricing{}))
+		})
+	}
+}
+
+// TestGetProjectedCost_PriceFreshness verifies estimates report the age of the
+// price lists they were built on and are flagged when those are stale.
+func TestGetProjectedCost_PriceFreshness(t *testing.T) {
+	tests := []struct {
+		name       string
+		rdsAgeDays int
+		wantStale  string
+	}{
+		{"current", 30, "false"},
+		{"stale", 200, "true"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := createFreshnessMockPlugin(t, tt.rdsAgeDays)
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: rdsProvenanceResource(),
+			})
+			require.NoError(t, err)
+
+			md := resp.GetMetadata()
+			assert.Equal(t, strconv.Itoa(tt.rdsAgeDays), md[metadataKeyPriceDataAgeDays],
+				"only the RDS price list is considered")
+			assert.Equal(t, tt.wantStale, md[metadataKeyPricingStale])
+			checkedAt, err := time.Parse(time.RFC3339, md[metadataKeyPriceDataCheckedAt])
+			require.NoError(t, err)
+			assert.WithinDuration(t, time.Now(), checkedAt, time.Minute)
+			if tt.wantStale == "true" {
+				assert.Equal(t, "AmazonRDS", md[metadataKeyStalePriceLists])
+			} else {
+				assert.NotContains(t, md, metadataKeyStalePriceLists)
+			}
+		})
+	}
+
+	t.Run("unknown vintage adds nothing", func(t *testing.T) {
+		resp, err := createConformanceMockPlugin("us-east-1").GetProjectedCost(context.Background(),
+			&pbc.GetProjectedCostRequest{Resource: rdsProvenanceResource()})
+		require.NoError(t, err)
+		assert.NotContains(t, resp.GetMetadata(), metadataKeyPriceDataAgeDays)
+	})
+}
+
+// TestGetPluginInfo_PriceFreshness verifies GetPluginInfo reports the oldest
+// price list and the configured thresholds.
+func TestGetPluginInfo_PriceFreshness(t *testing.T) {
+	plugin := createFreshnessMockPlugin(t, 200)
+	updateSettings(plugin, func(s *pluginSettings) { s.freshness = freshnessPolicy{staleAfterDays: 90, maxAgeDays: 365} })
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	require.NoError(t, err)
+
+	md := resp.GetMetadata()
+	assert.Equal(t, "200", md[metadataKeyPriceDataAgeDays])
+	assert.Equal(t, "true", md[metadataKeyPricingStale])
+	assert.Equal(t, "AmazonRDS", md[metadataKeyStalePriceLists])
+	assert.Equal(t, "90", md[metadataKeyPricingStaleAfterDays])
+	assert.Equal(t, "365", md[metadataKeyPricingMaxAgeDays])
+}
+
+// TestGetPricingSpec_PriceFreshness verifies the pricing spec carries the age
+// of its price lists.
+func TestGetPricingSpec_PriceFreshness(t *testing.T) {
+	plugin := createFreshnessMockPlugin(t, 200)
+
+	resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+		Resource: rdsProvenanceResource(),
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "200", resp.GetSpec().GetPluginMetadata()[metadataKeyPriceDataAgeDays])
+	assert.Equal(t, "true", resp.GetSpec().GetPluginMetadata()[metadataKeyPricingStale])
+}
+
+// TestEstimateCost_PriceFreshness verifies EstimateCost returns the price data
+// age as response header metadata.
+func TestEstimateCost_PriceFreshness(t *testing.T) {
+	plugin := createFreshnessMockPlugin(t, 200)
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"instanceClass":    "db.t3.micro",
+		"engine":           "mysql",
+		"allocatedStorage": float64(100),
+	})
+	require.NoError(t, err)
+
+	stream := &headerCaptureStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+		ResourceType: "aws:rds/instance:Instance",
+		Attributes:   attrs,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"200"}, stream.header.Get(metadataKeyPriceDataAgeDays))
+	assert.Equal(t, []string{"true"}, stream.header.Get(metadataKeyPricingStale))
+	assert.Len(t, stream.header.Get(metadataKeyPriceDataCheckedAt), 1)
+}
+
+// TestPriceDataMaxAge verifies the hard age limit fails the health check and
+// invalidates the DryRun configuration.
+func TestPriceDataMaxAge(t *testing.T) {
+	ctx := context.Background()
+	dryRun := &pbc.DryRunRequest{Resource: rdsProvenanceResource()}
+
+	tests := []struct {
+		name      string
+		policy    freshnessPolicy
+		wantValid bool
+	}{
+		{"within limit", freshnessPolicy{staleAfterDays: 90, maxAgeDays: 365}, true},
+		{"limit exceeded", freshnessPolicy{staleAfterDays: 90, maxAgeDays: 180}, false},
+		{"limit disabled", freshnessPolicy{staleAfterDays: 90}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plugin := createFreshnessMockPlugin(t, 200)
+			updateSettings(plugin, func(s *pluginSettings) { s.freshness = tt.policy })
+
+			err := plugin.Check(ctx)
+			resp, dryRunErr := plugin.HandleDryRun(ctx, dryRun)
+			require.NoError(t, dryRunErr)
+			assert.True(t, resp.GetResourceTypeSupported())
+			assert.Equal(t, tt.wantValid, resp.GetConfigurationValid())
+
+			if tt.wantValid {
+				require.NoError(t, err)
+				assert.Empty(t, resp.GetConfigurationErrors())
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), "AmazonRDS")
+			assert.NotContains(t, err.Error(), "AmazonEC2")
+			require.Len(t, resp.GetConfigurationErrors(), 1)
+			assert.Contains(t, resp.GetConfigurationErrors()[0], EnvPricingMaxAgeDays)
+		})
+	}
+}
+
+// TestHandleDryRun_FieldMappings verifies supported resources report the FOCUS
+// fields actual cost records populate and unsupported ones report none.
+func TestHandleDryRun_FieldMappings(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	statuses := func(resp *pbc.DryRunResponse) map[string]pbc.FieldSupportStatus {
+		out := make(map[string]pbc.FieldSupportStatus)
+		for _, mapping := range resp.GetFieldMappings() {
+			out[mapping.GetFieldName()] = mapping.GetSupportStatus()
+		}
+		return out
+	}
+
+	resp, err := plugin.HandleDryRun(ctx, &pbc.DryRunRequest{Resource: rdsProvenanceResource()})
+	require.NoError(t, err)
+	assert.True(t, resp.GetConfigurationValid())
+	got := statuses(resp)
+	assert.Equal(t, pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_SUPPORTED, got["billed_cost"])
+	assert.Equal(t, pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_SUPPORTED, got["sku_price_id"])
+	assert.Equal(t, pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_UNSUPPORTED, got["billing_account_id"])
+
+	resp, err = plugin.HandleDryRun(ctx, &pbc.DryRunRequest{Resource: &pbc.ResourceDescriptor{
+		Provider:     "gcp",
+		ResourceType: "gcp:compute/instance:Instance",
+	}})
+	require.NoError(t, err)
+	assert.False(t, resp.GetResourceTypeSupported())
+	assert.Equal(t, pbc.FieldSupportStatus_FIELD_SUPPORT_STATUS_UNSUPPORTED, statuses(resp)["billed_cost"])
+}
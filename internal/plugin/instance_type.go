@@ -150,3 +150,50 @@ var rdsGravitonSupportedEngines = map[string]bool{
 	"aurora-mysql":      true,
 	"aurora-postgresql": true,
 }
+
+// redshiftGenerationUpgradeMap maps previous-generation Redshift node types to
+// their RA3 replacements. Unlike EC2 and RDS, Redshift node sizes do not line up
+// across generations, so the map is keyed by full node type.
+// RA3 separates compute from storage; managed storage is billed separately.
+var redshiftGenerationUpgradeMap = map[string]string{
+	"dc2.large":   "ra3.large",
+	"dc2.8xlarge": "ra3.4xlarge",
+}
+
+// clusterDatabaseGenerationUpgradeMap maps DocumentDB, Neptune and MemoryDB instance
+// families to newer generations. The three services reuse RDS "db." class names but
+// each offers a different subset, so a recommendation is only made when the target
+// class is present in that service's pricing data.
+var clusterDatabaseGenerationUpgradeMap = map[string]string{
+	"db.r4":  "db.r5",
+	"db.r5":  "db.r6i",
+	"db.r6g": "db.r7g",
+}
+
+// clusterDatabaseGravitonMap maps x86 DocumentDB, Neptune and MemoryDB families to
+// Graviton equivalents. Unlike RDS, every engine in these services supports Graviton.
+var clusterDatabaseGravitonMap = map[string]string{
+	"db.r5":  "db.r6g",
+	"db.r6i": "db.r6g",
+	"db.t3":  "db.t4g",
+}
+
+// parseMSKBrokerType splits an MSK broker instance type into family and size.
+// Example: "kafka.m5.large" → ("kafka.m5", "large")
+// Returns empty strings if the format is invalid.
+func parseMSKBrokerType(instanceType string) (string, string) {
+	if !strings.HasPrefix(instanceType, "kafka.") {
+		return "", ""
+	}
+	family, size := parseInstanceType(strings.TrimPrefix(instanceType, "kafka."))
+	if family == "" {
+		return "", ""
+	}
+	return "kafka." + family, size
+}
+
+// mskGravitonMap maps x86 MSK broker families to Graviton equivalents, mirroring
+// gravitonMap for EC2. MSK offers no Graviton2 brokers, so M5 maps straight to M7g.
+var mskGravitonMap = map[string]string{
+	"kafka.m5": "kafka.m7g",
+}
@@ -0,0 +1,289 @@
+package plugin
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// tagCloudWatchMetricData carries measured usage for GetActualCost as the JSON
+// output of "aws cloudwatch get-metric-data" for the resource and the query
+// period. Metrics are matched by label, which defaults to the metric name.
+const tagCloudWatchMetricData = "cloudwatch_metric_data"
+
+// bytesPerGB converts CloudWatch byte metrics to the GB AWS bills by.
+const bytesPerGB = 1 << 30
+
+// measuredQuantity is a usage quantity measured over the GetActualCost period,
+// supplied as a "measured_*" tag, and the estimator tag it is priced through.
+type measuredQuantity struct {
+	tag     string // measured total over the period, e.g. "measured_requests"
+	monthly string // estimator tag priced with the measured usage, e.g. "requests_per_month"
+
+	// per names the measured tag the total is averaged over instead of being
+	// converted to a monthly rate, e.g. total duration over requests.
+	per string
+
+	whole bool // estimator tag only accepts whole numbers
+}
+
+// cloudWatchMetric maps a CloudWatch metric onto a measured tag.
+type cloudWatchMetric struct {
+	label string  // metric label in the get-metric-data output
+	tag   string  // measured tag the metric supplies; metrics of one tag are summed
+	scale float64 // converts the metric unit to the tag unit
+
+	// level marks storage size metrics (e.g. BucketSizeBytes) whose average
+	// over the period is converted to GB-months, rather than summed.
+	level bool
+}
+
+// measuredUsage lists the quantities a usage-based service can be priced
+// from in GetActualCost, and the CloudWatch metrics that measure them.
+type measuredUsage struct {
+	quantities []measuredQuantity
+	metrics    []cloudWatchMetric
+}
+
+// measuredUsageByService holds the services whose actual cost depends on usage
+// rather than running time. The estimator tags are the usage tags of the
+// service's GetProjectedCost estimator.
+var measuredUsageByService = map[string]measuredUsage{
+	serviceLambda: {
+		quantities: []measuredQuantity{
+			{tag: "measured_requests", monthly: "requests_per_month", whole: true},
+			{tag: "measured_duration_ms", monthly: "avg_duration_ms", per: "measured_requests", whole: true},
+		},
+		metrics: []cloudWatchMetric{
+			{label: "Invocations", tag: "measured_requests", scale: 1},
+			{label: "Duration", tag: "measured_duration_ms", scale: 1},
+		},
+	},
+	serviceDynamoDB: {
+		quantities: []measuredQuantity{
+			{tag: "measured_read_requests", monthly: "read_requests_per_month", whole: true},
+			{tag: "measured_write_requests", monthly: "write_requests_per_month", whole: true},
+			{tag: "measured_storage_gb_months", monthly: "storage_gb"},
+		},
+		metrics: []cloudWatchMetric{
+			{label: "ConsumedReadCapacityUnits", tag: "measured_read_requests", scale: 1},
+			{label: "ConsumedWriteCapacityUnits", tag: "measured_write_requests", scale: 1},
+		},
+	},
+	serviceNATGW: {
+		quantities: []measuredQuantity{
+			{tag: "measured_data_processed_gb", monthly: "data_processed_gb"},
+		},
+		metrics: []cloudWatchMetric{
+			{label: "BytesOutToDestination", tag: "measured_data_processed_gb", scale: 1.0 / bytesPerGB},
+			{label: "BytesOutToSource", tag: "measured_data_processed_gb", scale: 1.0 / bytesPerGB},
+		},
+	},
+	serviceCloudWatch: {
+		quantities: []measuredQuantity{
+			{tag: "measured_log_ingestion_gb", monthly: "log_ingestion_gb"},
+			{tag: "measured_log_storage_gb_months", monthly: "log_storage_gb"},
+		},
+		metrics: []cloudWatchMetric{
+			{label: "IncomingBytes", tag: "measured_log_ingestion_gb", scale: 1.0 / bytesPerGB},
+		},
+	},
+	serviceS3: {
+		quantities: []measuredQuantity{
+			{tag: "measured_storage_gb_months", monthly: "size"},
+		},
+		metrics: []cloudWatchMetric{
+			{label: "BucketSizeBytes", tag: "measured_storage_gb_months", scale: 1.0 / bytesPerGB, level: true},
+		},
+	},
+}
+
+// usageSource describes how the usage of an actual cost was obtained.
+type usageSource int
+
+const (
+	usageNotApplicable usageSource = iota // service billed by running time
+	usageMeasured                         // all usage measured for the period
+	usageEstimated                        // usage from monthly tags, some possibly measured
+	usageAssumed                          // some usage defaulted by the estimator
+)
+
+// applyMeasuredUsage prices a usage-based resource from the usage measured over
+// the query period. Measured totals from "measured_*" tags, or from a CloudWatch
+// metric data export, are converted to the monthly rates the estimator takes,
+// so prorating the monthly cost over runtimeHours prices exactly the measured
+// quantities. Tiers are applied to the monthly rate, as AWS bills them per month.
+//
+// Returns the resource to price, unchanged when nothing was measured, and the
+// measured tags applied. Invalid measurements are reported as InvalidArgument.
+func (p *AWSPublicPlugin) applyMeasuredUsage(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	serviceType string,
+	tags map[string]string,
+	runtimeHours float64,
+) (*pbc.ResourceDescriptor, []string, error) {
+	spec, ok := measuredUsageFor(serviceType)
+	if !ok || runtimeHours <= 0 {
+		return resource, nil, nil
+	}
+
+	measured, err := measuredTotals(spec, tags, runtimeHours)
+	if err != nil {
+		return nil, nil, p.newErrorWithID(traceID, codes.InvalidArgument, err.Error(),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	if len(measured) == 0 {
+		return resource, nil, nil
+	}
+
+	priced := cloneDescriptor(resource)
+	priced.Tags = maps.Clone(resource.GetTags())
+	if priced.Tags == nil {
+		priced.Tags = make(map[string]string, len(measured))
+	}
+	var applied []string
+	for _, quantity := range spec.quantities {
+		total, found := measured[quantity.tag]
+		if !found {
+			continue
+		}
+		value := total * carbon.HoursPerMonth / runtimeHours
+		if quantity.per != "" {
+			per, perFound := measured[quantity.per]
+			if !perFound || per == 0 {
+				continue
+			}
+			value = total / per
+		}
+		if quantity.whole {
+			value = math.Round(value)
+			if quantity.per != "" {
+				value = math.Max(1, value) // averages such as duration must be positive
+			}
+			priced.Tags[quantity.monthly] = strconv.FormatFloat(value, 'f', 0, 64)
+		} else {
+			priced.Tags[quantity.monthly] = formatUsage(value)
+		}
+		applied = append(applied, quantity.tag)
+	}
+	return priced, applied, nil
+}
+
+// measuredUsageFor returns the measured usage spec of a service, if it is usage-based.
+func measuredUsageFor(serviceType string) (measuredUsage, bool) {
+	if canonical, ok := serviceAliases[serviceType]; ok {
+		serviceType = canonical
+	}
+	spec, ok := measuredUsageByService[serviceType]
+	return spec, ok
+}
+
+// measuredTotals collects the measured totals of a service from its
+// CloudWatch metric data export and its "measured_*" tags. Tags take
+// precedence over the export.
+func measuredTotals(spec measuredUsage, tags map[string]string, runtimeHours float64) (map[string]float64, error) {
+	measured := make(map[string]float64)
+	if export, ok := tags[tagCloudWatchMetricData]; ok {
+		if err := addCloudWatchMetrics(measured, spec, export, runtimeHours); err != nil {
+			return nil, err
+		}
+	}
+	for _, quantity := range spec.quantities {
+		val, ok := tags[quantity.tag]
+		if !ok {
+			continue
+		}
+		total, err := parseUsageBound(quantity.tag, val)
+		if err != nil {
+			return nil, err
+		}
+		measured[quantity.tag] = total
+	}
+	return measured, nil
+}
+
+// metricDataOutput is the subset of the get-metric-data output read for measured usage.
+type metricDataOutput struct {
+	MetricDataResults []struct {
+		Label  string    `json:"Label"`
+		Values []float64 `json:"Values"`
+	} `json:"MetricDataResults"`
+}
+
+// addCloudWatchMetrics adds the measured totals found in a get-metric-data
+// export. Count and byte metrics should be queried with the Sum statistic,
+// storage size metrics with Average.
+func addCloudWatchMetrics(measured map[string]float64, spec measuredUsage, export string, runtimeHours float64) error {
+	var output metricDataOutput
+	if err := json.Unmarshal([]byte(export), &output); err != nil {
+		return fmt.Errorf("invalid value for '%s': not get-metric-data JSON output: %w", tagCloudWatchMetricData, err)
+	}
+	for _, result := range output.MetricDataResults {
+		for _, metric := range spec.metrics {
+			if result.Label != metric.label || len(result.Values) == 0 {
+				continue
+			}
+			sum := 0.0
+			for _, v := range result.Values {
+				if v < 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+					return fmt.Errorf("invalid value for '%s': metric %q has a negative or non-finite value",
+						tagCloudWatchMetricData, result.Label)
+				}
+				sum += v
+			}
+			if metric.level {
+				// Average size over the period, as GB-months
+				sum = sum / float64(len(result.Values)) * runtimeHours / carbon.HoursPerMonth
+			}
+			measured[metric.tag] += sum * metric.scale
+		}
+	}
+	return nil
+}
+
+// measuredUsageSource classifies the usage an actual cost was priced from:
+// measured when every usage quantity of the service was measured, assumed
+// when the estimator defaulted one that was not, estimated otherwise (usage
+// from monthly tags). applied lists the measured tags applied.
+func measuredUsageSource(serviceType string, applied []string, projectedMetadata map[string]string) usageSource {
+	spec, ok := measuredUsageFor(serviceType)
+	if !ok {
+		return usageNotApplicable
+	}
+	defaulted := defaultedFields(projectedMetadata)
+	source := usageMeasured
+	for _, quantity := range spec.quantities {
+		switch {
+		case slices.Contains(applied, quantity.tag):
+		case defaulted[quantity.monthly]:
+			return usageAssumed
+		default:
+			source = usageEstimated
+		}
+	}
+	return source
+}
+
+// usageConfidence lowers the timestamp confidence of an actual cost by how its
+// usage was obtained: LOW when usage was assumed, at most MEDIUM when it came
+// from monthly estimates rather than measurement.
+func usageConfidence(confidence ConfidenceLevel, source usageSource) ConfidenceLevel {
+	switch source {
+	case usageAssumed:
+		return ConfidenceLow
+	case usageEstimated:
+		if confidence == ConfidenceHigh {
+			return ConfidenceMedium
+		}
+	}
+	return confidence
+}
@@ -0,0 +1,122 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// actualCostRequest builds a GetActualCost request over the given number of hours.
+func actualCostRequest(resourceType, sku string, tags map[string]string, hours float64) *pbc.GetActualCostRequest {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON(providerAWS, resourceType, sku, "us-east-1", tags),
+		Start:      timestamppb.New(from),
+		End:        timestamppb.New(from.Add(time.Duration(hours * float64(time.Hour)))),
+	}
+}
+
+// actualCostTotal sums the cost of the results.
+func actualCostTotal(resp *pbc.GetActualCostResponse) float64 {
+	total := 0.0
+	for _, result := range resp.GetResults() {
+		total += result.GetCost()
+	}
+	return total
+}
+
+// TestGetActualCost_MeasuredUsage verifies measured Lambda usage is priced
+// exactly, whatever the length of the period.
+func TestGetActualCost_MeasuredUsage(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	resp, err := plugin.GetActualCost(context.Background(), actualCostRequest("aws:lambda/function:Function", "128",
+		map[string]string{"measured_requests": "100000", "measured_duration_ms": "20000000"}, 73))
+	require.NoError(t, err)
+	require.NotEmpty(t, resp.GetResults())
+
+	// 100,000 requests of 200 ms at 128 MB: 2,500 GB-seconds
+	assert.InDelta(t, 100_000*2e-7+2_500*0.0000166667, actualCostTotal(resp), 1e-9)
+	for _, result := range resp.GetResults() {
+		assert.Contains(t, result.GetSource(), "[confidence:HIGH] measured usage")
+		if result.GetUsageUnit() == "Requests" {
+			assert.InDelta(t, 100_000, result.GetUsageAmount(), 1e-6)
+		}
+	}
+}
+
+// TestGetActualCost_CloudWatchMetricData verifies a get-metric-data export is
+// summed per metric and priced with the fixed charges prorated.
+func TestGetActualCost_CloudWatchMetricData(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	export := `{"MetricDataResults": [
+		{"Id": "m1", "Label": "BytesOutToDestination", "Values": [5368709120, 5368709120], "StatusCode": "Complete"},
+		{"Id": "m2", "Label": "BytesOutToSource", "Values": [10737418240], "StatusCode": "Complete"},
+		{"Id": "m3", "Label": "ActiveConnectionCount", "Values": [99999]}
+	]}`
+
+	resp, err := plugin.GetActualCost(context.Background(), actualCostRequest("aws:ec2/natGateway:NatGateway", "",
+		map[string]string{"cloudwatch_metric_data": export}, 365))
+	require.NoError(t, err)
+
+	// Half a month of the hourly charge plus the 20 GB processed
+	assert.InDelta(t, 365*0.045+20*0.045, actualCostTotal(resp), 1e-9)
+	assert.Contains(t, resp.GetResults()[0].GetSource(), "measured usage")
+}
+
+// TestGetActualCost_UsageConfidence verifies confidence reflects measured,
+// estimated and assumed usage, and services billed by time are unaffected.
+func TestGetActualCost_UsageConfidence(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		want         string
+	}{
+		{"assumed", "aws:lambda/function:Function", "128", nil, "[confidence:LOW] assumed usage"},
+		{"estimated", "aws:lambda/function:Function", "128",
+			map[string]string{"requests_per_month": "1000000", "avg_duration_ms": "200"},
+			"[confidence:MEDIUM] estimated usage"},
+		{"partly measured", "aws:lambda/function:Function", "128",
+			map[string]string{"measured_requests": "1000", "avg_duration_ms": "200"},
+			"[confidence:MEDIUM] estimated usage"},
+		{"billed by time", "aws:ec2/instance:Instance", "t3.micro", nil, "[confidence:HIGH] |"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetActualCost(context.Background(),
+				actualCostRequest(tt.resourceType, tt.sku, tt.tags, 24))
+			require.NoError(t, err)
+			require.NotEmpty(t, resp.GetResults())
+			assert.Contains(t, resp.GetResults()[0].GetSource(), tt.want)
+		})
+	}
+}
+
+// TestGetActualCost_InvalidMeasuredUsage verifies invalid measurements are rejected.
+func TestGetActualCost_InvalidMeasuredUsage(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	for name, tags := range map[string]map[string]string{
+		"negative tag":  {"measured_requests": "-1"},
+		"invalid JSON":  {"cloudwatch_metric_data": "not json"},
+		"negative data": {"cloudwatch_metric_data": `{"MetricDataResults": [{"Label": "Invocations", "Values": [-5]}]}`},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := plugin.GetActualCost(context.Background(),
+				actualCostRequest("aws:lambda/function:Function", "128", tags, 24))
+			require.Error(t, err)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,241 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_MSK verifies broker hours, per-broker storage and provisioned
+// throughput for provisioned clusters, and cluster, partition and data pricing for
+// MSK Serverless.
+func TestGetProjectedCost_MSK(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "provisioned defaults",
+			resourceType: "aws:msk/cluster:Cluster",
+			sku:          "kafka.m5.large",
+			tags:         nil,
+			wantCost:     0.21*3*730 + 1000*0.10*3,
+			wantDetail:   "MSK kafka.m5.large, 3 broker(s), 730 hrs/month + 1000GB storage per broker",
+			wantDefaults: "number_of_broker_nodes=3,volume_size=1000",
+		},
+		{
+			name:         "provisioned with throughput",
+			resourceType: "aws:msk/cluster:Cluster",
+			sku:          "kafka.m5.large",
+			tags: map[string]string{
+				"number_of_broker_nodes":      "6",
+				"volume_size":                 "500",
+				"provisioned_throughput_mbps": "250",
+			},
+			wantCost:   0.21*6*730 + 500*0.10*6 + 250*0.08*6,
+			wantDetail: "250 MB/s provisioned throughput per broker",
+		},
+		{
+			name:         "serverless defaults",
+			resourceType: "aws:msk/serverlessCluster:ServerlessCluster",
+			sku:          "serverless",
+			tags:         nil,
+			wantCost:     0.75 * 730,
+			wantDetail:   "MSK Serverless, 730 cluster-hrs/month",
+			wantDefaults: "partition_count=0,data_in_gb_per_month=0,data_out_gb_per_month=0",
+		},
+		{
+			name:         "serverless with usage",
+			resourceType: "aws:msk/serverlessCluster:ServerlessCluster",
+			sku:          "serverless",
+			tags: map[string]string{
+				"partition_count":       "100",
+				"data_in_gb_per_month":  "500",
+				"data_out_gb_per_month": "1000",
+				"storage_gb":            "200",
+			},
+			wantCost:   0.75*730 + 100*0.0015*730 + 500*0.10 + 1000*0.05 + 200*0.10,
+			wantDetail: "100 partition(s), 500GB in, 1000GB out/month, 200GB storage",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("invalid broker count is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:msk/cluster:Cluster",
+				Sku:          "kafka.m5.large",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"number_of_broker_nodes": "0"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown broker type returns $0 with explanation", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:msk/cluster:Cluster",
+				Sku:          "kafka.x9.large",
+				Region:       "us-east-1",
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), `MSK broker type "kafka.x9.large" not found`)
+	})
+}
+
+// TestDetectService_MSK verifies Pulumi resource types route to the MSK estimator.
+func TestDetectService_MSK(t *testing.T) {
+	tests := []string{
+		"aws:msk/cluster:Cluster",
+		"aws:msk/serverlessCluster:ServerlessCluster",
+		"msk",
+	}
+
+	for _, resourceType := range tests {
+		t.Run(resourceType, func(t *testing.T) {
+			assert.Equal(t, serviceMSK, detectService(normalizeResourceType(resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_MSKConfiguration verifies configurations, SCRAM secret
+// associations and cluster policies are not priced as clusters.
+func TestGetProjectedCost_MSKConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:msk/configuration:Configuration",
+		"aws:msk/scramSecretAssociation:ScramSecretAssociation",
+		"aws:msk/clusterPolicy:ClusterPolicy",
+	)
+}
+
+// TestGetPricingSpec_MSK verifies the broker and Serverless cluster-hour specs.
+func TestGetPricingSpec_MSK(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+		wantUnit     string
+	}{
+		{"aws:msk/cluster:Cluster", "kafka.m5.large", 0.21, "hour"},
+		{"aws:msk/serverlessCluster:ServerlessCluster", "serverless", 0.75, "cluster-hour"},
+		{"aws:msk/cluster:Cluster", "kafka.x9.large", 0, "hour"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.sku, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "per_hour", resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
+
+// TestGetRecommendations_MSK verifies kafka.m5 brokers are recommended for
+// migration to kafka.m7g through mskGravitonMap, scaled by broker count.
+func TestGetRecommendations_MSK(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name        string
+		sku         string
+		tags        map[string]string
+		wantType    string
+		wantSavings float64
+	}{
+		{
+			name:        "m5 to m7g with default broker count",
+			sku:         "kafka.m5.large",
+			wantType:    "kafka.m7g.large",
+			wantSavings: (0.21 - 0.204) * 3 * 730,
+		},
+		{
+			name:        "m5 to m7g with six brokers",
+			sku:         "kafka.m5.large",
+			tags:        map[string]string{"number_of_broker_nodes": "6"},
+			wantType:    "kafka.m7g.large",
+			wantSavings: (0.21 - 0.204) * 6 * 730,
+		},
+		{
+			name: "t3 has no Graviton broker",
+			sku:  "kafka.t3.small",
+		},
+		{
+			name: "already Graviton",
+			sku:  "kafka.m7g.large",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{
+					{
+						Provider:     "aws",
+						ResourceType: "aws:msk/cluster:Cluster",
+						Sku:          tt.sku,
+						Region:       "us-east-1",
+						Tags:         tt.tags,
+					},
+				},
+			})
+			require.NoError(t, err)
+			if tt.wantType == "" {
+				assert.Empty(t, resp.GetRecommendations())
+				return
+			}
+
+			require.Len(t, resp.GetRecommendations(), 1)
+			rec := resp.GetRecommendations()[0]
+			assert.Equal(t, modTypeGraviton, rec.GetModify().GetModificationType())
+			assert.Equal(t, tt.wantType, rec.GetModify().GetRecommendedConfig()["instance_type"])
+			assert.Equal(t, archARM64, rec.GetModify().GetRecommendedConfig()["architecture"])
+			assert.InDelta(t, tt.wantSavings, rec.GetImpact().GetEstimatedSavings(), 1e-6)
+		})
+	}
+}
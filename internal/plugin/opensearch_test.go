@@ -0,0 +1,286 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_OpenSearch verifies node tiers are multiplied by node count
+// and storage is charged per data node.
+func TestGetProjectedCost_OpenSearch(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+	}{
+		{
+			name:         "single data node with defaults",
+			sku:          "r6g.large.search",
+			tags:         nil,
+			wantCost:     0.167*730 + 0.122*10,
+			wantDefaults: "instance_count=1,volume_type=gp3,volume_size=10",
+		},
+		{
+			name: "data nodes with gp2 storage",
+			sku:  "r6g.large.search",
+			tags: map[string]string{
+				"instance_count": "3",
+				"volume_type":    "gp2",
+				"volume_size":    "100",
+			},
+			wantCost:     0.167*3*730 + 0.135*100*3,
+			wantDefaults: "",
+		},
+		{
+			name: "dedicated masters and UltraWarm",
+			sku:  "r6g.large.search",
+			tags: map[string]string{
+				"instance_count":         "2",
+				"dedicated_master_type":  "m5.large.search",
+				"dedicated_master_count": "3",
+				"warm_type":              "ultrawarm1.medium.search",
+				"volume_size":            "0",
+				"volume_type":            "gp3",
+			},
+			wantCost:     0.167*2*730 + 0.142*3*730 + 0.238*2*730,
+			wantDefaults: "warm_count=2",
+		},
+		{
+			name: "legacy elasticsearch suffix",
+			sku:  "r6g.large.elasticsearch",
+			tags: map[string]string{
+				"instance_count": "1",
+				"volume_type":    "gp3",
+				"volume_size":    "20",
+			},
+			wantCost:     0.167*730 + 0.122*20,
+			wantDefaults: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:opensearch/domain:Domain",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.InDelta(t, 0.167, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "OpenSearch")
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestGetProjectedCost_OpenSearch_Carbon verifies carbon scales with data and master nodes.
+func TestGetProjectedCost_OpenSearch_Carbon(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	carbonFor := func(tags map[string]string) float64 {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:opensearch/domain:Domain",
+				Sku:          "m5.large.search",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetImpactMetrics(), 1)
+		assert.Equal(t, pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT, resp.GetImpactMetrics()[0].GetKind())
+		return resp.GetImpactMetrics()[0].GetValue()
+	}
+
+	single := carbonFor(map[string]string{"instance_count": "1"})
+	assert.Positive(t, single)
+	assert.InDelta(t, single*2, carbonFor(map[string]string{"instance_count": "2"}), 1e-6)
+	assert.InDelta(t, single*4, carbonFor(map[string]string{
+		"instance_count":         "1",
+		"dedicated_master_type":  "m5.large.search",
+		"dedicated_master_count": "3",
+	}), 1e-6)
+}
+
+// TestGetProjectedCost_OpenSearchServerless verifies OCU-based pricing for collections.
+func TestGetProjectedCost_OpenSearchServerless(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+	}{
+		{
+			name:         "default OCUs",
+			tags:         nil,
+			wantCost:     2 * 0.24 * 730,
+			wantDefaults: "indexing_ocu=1,search_ocu=1",
+		},
+		{
+			name:         "fractional OCUs",
+			tags:         map[string]string{"indexing_ocu": "0.5", "search_ocu": "0.5"},
+			wantCost:     1 * 0.24 * 730,
+			wantDefaults: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:opensearch/serverlessCollection:ServerlessCollection",
+					Sku:          "serverless",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), "OpenSearch Serverless")
+			assert.Empty(t, resp.GetImpactMetrics())
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestGetProjectedCost_OpenSearch_Errors verifies invalid input and missing pricing handling.
+func TestGetProjectedCost_OpenSearch_Errors(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	t.Run("missing instance type", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:opensearch/domain:Domain",
+				Region:       "us-east-1",
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid instance count", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:opensearch/domain:Domain",
+				Sku:          "r6g.large.search",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"instance_count": "zero"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown instance type", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:opensearch/domain:Domain",
+				Sku:          "x99.huge.search",
+				Region:       "us-east-1",
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), "x99.huge.search")
+	})
+}
+
+// TestDetectService_OpenSearch verifies domain, legacy Elasticsearch and serverless
+// resource types route to OpenSearch.
+func TestDetectService_OpenSearch(t *testing.T) {
+	for _, rt := range []string{
+		"aws:opensearch/domain:Domain",
+		"aws:elasticsearch/domain:Domain",
+		"aws:opensearch/serverlessCollection:ServerlessCollection",
+		"aws:opensearchserverless/collection:Collection",
+		"opensearch",
+	} {
+		t.Run(rt, func(t *testing.T) {
+			assert.Equal(t, serviceOpenSearch, detectService(normalizeResourceType(rt)))
+		})
+	}
+}
+
+// TestGetProjectedCost_OpenSearchConfiguration verifies domain policies, SAML
+// options, VPC endpoints and serverless policies are not priced as domains or
+// collections.
+func TestGetProjectedCost_OpenSearchConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:opensearch/domainPolicy:DomainPolicy",
+		"aws:opensearch/domainSamlOptions:DomainSamlOptions",
+		"aws:opensearch/vpcEndpoint:VpcEndpoint",
+		"aws:opensearch/serverlessSecurityPolicy:ServerlessSecurityPolicy",
+		"aws:elasticsearch/domainPolicy:DomainPolicy",
+	)
+}
+
+// TestSupports_OpenSearch verifies OpenSearch is supported with carbon metrics.
+func TestSupports_OpenSearch(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	resp, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{
+		Resource: &pbc.ResourceDescriptor{
+			Provider:     "aws",
+			ResourceType: "aws:opensearch/domain:Domain",
+			Region:       "us-east-1",
+		},
+	})
+	require.NoError(t, err)
+	assert.True(t, resp.GetSupported())
+	assert.Equal(t, []pbc.MetricKind{pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT}, resp.GetSupportedMetrics())
+}
+
+// TestGetPricingSpec_OpenSearch verifies the domain and serverless pricing specs.
+func TestGetPricingSpec_OpenSearch(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+		wantUnit     string
+	}{
+		{"aws:opensearch/domain:Domain", "r6g.large.search", 0.167, "hour"},
+		{"aws:opensearch/serverlessCollection:ServerlessCollection", "serverless", 0.24, "ocu-hour"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp.GetSpec())
+			assert.Equal(t, "per_hour", resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
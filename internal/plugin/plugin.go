@@ -5,9 +5,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,15 +26,14 @@ import (
 
 // AWSPublicPlugin implements the pluginsdk.Plugin interface for AWS public pricing.
 type AWSPublicPlugin struct {
-	region           string
-	version          string
-	pricing          pricing.PricingClient
-	carbonEstimator  carbon.CarbonEstimator
-	ebsEstimator     *carbon.EBSEstimator
-	logger           zerolog.Logger // logger is immutable (copy-on-write)
-	testMode         bool           // true when FINFOCUS_TEST_MODE=true
-	maxBatchSize     int            // configured max batch size for recommendations (read-only after init)
-	strictValidation bool           // fail-fast on invalid resources in recommendations (read-only after init)
+	region            string
+	version           string
+	pricing           pricing.PricingClient
+	carbonEstimator   carbon.CarbonEstimator
+	ebsEstimator      *carbon.EBSEstimator
+	embodiedEstimator *carbon.EmbodiedCarbonEstimator
+	logger            zerolog.Logger                 // logger is immutable (copy-on-write)
+	settings          atomic.Pointer[pluginSettings] // replaced as a whole by ApplyConfig
 }
 
 // NewAWSPublicPlugin creates and returns a configured AWSPublicPlugin for the given AWS region.
@@ -54,70 +55,29 @@ func NewAWSPublicPlugin(
 	pricingClient pricing.PricingClient,
 	logger zerolog.Logger,
 ) *AWSPublicPlugin {
-	testMode := IsTestMode()
-
-	if testMode {
-		logger.Info().Msg("Test mode enabled")
-	}
-
 	// Inject logger into carbon package for CSV parsing error logging (T004)
 	// Issue #159: carbon.SetLogger() is called here, ensure it happens before NewEstimator()
 	// and any carbon functionality is used.
 	carbon.SetLogger(logger)
 
-	// Initialize configuration
-	maxBatchSize := defaultMaxBatchSize
-	// Check for batch size (new variable takes precedence over deprecated)
-	val, varName, found := getEnvWithDeprecation(
-		logger,
-		EnvMaxBatchSize,
-		EnvMaxBatchSizeDeprecated,
-		EnvMaxBatchSizeLegacy,
-	)
-
-	if found {
-		if n, err := strconv.Atoi(val); err == nil && n > 0 {
-			if n > maxMaxBatchSize {
-				logger.Warn().
-					Str("variable", varName).
-					Int("requested", n).
-					Int("max_allowed", maxMaxBatchSize).
-					Msg("requested batch size exceeds maximum, capping")
-				maxBatchSize = maxMaxBatchSize
-			} else {
-				maxBatchSize = n
-			}
-		} else {
-			logger.Warn().
-				Str("variable", varName).
-				Str("value", val).
-				Msg("invalid batch size value, using default")
-		}
+	// Initialize configuration from the environment; a configuration file is
+	// applied later with ApplyConfig. Without a file, settings cannot be invalid.
+	settings, _ := newSettings(logger, nil)
+	if settings.testMode {
+		logger.Info().Msg("Test mode enabled")
 	}
 
-	// Check for strict validation (new variable takes precedence over deprecated)
-	var strictValidation bool
-	val, _, found = getEnvWithDeprecation(
-		logger,
-		EnvStrictValidation,
-		EnvStrictValidationDeprecated,
-		EnvStrictValidationLegacy,
-	)
-	if found {
-		strictValidation = parseBoolVal(val)
-	}
-
-	return &AWSPublicPlugin{
-		region:           region,
-		version:          version,
-		pricing:          pricingClient,
-		carbonEstimator:  carbon.NewEstimator(),
-		ebsEstimator:     carbon.NewEBSEstimator(),
-		logger:           logger,
-		testMode:         testMode,
-		maxBatchSize:     maxBatchSize,
-		strictValidation: strictValidation,
+	p := &AWSPublicPlugin{
+		region:            region,
+		version:           version,
+		pricing:           pricingClient,
+		carbonEstimator:   carbon.NewEstimator(),
+		ebsEstimator:      carbon.NewEBSEstimator(),
+		embodiedEstimator: carbon.NewEmbodiedCarbonEstimator(),
+		logger:            logger,
 	}
+	p.settings.Store(settings)
+	return p
 }
 
 // parseBoolVal returns true if the string value is truthy.
@@ -292,13 +252,37 @@ func (p *AWSPublicPlugin) GetPluginInfo(
 		Version:     p.version,
 		SpecVersion: pluginsdk.SpecVersion,
 		Providers:   []string{providerAWS},
-		Metadata: map[string]string{
-			"region": p.region,
-			"type":   "public-pricing-fallback",
-		},
+		Metadata:    p.pluginInfoMetadata(),
 	}, nil
 }
 
+// pluginInfoMetadata describes the plugin, the price list vintage of every
+// service it prices, so any estimate can be traced back to its AWS price list,
+// and how old the oldest loaded price list is.
+func (p *AWSPublicPlugin) pluginInfoMetadata() map[string]string {
+	metadata := map[string]string{
+		"region": p.region,
+		"type":   "public-pricing-fallback",
+	}
+	if vintages := p.priceVintagesByService(); len(vintages) > 0 {
+		if encoded, err := json.Marshal(vintages); err == nil {
+			metadata[metadataKeyPriceVintages] = string(encoded)
+		}
+	}
+	settings := p.config()
+	metadata[metadataKeyAccountFreeTier] = strconv.FormatBool(settings.accountFreeTier)
+	if freshness, ok := p.priceFreshness(settings, nil); ok {
+		for key, value := range freshness.metadata() {
+			metadata[key] = value
+		}
+		metadata[metadataKeyPricingStaleAfterDays] = strconv.Itoa(settings.freshness.staleAfterDays)
+		if settings.freshness.maxAgeDays > 0 {
+			metadata[metadataKeyPricingMaxAgeDays] = strconv.Itoa(settings.freshness.maxAgeDays)
+		}
+	}
+	return metadata
+}
+
 // GetActualCost retrieves actual cost for a resource based on runtime.
 // Uses fallback formula: actual_cost = projected_monthly_cost × (runtime_hours / 730)
 //
@@ -311,6 +295,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 ) (*pbc.GetActualCostResponse, error) {
 	start := time.Now()
 	traceID := p.getTraceID(ctx)
+	settings := p.config()
 
 	// Validate request, resolve timestamps, and extract resource
 	// Note: ValidateActualCostRequest now returns TimestampResolution for confidence tracking (Feature 016)
@@ -346,7 +331,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 	}
 
 	// Test mode: Enhanced logging for request details (US3)
-	if p.testMode {
+	if settings.testMode {
 		p.logger.Debug().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Str("resource_type", resource.GetResourceType()).
@@ -359,6 +344,14 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 	// Use cached service type from resolver for FOCUS record (optimization: SC-002)
 	serviceType := resolver.ServiceType()
 
+	// Resource tags overlaid with the request's, for measured usage and cost allocation
+	requestTags := maps.Clone(resource.GetTags())
+	if requestTags == nil {
+		requestTags = make(map[string]string)
+	}
+	maps.Copy(requestTags, mergeTagsFromRequest(req))
+	allocationTags := settings.allocation.tags(requestTags)
+
 	// Handle zero duration - return $0 with single result
 	if runtimeHours == 0 {
 		// Build source with confidence (Feature 016)
@@ -382,7 +375,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 				Cost:      0,
 				Source:    source,
 				// FOCUS 1.2 record for FinOps reporting
-				FocusRecord: buildFocusRecord(
+				FocusRecord: withAllocationTags(buildFocusRecord(
 					serviceType,
 					resource.GetResourceType(),
 					resource.GetRegion(),
@@ -390,13 +383,20 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 					getPricingUnitForService(serviceType),
 					fromTime, toTime,
 					resource.GetSku(),
-				),
+				), allocationTags),
 			}},
 		}, nil
 	}
 
+	// Usage-based services are priced from the usage measured over the period, when supplied
+	pricedResource, measured, err := p.applyMeasuredUsage(traceID, resource, serviceType, requestTags, runtimeHours)
+	if err != nil {
+		p.logErrorWithID(traceID, "GetActualCost", err, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		return nil, err
+	}
+
 	// Get projected monthly cost using helper (pass resolver to reuse cached service type)
-	projectedResp, err := p.getProjectedForResource(traceID, resource, resolver)
+	projectedResp, err := p.getProjectedForResource(settings, traceID, pricedResource, resolver)
 	if err != nil {
 		var pue *PricingUnavailableError
 		if errors.As(err, &pue) {
@@ -418,7 +418,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 	actualCost := projectedResp.GetCostPerMonth() * (runtimeHours / carbon.HoursPerMonth)
 
 	// Test mode: Enhanced logging for calculation result (US3)
-	if p.testMode {
+	if settings.testMode {
 		p.logger.Debug().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Float64("projected_monthly", projectedResp.GetCostPerMonth()).
@@ -428,11 +428,24 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 			Msg("Test mode: GetActualCost calculation result")
 	}
 
+	// Confidence also reflects whether usage was measured, estimated or assumed
+	usageSrc := measuredUsageSource(serviceType, measured, projectedResp.GetMetadata())
+	confidence = usageConfidence(confidence, usageSrc)
+
 	// Build source with confidence and billing detail (Feature 016)
-	note := ""
+	var notes []string
 	if resolution != nil && resolution.IsImported {
-		note = "imported resource"
+		notes = append(notes, "imported resource")
+	}
+	switch usageSrc {
+	case usageMeasured:
+		notes = append(notes, "measured usage")
+	case usageEstimated:
+		notes = append(notes, "estimated usage")
+	case usageAssumed:
+		notes = append(notes, "assumed usage")
 	}
+	note := strings.Join(notes, ", ")
 	sourceWithConfidence := formatSourceWithConfidence(confidence, note)
 	billingDetail := formatActualBillingDetail(projectedResp.GetBillingDetail(), runtimeHours, actualCost)
 	// Combine: confidence prefix + billing detail
@@ -451,6 +464,34 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 		Int64(pluginsdk.FieldDurationMs, time.Since(start).Milliseconds()).
 		Msg("cost calculated")
 
+	// One FOCUS record per cost component, each prorated like the total
+	if components := costComponentsFromMetadata(projectedResp.GetMetadata()); len(components) > 0 {
+		fraction := runtimeHours / carbon.HoursPerMonth
+		results := make([]*pbc.ActualCostResult, 0, len(components))
+		for _, component := range components {
+			componentCost := component.Subtotal * fraction
+			usage := component.Quantity * fraction
+			results = append(results, &pbc.ActualCostResult{
+				Timestamp:   req.GetStart(),
+				Cost:        componentCost,
+				UsageAmount: usage,
+				UsageUnit:   component.Unit,
+				Source: sourceWithConfidence + " | " +
+					formatComponentBillingDetail(component, runtimeHours, componentCost),
+				FocusRecord: withAllocationTags(buildComponentFocusRecord(
+					serviceType,
+					resource.GetResourceType(),
+					resource.GetRegion(),
+					component,
+					componentCost, usage,
+					fromTime, toTime,
+					resource.GetSku(),
+				), allocationTags),
+			})
+		}
+		return &pbc.GetActualCostResponse{Results: results}, nil
+	}
+
 	return &pbc.GetActualCostResponse{
 		Results: []*pbc.ActualCostResult{{
 			Timestamp:   req.GetStart(),
@@ -459,7 +500,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 			UsageUnit:   "hours",
 			Source:      fullSource,
 			// FOCUS 1.2 record for FinOps reporting
-			FocusRecord: buildFocusRecord(
+			FocusRecord: withAllocationTags(buildFocusRecord(
 				serviceType,
 				resource.GetResourceType(),
 				resource.GetRegion(),
@@ -468,7 +509,7 @@ func (p *AWSPublicPlugin) GetActualCost( //nolint:funlen
 				"Hours",
 				fromTime, toTime,
 				resource.GetSku(),
-			),
+			), allocationTags),
 		}},
 	}, nil
 }
@@ -6,9 +6,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math"
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
@@ -21,56 +23,226 @@ import (
 
 // mockPricingClient is a test double for pricing.PricingClient.
 type mockPricingClient struct {
-	region                string
-	currency              string
-	ec2Prices             map[string]float64 // key: "instanceType/os/tenancy"
-	ebsPrices             map[string]float64 // key: "volumeType"
-	s3Prices              map[string]float64 // key: "storageClass"
-	rdsInstancePrices     map[string]float64 // key: "instanceType/engine"
-	rdsStoragePrices      map[string]float64 // key: "volumeType"
-	lambdaPrices          map[string]float64 // key: "request" or "gb-second"
-	dynamoDBPrices        map[string]float64 // key: "on-demand-read", "on-demand-write", "provisioned-rcu", "provisioned-wcu", "storage"
-	eksStandardPrice      float64            // EKS cluster standard support hourly rate
-	eksExtendedPrice      float64            // EKS cluster extended support hourly rate
-	albHourlyPrice        float64            // ALB fixed hourly rate
-	albLCUPrice           float64            // ALB cost per LCU-hour
-	nlbHourlyPrice        float64            // NLB fixed hourly rate
-	nlbNLCUPrice          float64            // NLB cost per NLCU-hour
-	natgwHourlyPrice      float64            // NAT Gateway hourly rate
-	natgwDataPrice        float64            // NAT Gateway data processing rate
-	cwLogsIngestionTiers  []pricing.TierRate // CloudWatch logs ingestion tiers
-	cwLogsStorageRate     float64            // CloudWatch logs storage rate per GB-month
-	cwMetricsTiers        []pricing.TierRate // CloudWatch custom metrics tiers
-	elasticachePrices     map[string]float64 // key: "nodeType:engine" (e.g., "cache.m5.large:Redis")
-	ec2OnDemandCalled     int
-	ebsPriceCalled        int
-	s3PriceCalled         int
-	rdsOnDemandCalled     int
-	rdsStoragePriceCalled int
-	eksPriceCalled        int
-	lambdaRequestCalled   int
-	lambdaGBSecondCalled  int
-	dynamoDBCalled        int
-	elbCalled             int
-	natgwCalled           int
+	region                           string
+	currency                         string
+	ec2Prices                        map[string]float64            // key: "instanceType/os/tenancy"
+	ebsPrices                        map[string]float64            // key: "volumeType"
+	s3Prices                         map[string]float64            // key: "storageClass"
+	rdsInstancePrices                map[string]float64            // key: "instanceType/engine"
+	rdsStoragePrices                 map[string]float64            // key: "volumeType"
+	lambdaPrices                     map[string]float64            // key: "request" or "gb-second"
+	dynamoDBPrices                   map[string]float64            // key: "on-demand-read", "on-demand-write", "provisioned-rcu", "provisioned-wcu", "storage"
+	eksStandardPrice                 float64                       // EKS cluster standard support hourly rate
+	eksExtendedPrice                 float64                       // EKS cluster extended support hourly rate
+	albHourlyPrice                   float64                       // ALB fixed hourly rate
+	albLCUPrice                      float64                       // ALB cost per LCU-hour
+	nlbHourlyPrice                   float64                       // NLB fixed hourly rate
+	nlbNLCUPrice                     float64                       // NLB cost per NLCU-hour
+	natgwHourlyPrice                 float64                       // NAT Gateway hourly rate
+	natgwDataPrice                   float64                       // NAT Gateway data processing rate
+	cwLogsIngestionTiers             []pricing.TierRate            // CloudWatch logs ingestion tiers
+	cwLogsStorageRate                float64                       // CloudWatch logs storage rate per GB-month
+	cwMetricsTiers                   []pricing.TierRate            // CloudWatch custom metrics tiers
+	s3Tiers                          map[string][]pricing.TierRate // key: "storageClass"
+	elasticachePrices                map[string]float64            // key: "nodeType:engine" (e.g., "cache.m5.large:Redis")
+	smSecretPrice                    float64                       // Secrets Manager per-secret monthly rate
+	smAPIPrice                       float64                       // Secrets Manager per-API-request rate
+	kmsKeyPrice                      float64                       // KMS per-key monthly rate
+	kmsRequestPrice                  float64                       // KMS per-request rate
+	openSearchPrices                 map[string]float64            // key: "r6g.large.search"
+	openSearchStorage                map[string]float64            // key: "volumeType"
+	openSearchOCUPrice               float64                       // OpenSearch Serverless per-OCU-hour rate
+	redshiftNodePrices               map[string]float64            // key: "ra3.xlplus"
+	redshiftStoragePrice             float64                       // Redshift Managed Storage per GB-month
+	redshiftRPUPrice                 float64                       // Redshift Serverless per RPU-hour
+	ecrStoragePrice                  float64                       // ECR storage per GB-month
+	backupStoragePrices              map[string]float64            // key: "efs/cold"
+	lambdaAddOns                     *pricing.LambdaAddOnPrice
+	sfnTransitionPrice               float64            // Step Functions Standard per state transition
+	sfnExpressReqPrice               float64            // Step Functions Express per request
+	sfnExpressTiers                  []pricing.TierRate // Step Functions Express per GB-second tiers
+	ebCustomEventPrice               float64            // EventBridge per custom event
+	ebPipesPrice                     float64            // EventBridge Pipes per request
+	docDBInstancePrices              map[string]float64 // key: "db.r6g.large"
+	docDBStoragePrice                float64            // DocumentDB storage per GB-month
+	docDBIOPrice                     float64            // DocumentDB per I/O request
+	docDBBackupPrice                 float64            // DocumentDB backup storage per GB-month
+	neptuneInstancePrices            map[string]float64 // key: "db.r6g.large"
+	neptuneStoragePrice              float64            // Neptune storage per GB-month
+	neptuneIOPrice                   float64            // Neptune per I/O request
+	neptuneBackupPrice               float64            // Neptune backup storage per GB-month
+	memoryDBNodePrices               map[string]float64 // key: "db.r6g.large"
+	memoryDBWritePrice               float64            // MemoryDB per GB of data written
+	memoryDBSnapshotPrice            float64            // MemoryDB snapshot storage per GB-month
+	mskBrokerPrices                  map[string]float64 // key: "kafka.m5.large"
+	mskStoragePrice                  float64            // MSK broker storage per GB-month
+	mskThroughputPrice               float64            // MSK provisioned throughput per MB/s-month
+	mskServerlessClusterPrice        float64            // MSK Serverless per cluster-hour
+	mskServerlessPartitionPrice      float64            // MSK Serverless per partition-hour
+	mskServerlessDataInPrice         float64            // MSK Serverless per GB written
+	mskServerlessDataOutPrice        float64            // MSK Serverless per GB read
+	mskServerlessStoragePrice        float64            // MSK Serverless storage per GB-month
+	sageMakerHostingPrices           map[string]float64 // key: "ml.m5.large"
+	sageMakerNotebookPrices          map[string]float64 // key: "ml.t3.medium"
+	sageMakerNotebookStoragePrice    float64            // SageMaker notebook storage per GB-month
+	sageMakerServerlessPrice         float64            // SageMaker Serverless Inference per GB-second
+	sageMakerServerlessDataPrice     float64            // SageMaker Serverless Inference per GB processed
+	wafWebACLPrice                   float64            // WAF per web ACL-month
+	wafRulePrice                     float64            // WAF per rule-month
+	wafRequestPrice                  float64            // WAF per request
+	shieldAdvancedPrice              float64            // Shield Advanced subscription per month
+	globalAcceleratorPrice           float64            // Global Accelerator per accelerator-hour
+	globalAcceleratorDTPremiumPrices map[string]float64 // key: "North America|Europe"
+	priceVintages                    []pricing.PriceVintage
+	rateSources                      map[string]pricing.RateSource // key: "offerCode|lookupKey"
+	ec2OnDemandCalled                int
+	ebsPriceCalled                   int
+	s3PriceCalled                    int
+	rdsOnDemandCalled                int
+	rdsStoragePriceCalled            int
+	eksPriceCalled                   int
+	lambdaRequestCalled              int
+	lambdaGBSecondCalled             int
+	dynamoDBCalled                   int
+	elbCalled                        int
+	natgwCalled                      int
 }
 
 // newMockPricingClient creates a new mockPricingClient with default values.
 func newMockPricingClient(region, currency string) *mockPricingClient {
 	return &mockPricingClient{
-		region:            region,
-		currency:          currency,
-		ec2Prices:         make(map[string]float64),
-		ebsPrices:         make(map[string]float64),
-		s3Prices:          make(map[string]float64),
-		rdsInstancePrices: make(map[string]float64),
-		rdsStoragePrices:  make(map[string]float64),
-		lambdaPrices:      make(map[string]float64),
-		dynamoDBPrices:    make(map[string]float64),
-		elasticachePrices: make(map[string]float64),
+		region:                           region,
+		currency:                         currency,
+		ec2Prices:                        make(map[string]float64),
+		ebsPrices:                        make(map[string]float64),
+		s3Prices:                         make(map[string]float64),
+		rdsInstancePrices:                make(map[string]float64),
+		rdsStoragePrices:                 make(map[string]float64),
+		lambdaPrices:                     make(map[string]float64),
+		dynamoDBPrices:                   make(map[string]float64),
+		elasticachePrices:                make(map[string]float64),
+		openSearchPrices:                 make(map[string]float64),
+		openSearchStorage:                make(map[string]float64),
+		redshiftNodePrices:               make(map[string]float64),
+		backupStoragePrices:              make(map[string]float64),
+		docDBInstancePrices:              make(map[string]float64),
+		neptuneInstancePrices:            make(map[string]float64),
+		memoryDBNodePrices:               make(map[string]float64),
+		mskBrokerPrices:                  make(map[string]float64),
+		sageMakerHostingPrices:           make(map[string]float64),
+		sageMakerNotebookPrices:          make(map[string]float64),
+		globalAcceleratorDTPremiumPrices: make(map[string]float64),
+		rateSources:                      make(map[string]pricing.RateSource),
 	}
 }
 
+// createConformanceMockPlugin creates a test plugin with pricing configured for
+// every conformance sample and the service tests. It is the shared fixture for
+// tests that price resources through the plugin.
+func createConformanceMockPlugin(region string) *AWSPublicPlugin {
+	mock := newMockPricingClient(region, "USD")
+	mock.ec2Prices["t3.micro/Linux/Shared"] = 0.0104
+	mock.ec2Prices["t3.medium/Linux/Shared"] = 0.0416
+	mock.ec2Prices["m5.large/Linux/Shared"] = 0.096
+	mock.ec2Prices["m5.large/Windows/Shared"] = 0.188
+	mock.ebsPrices["gp3"] = 0.08
+	mock.ebsPrices["gp2"] = 0.10
+	mock.s3Prices["STANDARD"] = 0.023
+	mock.rdsInstancePrices["db.t3.micro/MySQL"] = 0.017
+	mock.rdsStoragePrices["gp2"] = 0.115
+	mock.lambdaPrices["request"] = 0.0000002
+	mock.lambdaPrices["gb-second"] = 0.0000166667
+	mock.lambdaAddOns = &pricing.LambdaAddOnPrice{
+		ProvisionedConcurrencyRate: 0.0000041667,
+		ProvisionedDurationRate:    0.0000097222,
+		EphemeralStorageRate:       0.0000000309,
+		SnapStartCacheRate:         0.0000015046,
+		SnapStartRestoreRate:       0.0001397998,
+		Currency:                   "USD",
+	}
+	mock.dynamoDBPrices["on-demand-read"] = 0.00000025
+	mock.dynamoDBPrices["on-demand-write"] = 0.00000125
+	mock.dynamoDBPrices["storage"] = 0.25
+	mock.eksStandardPrice = 0.10
+	mock.albHourlyPrice = 0.0225
+	mock.albLCUPrice = 0.008
+	mock.natgwHourlyPrice = 0.045
+	mock.natgwDataPrice = 0.045
+	mock.cwLogsIngestionTiers = []pricing.TierRate{{UpTo: 1e18, Rate: 0.50}}
+	mock.cwLogsStorageRate = 0.03
+	mock.elasticachePrices["cache.m5.large:Redis"] = 0.156
+	mock.smSecretPrice = 0.40
+	mock.smAPIPrice = 0.000005
+	mock.kmsKeyPrice = 1.00
+	mock.kmsRequestPrice = 0.000003
+	mock.openSearchPrices["r6g.large.search"] = 0.167
+	mock.openSearchPrices["m5.large.search"] = 0.142
+	mock.openSearchPrices["ultrawarm1.medium.search"] = 0.238
+	mock.openSearchStorage["gp3"] = 0.122
+	mock.openSearchStorage["gp2"] = 0.135
+	mock.openSearchOCUPrice = 0.24
+	mock.redshiftNodePrices["ra3.large"] = 0.543
+	mock.redshiftNodePrices["dc2.large"] = 0.25
+	mock.redshiftNodePrices["dc2.8xlarge"] = 4.80
+	mock.redshiftNodePrices["ra3.4xlarge"] = 3.26
+	mock.redshiftStoragePrice = 0.024
+	mock.redshiftRPUPrice = 0.375
+	mock.ecrStoragePrice = 0.10
+	mock.backupStoragePrices["ebs/warm"] = 0.05
+	mock.backupStoragePrices["efs/warm"] = 0.05
+	mock.backupStoragePrices["efs/cold"] = 0.01
+	mock.backupStoragePrices["rds/warm"] = 0.095
+	mock.sfnTransitionPrice = 0.000025
+	mock.sfnExpressReqPrice = 0.000001
+	mock.sfnExpressTiers = []pricing.TierRate{
+		{UpTo: 3600000, Rate: 0.00001667},
+		{UpTo: math.MaxFloat64, Rate: 0.00000833},
+	}
+	mock.ebCustomEventPrice = 0.000001
+	mock.ebPipesPrice = 0.0000004
+	mock.docDBInstancePrices["db.r5.large"] = 0.277
+	mock.docDBInstancePrices["db.r4.large"] = 0.277
+	mock.docDBInstancePrices["db.r6g.large"] = 0.249
+	mock.docDBStoragePrice = 0.10
+	mock.docDBIOPrice = 0.0000002
+	mock.docDBBackupPrice = 0.021
+	mock.neptuneInstancePrices["db.r5.large"] = 0.348
+	mock.neptuneInstancePrices["db.r6i.large"] = 0.348
+	mock.neptuneInstancePrices["db.r6g.large"] = 0.313
+	mock.neptuneStoragePrice = 0.10
+	mock.neptuneIOPrice = 0.0000002
+	mock.neptuneBackupPrice = 0.021
+	mock.memoryDBNodePrices["db.r6g.large"] = 0.309
+	mock.memoryDBNodePrices["db.r7g.large"] = 0.327
+	mock.memoryDBWritePrice = 0.20
+	mock.memoryDBSnapshotPrice = 0.021
+	mock.mskBrokerPrices["kafka.m5.large"] = 0.21
+	mock.mskBrokerPrices["kafka.t3.small"] = 0.0456
+	mock.mskBrokerPrices["kafka.m7g.large"] = 0.204
+	mock.mskStoragePrice = 0.10
+	mock.mskThroughputPrice = 0.08
+	mock.mskServerlessClusterPrice = 0.75
+	mock.mskServerlessPartitionPrice = 0.0015
+	mock.mskServerlessDataInPrice = 0.10
+	mock.mskServerlessDataOutPrice = 0.05
+	mock.mskServerlessStoragePrice = 0.10
+	mock.sageMakerHostingPrices["ml.m5.large"] = 0.115
+	mock.sageMakerHostingPrices["ml.g5.xlarge"] = 1.408
+	mock.sageMakerNotebookPrices["ml.t3.medium"] = 0.05
+	mock.sageMakerNotebookStoragePrice = 0.14
+	mock.sageMakerServerlessPrice = 0.00002
+	mock.sageMakerServerlessDataPrice = 0.016
+	mock.wafWebACLPrice = 5.00
+	mock.wafRulePrice = 1.00
+	mock.wafRequestPrice = 0.0000006
+	mock.shieldAdvancedPrice = 3000
+	mock.globalAcceleratorPrice = 0.025
+	mock.globalAcceleratorDTPremiumPrices["North America|North America"] = 0.015
+	mock.globalAcceleratorDTPremiumPrices["North America|Asia Pacific"] = 0.035
+	mock.globalAcceleratorDTPremiumPrices["Europe|Europe"] = 0.015
+	return NewAWSPublicPlugin(region, "test-version", mock, zerolog.Nop())
+}
+
 func (m *mockPricingClient) Region() string {
 	return m.region
 }
@@ -148,6 +320,11 @@ func (m *mockPricingClient) S3PricePerGBMonth(storageClass string) (float64, boo
 	return price, found
 }
 
+func (m *mockPricingClient) S3StorageTiers(storageClass string) ([]pricing.TierRate, bool) {
+	tiers, found := m.s3Tiers[storageClass]
+	return tiers, found
+}
+
 func (m *mockPricingClient) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
 	m.rdsOnDemandCalled++
 	key := instanceType + "/" + engine
@@ -262,6 +439,351 @@ func (m *mockPricingClient) ElastiCacheOnDemandPricePerHour(instanceType, engine
 	return price, found
 }
 
+func (m *mockPricingClient) SecretsManagerPricePerSecretMonth() (float64, bool) {
+	if m.smSecretPrice > 0 {
+		return m.smSecretPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) SecretsManagerPricePerAPIRequest() (float64, bool) {
+	if m.smAPIPrice > 0 {
+		return m.smAPIPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) KMSPricePerKeyMonth() (float64, bool) {
+	if m.kmsKeyPrice > 0 {
+		return m.kmsKeyPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) KMSPricePerRequest() (float64, bool) {
+	if m.kmsRequestPrice > 0 {
+		return m.kmsRequestPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) OpenSearchInstancePricePerHour(instanceType string) (float64, bool) {
+	// Normalize suffix to match pricing client behavior
+	key := strings.TrimSuffix(instanceType, ".elasticsearch")
+	if !strings.HasSuffix(key, ".search") {
+		key += ".search"
+	}
+	price, found := m.openSearchPrices[key]
+	return price, found
+}
+
+func (m *mockPricingClient) OpenSearchStoragePricePerGBMonth(volumeType string) (float64, bool) {
+	price, found := m.openSearchStorage[volumeType]
+	return price, found
+}
+
+func (m *mockPricingClient) OpenSearchServerlessOCUPricePerHour() (float64, bool) {
+	if m.openSearchOCUPrice > 0 {
+		return m.openSearchOCUPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) RedshiftNodePricePerHour(nodeType string) (float64, bool) {
+	price, found := m.redshiftNodePrices[nodeType]
+	return price, found
+}
+
+func (m *mockPricingClient) RedshiftManagedStoragePricePerGBMonth() (float64, bool) {
+	if m.redshiftStoragePrice > 0 {
+		return m.redshiftStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) RedshiftServerlessPricePerRPUHour() (float64, bool) {
+	if m.redshiftRPUPrice > 0 {
+		return m.redshiftRPUPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) ECRStoragePricePerGBMonth() (float64, bool) {
+	if m.ecrStoragePrice > 0 {
+		return m.ecrStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) BackupStoragePricePerGBMonth(resourceType, tier string) (float64, bool) {
+	price, found := m.backupStoragePrices[strings.ToLower(resourceType)+"/"+strings.ToLower(tier)]
+	return price, found
+}
+
+func (m *mockPricingClient) LambdaAddOnPrices(_ string) (*pricing.LambdaAddOnPrice, bool) {
+	if m.lambdaAddOns != nil {
+		return m.lambdaAddOns, true
+	}
+	return nil, false
+}
+
+func (m *mockPricingClient) StepFunctionsPricePerStateTransition() (float64, bool) {
+	if m.sfnTransitionPrice > 0 {
+		return m.sfnTransitionPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) StepFunctionsExpressPricePerRequest() (float64, bool) {
+	if m.sfnExpressReqPrice > 0 {
+		return m.sfnExpressReqPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) StepFunctionsExpressDurationTiers() ([]pricing.TierRate, bool) {
+	if len(m.sfnExpressTiers) > 0 {
+		return m.sfnExpressTiers, true
+	}
+	return nil, false
+}
+
+func (m *mockPricingClient) EventBridgePricePerCustomEvent() (float64, bool) {
+	if m.ebCustomEventPrice > 0 {
+		return m.ebCustomEventPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) EventBridgePipesPricePerRequest() (float64, bool) {
+	if m.ebPipesPrice > 0 {
+		return m.ebPipesPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) DocumentDBInstancePricePerHour(instanceType string) (float64, bool) {
+	price, found := m.docDBInstancePrices[instanceType]
+	return price, found
+}
+
+func (m *mockPricingClient) DocumentDBStoragePricePerGBMonth() (float64, bool) {
+	if m.docDBStoragePrice > 0 {
+		return m.docDBStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) DocumentDBPricePerIORequest() (float64, bool) {
+	if m.docDBIOPrice > 0 {
+		return m.docDBIOPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) DocumentDBBackupPricePerGBMonth() (float64, bool) {
+	if m.docDBBackupPrice > 0 {
+		return m.docDBBackupPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) NeptuneInstancePricePerHour(instanceType string) (float64, bool) {
+	price, found := m.neptuneInstancePrices[instanceType]
+	return price, found
+}
+
+func (m *mockPricingClient) NeptuneStoragePricePerGBMonth() (float64, bool) {
+	if m.neptuneStoragePrice > 0 {
+		return m.neptuneStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) NeptunePricePerIORequest() (float64, bool) {
+	if m.neptuneIOPrice > 0 {
+		return m.neptuneIOPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) NeptuneBackupPricePerGBMonth() (float64, bool) {
+	if m.neptuneBackupPrice > 0 {
+		return m.neptuneBackupPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MemoryDBNodePricePerHour(nodeType string) (float64, bool) {
+	price, found := m.memoryDBNodePrices[nodeType]
+	return price, found
+}
+
+func (m *mockPricingClient) MemoryDBDataWrittenPricePerGB() (float64, bool) {
+	if m.memoryDBWritePrice > 0 {
+		return m.memoryDBWritePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MemoryDBSnapshotPricePerGBMonth() (float64, bool) {
+	if m.memoryDBSnapshotPrice > 0 {
+		return m.memoryDBSnapshotPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKBrokerPricePerHour(instanceType string) (float64, bool) {
+	price, found := m.mskBrokerPrices[instanceType]
+	return price, found
+}
+
+func (m *mockPricingClient) MSKStoragePricePerGBMonth() (float64, bool) {
+	if m.mskStoragePrice > 0 {
+		return m.mskStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKThroughputPricePerMBpsMonth() (float64, bool) {
+	if m.mskThroughputPrice > 0 {
+		return m.mskThroughputPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKServerlessClusterPricePerHour() (float64, bool) {
+	if m.mskServerlessClusterPrice > 0 {
+		return m.mskServerlessClusterPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKServerlessPartitionPricePerHour() (float64, bool) {
+	if m.mskServerlessPartitionPrice > 0 {
+		return m.mskServerlessPartitionPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKServerlessDataInPricePerGB() (float64, bool) {
+	if m.mskServerlessDataInPrice > 0 {
+		return m.mskServerlessDataInPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKServerlessDataOutPricePerGB() (float64, bool) {
+	if m.mskServerlessDataOutPrice > 0 {
+		return m.mskServerlessDataOutPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) MSKServerlessStoragePricePerGBMonth() (float64, bool) {
+	if m.mskServerlessStoragePrice > 0 {
+		return m.mskServerlessStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) SageMakerHostingPricePerHour(instanceType string) (float64, bool) {
+	price, found := m.sageMakerHostingPrices[instanceType]
+	return price, found
+}
+
+func (m *mockPricingClient) SageMakerNotebookPricePerHour(instanceType string) (float64, bool) {
+	price, found := m.sageMakerNotebookPrices[instanceType]
+	return price, found
+}
+
+func (m *mockPricingClient) SageMakerNotebookStoragePricePerGBMonth() (float64, bool) {
+	if m.sageMakerNotebookStoragePrice > 0 {
+		return m.sageMakerNotebookStoragePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) SageMakerServerlessPricePerGBSecond() (float64, bool) {
+	if m.sageMakerServerlessPrice > 0 {
+		return m.sageMakerServerlessPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) SageMakerServerlessDataPricePerGB() (float64, bool) {
+	if m.sageMakerServerlessDataPrice > 0 {
+		return m.sageMakerServerlessDataPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) WAFPricePerWebACLMonth() (float64, bool) {
+	if m.wafWebACLPrice > 0 {
+		return m.wafWebACLPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) WAFPricePerRuleMonth() (float64, bool) {
+	if m.wafRulePrice > 0 {
+		return m.wafRulePrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) WAFPricePerRequest() (float64, bool) {
+	if m.wafRequestPrice > 0 {
+		return m.wafRequestPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) ShieldAdvancedPricePerMonth() (float64, bool) {
+	if m.shieldAdvancedPrice > 0 {
+		return m.shieldAdvancedPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) GlobalAcceleratorPricePerHour() (float64, bool) {
+	if m.globalAcceleratorPrice > 0 {
+		return m.globalAcceleratorPrice, true
+	}
+	return 0, false
+}
+
+func (m *mockPricingClient) GlobalAcceleratorDTPremiumPricePerGB(source, destination string) (float64, bool) {
+	price, found := m.globalAcceleratorDTPremiumPrices[source+"|"+destination]
+	return price, found
+}
+
+func (m *mockPricingClient) PriceVintages() []pricing.PriceVintage {
+	return m.priceVintages
+}
+
+func (m *mockPricingClient) RateSource(offerCode, key string) (pricing.RateSource, bool) {
+	if source, found := m.rateSources[offerCode+"|"+key]; found {
+		return source, true
+	}
+	for _, vintage := range m.priceVintages {
+		if vintage.OfferCode == offerCode {
+			return pricing.RateSource{PriceVintage: vintage}, false
+		}
+	}
+	return pricing.RateSource{}, false
+}
+
+func (m *mockPricingClient) PriceListAges(now time.Time) []pricing.PriceListAge {
+	ages := make([]pricing.PriceListAge, 0, len(m.priceVintages))
+	for _, vintage := range m.priceVintages {
+		if age, ok := vintage.AgeAt(now); ok {
+			ages = append(ages, pricing.PriceListAge{PriceVintage: vintage, Age: age})
+		}
+	}
+	return ages
+}
+
 func TestNewAWSPublicPlugin(t *testing.T) {
 	mock := newMockPricingClient("us-east-1", "USD")
 	logger := zerolog.New(nil).Level(zerolog.InfoLevel)
@@ -691,12 +1213,12 @@ func TestNewAWSPublicPlugin_DeprecatedEnvVars(t *testing.T) {
 
 			plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, logger)
 
-			if plugin.maxBatchSize != tt.expectedBatchSize {
-				t.Errorf("maxBatchSize = %d, want %d", plugin.maxBatchSize, tt.expectedBatchSize)
+			if plugin.config().maxBatchSize != tt.expectedBatchSize {
+				t.Errorf("maxBatchSize = %d, want %d", plugin.config().maxBatchSize, tt.expectedBatchSize)
 			}
 
-			if plugin.strictValidation != tt.expectedStrict {
-				t.Errorf("strictValidation = %v, want %v", plugin.strictValidation, tt.expectedStrict)
+			if plugin.config().strictValidation != tt.expectedStrict {
+				t.Errorf("strictValidation = %v, want %v", plugin.config().strictValidation, tt.expectedStrict)
 			}
 
 			logOutput := logBuf.String()
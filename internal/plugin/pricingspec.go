@@ -3,6 +3,7 @@ package plugin
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
@@ -39,30 +40,10 @@ func (p *AWSPublicPlugin) GetPricingSpec(
 
 	var spec *pbc.PricingSpec
 
-	switch serviceType {
-	case serviceEC2:
-		spec = p.ec2PricingSpec(resource)
-	case serviceEBS:
-		spec = p.ebsPricingSpec(resource)
-	case serviceS3:
-		spec = p.s3PricingSpec(resource)
-	case serviceLambda:
-		spec = p.lambdaPricingSpec(resource)
-	case serviceRDS:
-		spec = p.rdsPricingSpec(resource)
-	case serviceDynamoDB:
-		spec = p.dynamoDBPricingSpec(resource)
-	case serviceEKS:
-		spec = p.eksPricingSpec(resource)
-	case serviceELB, serviceALB, serviceNLB:
-		spec = p.elbPricingSpec(resource)
-	case serviceNATGW:
-		spec = p.natGatewayPricingSpec(resource)
-	case serviceCloudWatch:
-		spec = p.cloudWatchPricingSpec(resource)
-	case serviceVPC, serviceSecurityGroup, serviceSubnet, serviceIAM, serviceLaunchTmpl, serviceLaunchConfig:
-		spec = p.zeroCostPricingSpec(resource, serviceType)
-	default:
+	if estimator, ok := lookupEstimator(serviceType); ok {
+		spec = estimator.PricingSpec(p, resource)
+		p.pricingSpecProvenance(p.config(), spec, estimator, traceID, resource)
+	} else {
 		spec = &pbc.PricingSpec{
 			Provider:     resource.GetProvider(),
 			ResourceType: resource.GetResourceType(),
@@ -243,6 +224,23 @@ func (p *AWSPublicPlugin) lambdaPricingSpec(resource *pbc.ResourceDescriptor) *p
 		}
 	}
 
+	assumptions := []string{
+		fmt.Sprintf("Request rate: $%.10f per request", requestRate),
+		fmt.Sprintf("Compute rate: $%.10f per GB-second (%s)", gbSecRate, arch),
+	}
+	if addOns, found := p.pricing.LambdaAddOnPrices(arch); found {
+		assumptions = append(assumptions,
+			fmt.Sprintf("Provisioned concurrency: $%.10f per GB-second configured, $%.10f per GB-second executed",
+				addOns.ProvisionedConcurrencyRate, addOns.ProvisionedDurationRate),
+			fmt.Sprintf("Ephemeral storage above 512 MB: $%.10f per GB-second", addOns.EphemeralStorageRate),
+			fmt.Sprintf("SnapStart: $%.10f per GB-second cached, $%.10f per GB restored",
+				addOns.SnapStartCacheRate, addOns.SnapStartRestoreRate),
+		)
+	} else {
+		assumptions = append(assumptions, "Provisioned concurrency, ephemeral storage and SnapStart not included")
+	}
+	assumptions = append(assumptions, "Lambda@Edge pricing differs")
+
 	return &pbc.PricingSpec{
 		Provider:     resource.GetProvider(),
 		ResourceType: resource.GetResourceType(),
@@ -254,12 +252,7 @@ func (p *AWSPublicPlugin) lambdaPricingSpec(resource *pbc.ResourceDescriptor) *p
 		Unit:         "GB-second",
 		Description:  fmt.Sprintf("Lambda %s architecture", arch),
 		Source:       "aws-public",
-		Assumptions: []string{
-			fmt.Sprintf("Request rate: $%.10f per request", requestRate),
-			fmt.Sprintf("Compute rate: $%.10f per GB-second (%s)", gbSecRate, arch),
-			"Provisioned concurrency not included",
-			"Lambda@Edge pricing differs",
-		},
+		Assumptions:  assumptions,
 	}
 }
 
@@ -402,6 +395,13 @@ func (p *AWSPublicPlugin) dynamoDBPricingSpec(resource *pbc.ResourceDescriptor)
 
 // eksPricingSpec returns the pricing specification for EKS clusters.
 func (p *AWSPublicPlugin) eksPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	switch {
+	case isEKSNodeGroup(resource):
+		return p.eksNodeGroupPricingSpec(resource)
+	case isEKSFargateProfile(resource):
+		return p.zeroCostPricingSpec(resource, resource.GetResourceType())
+	}
+
 	supportType := "standard"
 	if s, ok := resource.GetTags()["support_type"]; ok && s != "" {
 		supportType = s
@@ -446,6 +446,83 @@ func (p *AWSPublicPlugin) eksPricingSpec(resource *pbc.ResourceDescriptor) *pbc.
 	}
 }
 
+// eksNodeGroupPricingSpec returns the per-node EC2 hourly rate for an EKS managed
+// node group.
+func (p *AWSPublicPlugin) eksNodeGroupPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	instanceType, _ := eksNodeGroupInstanceType(resource)
+	hourlyRate, found := p.pricing.EC2OnDemandPricePerHour(instanceType, "Linux", "Shared")
+
+	spec := &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          instanceType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("EKS managed node group of %s instances", instanceType),
+		Source:       "aws-public",
+		Assumptions: []string{
+			"Rate is per node; monthly cost scales with desired_size",
+			fmt.Sprintf("Linux on-demand rate; SPOT capacity priced at an assumed %.0f%% discount", eksSpotDiscount*100),
+			"Node disk billed separately as gp2 storage per node",
+		},
+	}
+	if !found {
+		spec.Description = "EKS node group instance type not found in embedded data"
+		spec.Assumptions = []string{fmt.Sprintf("EC2 pricing for %s not available", instanceType)}
+	}
+	return spec
+}
+
+// elastiCachePricingSpec returns the per-node hourly rate for an ElastiCache cluster.
+func (p *AWSPublicPlugin) elastiCachePricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	nodeType := resource.GetSku()
+	if nodeType == "" {
+		nodeType = extractAWSSKU(resource.GetTags())
+	}
+	engine := "redis"
+	if val := resource.GetTags()["engine"]; val != "" {
+		engine = strings.ToLower(val)
+	}
+
+	hourlyRate, found := p.pricing.ElastiCacheOnDemandPricePerHour(nodeType, engine)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          nodeType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  fmt.Sprintf(PricingNotFoundTemplate, fmt.Sprintf("ElastiCache %s node", engine), nodeType),
+			Source:       "aws-public",
+			Assumptions:  []string{"Node type not found in embedded pricing data"},
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          nodeType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("ElastiCache %s node (%s)", nodeType, engine),
+		Source:       "aws-public",
+		Assumptions: []string{
+			"Rate is per node; monthly cost scales with num_nodes (default 1)",
+			"730 hours per month",
+			"Backup storage and data transfer not included",
+		},
+	}
+}
+
 // elbPricingSpec returns the pricing specification for Elastic Load Balancers.
 func (p *AWSPublicPlugin) elbPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
 	lbType := resource.GetSku()
@@ -688,6 +765,832 @@ func (p *AWSPublicPlugin) cloudWatchPricingSpec( //nolint:gocognit,funlen
 	}
 }
 
+// secretsManagerPricingSpec returns the pricing specification for a Secrets Manager secret.
+func (p *AWSPublicPlugin) secretsManagerPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	secretRate, secretFound := p.pricing.SecretsManagerPricePerSecretMonth()
+	apiRate, apiFound := p.pricing.SecretsManagerPricePerAPIRequest()
+
+	if !secretFound || !apiFound {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_month_plus_requests",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Description:  "Secrets Manager pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"Secrets Manager pricing data not available"},
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_month_plus_requests",
+		RatePerUnit:  secretRate,
+		Currency:     "USD",
+		Unit:         "secret-month",
+		Description:  "Secrets Manager secret",
+		Source:       "aws-public",
+		Assumptions: []string{
+			fmt.Sprintf("Secret storage: $%.2f per secret per month", secretRate),
+			fmt.Sprintf("API calls: $%.4f per 10,000 requests", apiRate*apiCallsPer10k),
+			"Replica secrets are billed as separate secrets",
+			"30-day free trial for new secrets not applied",
+		},
+	}
+}
+
+// kmsPricingSpec returns the pricing specification for a KMS key.
+func (p *AWSPublicPlugin) kmsPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	keyRate, keyFound := p.pricing.KMSPricePerKeyMonth()
+	requestRate, requestFound := p.pricing.KMSPricePerRequest()
+
+	if !keyFound || !requestFound {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_month_plus_requests",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Description:  "KMS pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"KMS pricing data not available"},
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_month_plus_requests",
+		RatePerUnit:  keyRate,
+		Currency:     "USD",
+		Unit:         "key-month",
+		Description:  "KMS customer managed key",
+		Source:       "aws-public",
+		Assumptions: []string{
+			fmt.Sprintf("Customer managed key: $%.2f per key per month", keyRate),
+			fmt.Sprintf("Symmetric requests: $%.4f per 10,000 requests", requestRate*apiCallsPer10k),
+			"AWS managed keys have no monthly fee",
+			"Account-level free tier of 20,000 requests per month not applied",
+		},
+	}
+}
+
+// openSearchPricingSpec returns the pricing specification for an OpenSearch domain
+// or OpenSearch Serverless collection.
+func (p *AWSPublicPlugin) openSearchPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	if isOpenSearchServerless(resource) {
+		ocuRate, found := p.pricing.OpenSearchServerlessOCUPricePerHour()
+		if !found {
+			return &pbc.PricingSpec{
+				Provider:     resource.GetProvider(),
+				ResourceType: resource.GetResourceType(),
+				Sku:          resource.GetSku(),
+				Region:       resource.GetRegion(),
+				BillingMode:  "per_hour",
+				RatePerUnit:  0,
+				Currency:     "USD",
+				Unit:         "ocu-hour",
+				Description:  "OpenSearch Serverless pricing not found in embedded data",
+				Source:       "aws-public",
+				Assumptions:  []string{"OpenSearch Serverless pricing data not available"},
+			}
+		}
+
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  ocuRate,
+			Currency:     "USD",
+			Unit:         "ocu-hour",
+			Description:  "OpenSearch Serverless collection",
+			Source:       "aws-public",
+			Assumptions: []string{
+				"Indexing and search OCUs billed at the same hourly rate",
+				"730 hours per month",
+				"Managed storage (S3) not included",
+			},
+		}
+	}
+
+	instanceType := resource.GetSku()
+	hourlyRate, found := p.pricing.OpenSearchInstancePricePerHour(instanceType)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          instanceType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "OpenSearch pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"OpenSearch pricing data not available"},
+		}
+	}
+
+	assumptions := []string{
+		"On-demand pricing per data node",
+		"730 hours per month",
+		"Dedicated master and UltraWarm nodes billed at their own node rates",
+	}
+	if storageRate, storageFound := p.pricing.OpenSearchStoragePricePerGBMonth(defaultOpenSearchVolumeType); storageFound {
+		assumptions = append(assumptions,
+			fmt.Sprintf("EBS storage (gp3): $%.4f per GB-month per data node", storageRate))
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          instanceType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("OpenSearch %s data node", instanceType),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// redshiftPricingSpec returns the pricing specification for a Redshift cluster
+// or Redshift Serverless workgroup.
+func (p *AWSPublicPlugin) redshiftPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	storageAssumption := "Managed storage (RA3/Serverless) pricing not available"
+	if storageRate, found := p.pricing.RedshiftManagedStoragePricePerGBMonth(); found {
+		storageAssumption = fmt.Sprintf("Managed storage (RA3/Serverless): $%.3f per GB-month", storageRate)
+	}
+
+	if isRedshiftServerless(resource) {
+		rpuRate, found := p.pricing.RedshiftServerlessPricePerRPUHour()
+		if !found {
+			return &pbc.PricingSpec{
+				Provider:     resource.GetProvider(),
+				ResourceType: resource.GetResourceType(),
+				Sku:          resource.GetSku(),
+				Region:       resource.GetRegion(),
+				BillingMode:  "per_hour",
+				RatePerUnit:  0,
+				Currency:     "USD",
+				Unit:         "rpu-hour",
+				Description:  "Redshift Serverless pricing not found in embedded data",
+				Source:       "aws-public",
+				Assumptions:  []string{"Redshift Serverless pricing data not available"},
+			}
+		}
+
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  rpuRate,
+			Currency:     "USD",
+			Unit:         "rpu-hour",
+			Description:  "Redshift Serverless workgroup",
+			Source:       "aws-public",
+			Assumptions: []string{
+				"Compute billed only while queries run (hours_per_day usage hint)",
+				storageAssumption,
+			},
+		}
+	}
+
+	nodeType := resource.GetSku()
+	hourlyRate, found := p.pricing.RedshiftNodePricePerHour(nodeType)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          nodeType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "Redshift pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"Redshift pricing data not available"},
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          nodeType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("Redshift %s node", nodeType),
+		Source:       "aws-public",
+		Assumptions: []string{
+			"On-demand pricing per node",
+			"730 hours per month",
+			storageAssumption,
+			"DC2 node storage included in the node rate",
+		},
+	}
+}
+
+// ecrPricingSpec returns the pricing specification for an ECR repository.
+func (p *AWSPublicPlugin) ecrPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	storageRate, found := p.pricing.ECRStoragePricePerGBMonth()
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_gb_month",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "GB-month",
+			Description:  "ECR pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"ECR pricing data not available"},
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_gb_month",
+		RatePerUnit:  storageRate,
+		Currency:     "USD",
+		Unit:         "GB-month",
+		Description:  "ECR private repository image storage",
+		Source:       "aws-public",
+		Assumptions: []string{
+			"Storage cost only",
+			"Data transfer out of the region billed separately",
+			"Storage grows with pushed images; see monthly_growth_gb",
+		},
+	}
+}
+
+// backupPricingSpec returns the pricing specification for an AWS Backup vault.
+// The rate is the warm tier for the protected resource type; the cold tier rate,
+// when available, is listed in the assumptions.
+func (p *AWSPublicPlugin) backupPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	resourceType, known := backupProtectedResourceType(resource)
+	if !known {
+		resourceType = defaultBackupResourceType
+	}
+
+	warmRate, found := p.pricing.BackupStoragePricePerGBMonth(resourceType, "warm")
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_gb_month",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "GB-month",
+			Description:  fmt.Sprintf(PricingNotFoundTemplate, "AWS Backup warm storage resource type", resourceType),
+			Source:       "aws-public",
+			Assumptions:  []string{"AWS Backup pricing data not available"},
+		}
+	}
+
+	assumptions := []string{
+		fmt.Sprintf("Protected resource type: %s", resourceType),
+		"Restore and cross-region copy charges not included",
+	}
+	if coldRate, coldFound := p.pricing.BackupStoragePricePerGBMonth(resourceType, "cold"); coldFound {
+		assumptions = append(assumptions, fmt.Sprintf("Cold storage: $%.4f per GB-month", coldRate))
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_gb_month",
+		RatePerUnit:  warmRate,
+		Currency:     "USD",
+		Unit:         "GB-month",
+		Description:  fmt.Sprintf("AWS Backup %s warm storage", resourceType),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// stepFunctionsPricingSpec returns the pricing specification for a Step Functions
+// state machine: per state transition for Standard workflows, per request for Express.
+func (p *AWSPublicPlugin) stepFunctionsPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	workflowType, known := stepFunctionsWorkflowType(resource)
+	if !known {
+		workflowType = sfnTypeStandard
+	}
+
+	spec := &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          workflowType,
+		Region:       resource.GetRegion(),
+		Currency:     "USD",
+		Source:       "aws-public",
+	}
+
+	if workflowType == sfnTypeStandard {
+		spec.BillingMode = "per_transition"
+		spec.Unit = "state-transition"
+		rate, found := p.pricing.StepFunctionsPricePerStateTransition()
+		if !found {
+			spec.Description = "Step Functions pricing not found in embedded data"
+			spec.Assumptions = []string{"Step Functions pricing data not available"}
+			return spec
+		}
+		spec.RatePerUnit = rate
+		spec.Description = "Step Functions Standard workflow"
+		spec.Assumptions = []string{"Account-level free tier (4,000 transitions/month) not applied"}
+		return spec
+	}
+
+	spec.BillingMode = "per_request_and_gb_second"
+	spec.Unit = "request"
+	requestRate, reqFound := p.pricing.StepFunctionsExpressPricePerRequest()
+	tiers, tiersFound := p.pricing.StepFunctionsExpressDurationTiers()
+	if !reqFound || !tiersFound {
+		spec.Description = "Step Functions Express pricing not found in embedded data"
+		spec.Assumptions = []string{"Step Functions Express pricing data not available"}
+		return spec
+	}
+	spec.RatePerUnit = requestRate
+	spec.Description = "Step Functions Express workflow"
+	spec.Assumptions = []string{
+		fmt.Sprintf("Duration: $%.8f per GB-second (first tier)", tiers[0].Rate),
+		"Duration billed in 100 ms increments, memory in 64 MB increments",
+	}
+	return spec
+}
+
+// eventBridgePricingSpec returns the pricing specification for an EventBridge
+// custom event bus or Pipe.
+func (p *AWSPublicPlugin) eventBridgePricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	spec := &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_request",
+		Currency:     "USD",
+		Source:       "aws-public",
+	}
+
+	label := "custom event bus"
+	spec.Unit = "event"
+	rate, found := p.pricing.EventBridgePricePerCustomEvent()
+	if isEventBridgePipe(resource) {
+		label = "Pipe"
+		spec.Unit = "request"
+		rate, found = p.pricing.EventBridgePipesPricePerRequest()
+	}
+	if !found {
+		spec.Description = fmt.Sprintf("EventBridge %s pricing not found in embedded data", label)
+		spec.Assumptions = []string{"EventBridge pricing data not available"}
+		return spec
+	}
+
+	spec.RatePerUnit = rate
+	spec.Description = "EventBridge " + label
+	spec.Assumptions = []string{
+		"Each 64 KB payload chunk is billed as one event or request",
+		"Events from AWS services to the default bus are free",
+	}
+	return spec
+}
+
+// clusterDatabasePricingSpec returns the pricing specification for an Amazon
+// DocumentDB or Amazon Neptune instance class. Storage, I/O and backup rates are
+// listed in the assumptions.
+func (p *AWSPublicPlugin) clusterDatabasePricingSpec(
+	resource *pbc.ResourceDescriptor,
+	service string,
+) *pbc.PricingSpec {
+	label := clusterDatabaseLabels[service]
+	instanceClass := clusterDatabaseInstanceClass(resource)
+
+	hourlyRate, found := p.clusterDatabaseInstancePrice(service, instanceClass)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          instanceClass,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  fmt.Sprintf("%s pricing not found in embedded data", label),
+			Source:       "aws-public",
+			Assumptions:  []string{fmt.Sprintf("%s pricing data not available", label)},
+		}
+	}
+
+	assumptions := []string{
+		"On-demand pricing per instance",
+		"730 hours per month",
+	}
+	rates := p.clusterDatabaseStorageRatesFor(service)
+	if rates.storage > 0 {
+		assumptions = append(assumptions, fmt.Sprintf("Storage: $%.3f per GB-month", rates.storage))
+	}
+	if rates.io > 0 {
+		assumptions = append(assumptions, fmt.Sprintf("I/O: $%.2f per million requests", rates.io*1e6))
+	}
+	if rates.backup > 0 {
+		assumptions = append(assumptions,
+			fmt.Sprintf("Backup storage beyond cluster size: $%.3f per GB-month", rates.backup))
+	}
+	assumptions = append(assumptions, "Standard storage configuration (I/O-Optimized not modeled)")
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          instanceClass,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("%s %s instance", label, instanceClass),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// memoryDBPricingSpec returns the pricing specification for an Amazon MemoryDB node type.
+func (p *AWSPublicPlugin) memoryDBPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	nodeType := clusterDatabaseInstanceClass(resource)
+
+	hourlyRate, found := p.pricing.MemoryDBNodePricePerHour(nodeType)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          nodeType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "MemoryDB pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"MemoryDB pricing data not available"},
+		}
+	}
+
+	assumptions := []string{
+		"On-demand pricing per node (primary and replicas)",
+		"730 hours per month",
+	}
+	if writeRate, writeFound := p.pricing.MemoryDBDataWrittenPricePerGB(); writeFound {
+		assumptions = append(assumptions, fmt.Sprintf("Data written: $%.2f per GB", writeRate))
+	}
+	if snapshotRate, snapshotFound := p.pricing.MemoryDBSnapshotPricePerGBMonth(); snapshotFound {
+		assumptions = append(assumptions, fmt.Sprintf("Snapshot storage: $%.3f per GB-month", snapshotRate))
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          nodeType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("MemoryDB %s node", nodeType),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// mskPricingSpec returns the pricing specification for an Amazon MSK broker type,
+// or the cluster-hour rate for MSK Serverless clusters.
+func (p *AWSPublicPlugin) mskPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	if isMSKServerless(resource) {
+		clusterRate, found := p.pricing.MSKServerlessClusterPricePerHour()
+		if !found {
+			return &pbc.PricingSpec{
+				Provider:     resource.GetProvider(),
+				ResourceType: resource.GetResourceType(),
+				Sku:          resource.GetSku(),
+				Region:       resource.GetRegion(),
+				BillingMode:  "per_hour",
+				RatePerUnit:  0,
+				Currency:     "USD",
+				Unit:         "cluster-hour",
+				Description:  "MSK Serverless pricing not found in embedded data",
+				Source:       "aws-public",
+				Assumptions:  []string{"MSK Serverless pricing data not available"},
+			}
+		}
+
+		assumptions := []string{"730 hours per month"}
+		if rate, rateFound := p.pricing.MSKServerlessPartitionPricePerHour(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Partitions: $%.4f per partition-hour", rate))
+		}
+		if rate, rateFound := p.pricing.MSKServerlessDataInPricePerGB(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Data in: $%.2f per GB", rate))
+		}
+		if rate, rateFound := p.pricing.MSKServerlessDataOutPricePerGB(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Data out: $%.2f per GB", rate))
+		}
+		if rate, rateFound := p.pricing.MSKServerlessStoragePricePerGBMonth(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Storage: $%.2f per GB-month", rate))
+		}
+
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  clusterRate,
+			Currency:     "USD",
+			Unit:         "cluster-hour",
+			Description:  "MSK Serverless cluster",
+			Source:       "aws-public",
+			Assumptions:  assumptions,
+		}
+	}
+
+	instanceType := resource.GetSku()
+	hourlyRate, found := p.pricing.MSKBrokerPricePerHour(instanceType)
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          instanceType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "MSK pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"MSK pricing data not available"},
+		}
+	}
+
+	assumptions := []string{
+		"On-demand pricing per broker",
+		"730 hours per month",
+	}
+	if rate, rateFound := p.pricing.MSKStoragePricePerGBMonth(); rateFound {
+		assumptions = append(assumptions, fmt.Sprintf("Broker storage: $%.2f per GB-month", rate))
+	}
+	if rate, rateFound := p.pricing.MSKThroughputPricePerMBpsMonth(); rateFound {
+		assumptions = append(assumptions,
+			fmt.Sprintf("Provisioned storage throughput: $%.2f per MB/s-month", rate))
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          instanceType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("MSK %s broker", instanceType),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// sageMakerPricingSpec returns the pricing specification for a SageMaker real-time
+// endpoint or notebook instance type, or the GB-second rate for Serverless Inference.
+func (p *AWSPublicPlugin) sageMakerPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	isNotebook := isSageMakerNotebook(resource)
+
+	if !isNotebook && isSageMakerServerless(resource) {
+		gbSecondRate, found := p.pricing.SageMakerServerlessPricePerGBSecond()
+		if !found {
+			return &pbc.PricingSpec{
+				Provider:     resource.GetProvider(),
+				ResourceType: resource.GetResourceType(),
+				Sku:          resource.GetSku(),
+				Region:       resource.GetRegion(),
+				BillingMode:  "per_gb_second",
+				RatePerUnit:  0,
+				Currency:     "USD",
+				Unit:         "GB-second",
+				Description:  "SageMaker Serverless Inference pricing not found in embedded data",
+				Source:       "aws-public",
+				Assumptions:  []string{"SageMaker Serverless Inference pricing data not available"},
+			}
+		}
+
+		assumptions := []string{"Memory size rounded up to a 1 GB multiple (max 6 GB)"}
+		if rate, rateFound := p.pricing.SageMakerServerlessDataPricePerGB(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Data processed: $%.3f per GB", rate))
+		}
+
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_gb_second",
+			RatePerUnit:  gbSecondRate,
+			Currency:     "USD",
+			Unit:         "GB-second",
+			Description:  "SageMaker Serverless Inference",
+			Source:       "aws-public",
+			Assumptions:  assumptions,
+		}
+	}
+
+	instanceType := sageMakerInstanceType(resource)
+	kind := "endpoint"
+	hourlyRate, found := p.pricing.SageMakerHostingPricePerHour(instanceType)
+	if isNotebook {
+		kind = "notebook"
+		hourlyRate, found = p.pricing.SageMakerNotebookPricePerHour(instanceType)
+	}
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          instanceType,
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "SageMaker pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"SageMaker pricing data not available"},
+		}
+	}
+
+	assumptions := []string{
+		"On-demand pricing per instance",
+		"730 hours per month",
+	}
+	if isNotebook {
+		if rate, rateFound := p.pricing.SageMakerNotebookStoragePricePerGBMonth(); rateFound {
+			assumptions = append(assumptions, fmt.Sprintf("Notebook storage: $%.2f per GB-month", rate))
+		}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          instanceType,
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  fmt.Sprintf("SageMaker %s %s", kind, instanceType),
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// wafPricingSpec returns the pricing specification for an AWS WAF web ACL.
+func (p *AWSPublicPlugin) wafPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	webACLRate, found := p.pricing.WAFPricePerWebACLMonth()
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_month_plus_requests",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "web-acl-month",
+			Description:  "AWS WAF pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"AWS WAF pricing data not available"},
+		}
+	}
+
+	assumptions := []string{fmt.Sprintf("Web ACL: $%.2f per month", webACLRate)}
+	if rate, rateFound := p.pricing.WAFPricePerRuleMonth(); rateFound {
+		assumptions = append(assumptions, fmt.Sprintf("Rules: $%.2f per rule per month", rate))
+	}
+	if rate, rateFound := p.pricing.WAFPricePerRequest(); rateFound {
+		assumptions = append(assumptions, fmt.Sprintf("Requests: $%.2f per million", rate*1_000_000))
+	}
+	assumptions = append(assumptions, "Managed rule group subscriptions, Bot Control and Fraud Control not included")
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_month_plus_requests",
+		RatePerUnit:  webACLRate,
+		Currency:     "USD",
+		Unit:         "web-acl-month",
+		Description:  "AWS WAF web ACL",
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// shieldPricingSpec returns the pricing specification for a Shield Advanced
+// subscription.
+func (p *AWSPublicPlugin) shieldPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	monthlyFee, found := p.pricing.ShieldAdvancedPricePerMonth()
+	description := "Shield Advanced subscription"
+	assumptions := []string{
+		"Charged once per organization",
+		"Data transfer out for protected resources not included",
+	}
+	if !found {
+		description = "Shield Advanced pricing not found in embedded data"
+		assumptions = []string{"Shield Advanced pricing data not available"}
+	}
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_month",
+		RatePerUnit:  monthlyFee,
+		Currency:     "USD",
+		Unit:         "month",
+		Description:  description,
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
+// globalAcceleratorPricingSpec returns the pricing specification for a Global
+// Accelerator.
+func (p *AWSPublicPlugin) globalAcceleratorPricingSpec(resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	hourlyRate, found := p.pricing.GlobalAcceleratorPricePerHour()
+	if !found {
+		return &pbc.PricingSpec{
+			Provider:     resource.GetProvider(),
+			ResourceType: resource.GetResourceType(),
+			Sku:          resource.GetSku(),
+			Region:       resource.GetRegion(),
+			BillingMode:  "per_hour_plus_data",
+			RatePerUnit:  0,
+			Currency:     "USD",
+			Unit:         "hour",
+			Description:  "Global Accelerator pricing not found in embedded data",
+			Source:       "aws-public",
+			Assumptions:  []string{"Global Accelerator pricing data not available"},
+		}
+	}
+
+	assumptions := []string{"730 hours per month"}
+	if geography := regionGeography(p.region); geography != "" {
+		if rate, rateFound := p.pricing.GlobalAcceleratorDTPremiumPricePerGB(geography, geography); rateFound {
+			assumptions = append(assumptions,
+				fmt.Sprintf("DT-Premium within %s: $%.3f per GB (dominant direction)", geography, rate))
+		}
+	}
+	assumptions = append(assumptions, "Standard data transfer charges billed separately")
+
+	return &pbc.PricingSpec{
+		Provider:     resource.GetProvider(),
+		ResourceType: resource.GetResourceType(),
+		Sku:          resource.GetSku(),
+		Region:       resource.GetRegion(),
+		BillingMode:  "per_hour_plus_data",
+		RatePerUnit:  hourlyRate,
+		Currency:     "USD",
+		Unit:         "hour",
+		Description:  "Global Accelerator",
+		Source:       "aws-public",
+		Assumptions:  assumptions,
+	}
+}
+
 // zeroCostPricingSpec returns a pricing specification for AWS resources with no direct charges.
 // These include VPC, Security Groups, Subnets, IAM resources, Launch Templates, and Launch Configurations.
 // The description is sourced from the shared zeroCostResourceDescriptions map for consistency
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -48,6 +50,12 @@ func normalizeResourceType(resourceType string) string { //nolint:gocognit
 			return serviceNATGW
 		}
 
+		// EventBridge buses live under the cloudwatch module in Pulumi and must be
+		// detected before generic CloudWatch normalization.
+		if strings.HasPrefix(rt, "aws:cloudwatch/eventbus") {
+			return serviceEventBridge
+		}
+
 		// IAM resources (prefix match)
 		if strings.HasPrefix(rt, "aws:iam/") {
 			return serviceIAM
@@ -57,12 +65,17 @@ func normalizeResourceType(resourceType string) string { //nolint:gocognit
 		// Use token-aware matching to avoid false positives (e.g., "ec2/vpc" matching "ec2/vpcEndpoint")
 		awsSuffix := rt[4:] // Remove "aws:" prefix (already verified above)
 		for pattern, service := range ZeroCostPulumiPatterns {
-			// Pattern must be a complete path segment, followed by ":" (Pulumi type separator) or end of string
-			if strings.HasPrefix(awsSuffix, pattern) {
-				remaining := awsSuffix[len(pattern):]
-				if remaining == "" || remaining[0] == ':' {
-					return service
-				}
+			if hasPathSegment(awsSuffix, pattern) {
+				return service
+			}
+		}
+
+		// Configuration resources of a billed module (e.g., aws:kms/alias) have no
+		// direct charge and must not be priced as the module's billed resource.
+		if module, _, found := strings.Cut(awsSuffix, "/"); found {
+			if billed, ok := BilledPulumiResources[module]; ok &&
+				!slices.ContainsFunc(billed, func(pattern string) bool { return hasPathSegment(awsSuffix, pattern) }) {
+				return serviceConfigOnly
 			}
 		}
 
@@ -70,23 +83,8 @@ func normalizeResourceType(resourceType string) string { //nolint:gocognit
 		if len(parts) > 0 {
 			// Extract service from aws:<service>/...
 			svcParts := strings.Split(parts[0], ":")
-			svc := svcParts[0]
-			switch svc {
-			case serviceEC2,
-				serviceEBS,
-				serviceRDS,
-				serviceS3,
-				serviceLambda,
-				serviceDynamoDB,
-				serviceEKS,
-				serviceNATGW,
-				serviceCloudWatch,
-				serviceElastiCache:
+			if svc, ok := canonicalService(svcParts[0]); ok {
 				return svc
-			case "lb", serviceALB, serviceNLB:
-				return serviceELB
-			case "natgateway":
-				return serviceNATGW
 			}
 		}
 		// If it's an AWS resource but we don't recognize the service canonical form,
@@ -97,6 +95,14 @@ func normalizeResourceType(resourceType string) string { //nolint:gocognit
 	return rt
 }
 
+// hasPathSegment reports whether a Pulumi type without its "aws:" prefix starts
+// with the pattern as a complete path segment, followed by ":" (the Pulumi type
+// separator) or the end of the string, so "ec2/vpc" does not match "ec2/vpcEndpoint".
+func hasPathSegment(awsSuffix, pattern string) bool {
+	remaining, found := strings.CutPrefix(awsSuffix, pattern)
+	return found && (remaining == "" || remaining[0] == ':')
+}
+
 // extractAWSSKU extracts AWS SKU from tags with priority matching SDK behavior.
 // Uses SDK mapping.ExtractSKU with extended key list for backwards compatibility
 // with both camelCase (SDK standard) and snake_case (legacy) property names.
@@ -145,9 +151,19 @@ var validRDSStorageTypes = map[string]bool{
 }
 
 // GetProjectedCost estimates the monthly cost for the given resource.
-func (p *AWSPublicPlugin) GetProjectedCost( //nolint:funlen
+func (p *AWSPublicPlugin) GetProjectedCost(
 	ctx context.Context,
 	req *pbc.GetProjectedCostRequest,
+) (*pbc.GetProjectedCostResponse, error) {
+	return p.projectedCost(ctx, p.config(), req)
+}
+
+// projectedCost implements GetProjectedCost with the given settings, so callers
+// pricing several resources in one request use a single settings snapshot.
+func (p *AWSPublicPlugin) projectedCost( //nolint:funlen
+	ctx context.Context,
+	settings *pluginSettings,
+	req *pbc.GetProjectedCostRequest,
 ) (*pbc.GetProjectedCostResponse, error) {
 	start := time.Now()
 	traceID := p.getTraceID(ctx)
@@ -179,7 +195,7 @@ func (p *AWSPublicPlugin) GetProjectedCost( //nolint:funlen
 	}
 
 	// Test mode: Enhanced logging for request details (US3)
-	if p.testMode {
+	if settings.testMode {
 		p.logger.Debug().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Str("resource_type", resource.GetResourceType()).
@@ -195,33 +211,9 @@ func (p *AWSPublicPlugin) GetProjectedCost( //nolint:funlen
 
 	// Use cached service type from resolver (optimization: SC-002)
 	serviceType := resolver.ServiceType()
-	switch serviceType {
-	case serviceEC2:
-		resp, err = p.estimateEC2(traceID, resource, req)
-	case serviceEBS:
-		resp, err = p.estimateEBS(traceID, resource)
-	case serviceRDS:
-		resp, err = p.estimateRDS(traceID, resource)
-	case serviceEKS:
-		resp, err = p.estimateEKS(traceID, resource)
-	case serviceS3:
-		resp, err = p.estimateS3(traceID, resource)
-	case serviceLambda:
-		resp, err = p.estimateLambda(traceID, resource)
-	case serviceDynamoDB:
-		resp, err = p.estimateDynamoDB(traceID, resource)
-	case serviceELB:
-		resp, err = p.estimateELB(traceID, resource)
-	case serviceNATGW:
-		resp, err = p.estimateNATGateway(traceID, resource)
-	case serviceCloudWatch:
-		resp, err = p.estimateCloudWatch(traceID, resource)
-	case serviceElastiCache:
-		resp, err = p.estimateElastiCache(traceID, resource)
-	case serviceVPC, serviceSecurityGroup, serviceSubnet, serviceIAM, serviceLaunchTmpl, serviceLaunchConfig:
-		// Zero-cost AWS networking, IAM, and configuration-only resources - no direct charges
-		resp = p.estimateZeroCostResource(traceID, resource, serviceType)
-	default:
+	if estimator, ok := lookupEstimator(serviceType); ok {
+		resp, err = estimator.ProjectedCost(p, settings, traceID, resource, req)
+	} else {
 		// Unknown resource type - return $0 with explanation
 		resp = &pbc.GetProjectedCostResponse{
 			CostPerMonth: 0,
@@ -250,7 +242,7 @@ func (p *AWSPublicPlugin) GetProjectedCost( //nolint:funlen
 	}
 
 	// Test mode: Enhanced logging for calculation result (US3)
-	if p.testMode {
+	if settings.testMode {
 		p.logger.Debug().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Float64("unit_price", resp.GetUnitPrice()).
@@ -274,8 +266,10 @@ func (p *AWSPublicPlugin) GetProjectedCost( //nolint:funlen
 }
 
 // estimateEC2 calculates the projected monthly cost for an EC2 instance.
-// traceID is passed from the parent handler to ensure consistent trace correlation.
+// traceID is passed from the parent handler to ensure consistent trace correlation;
+// settings decides whether embodied carbon is included.
 func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
+	settings *pluginSettings,
 	traceID string,
 	resource *pbc.ResourceDescriptor,
 	req *pbc.GetProjectedCostRequest,
@@ -307,17 +301,25 @@ func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
 		Float64("unit_price", hourlyRate).
 		Msg("EC2 pricing lookup successful")
 
-	// FR-021: Calculate monthly cost (730 hours/month)
-	computeCost := hourlyRate * carbon.HoursPerMonth
+	schedule, err := p.usageSchedule(traceID, "aws:ec2:instance", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	// FR-021: Calculate monthly cost (730 hours/month unless scheduled)
+	var components CostBreakdown
+	computeCost := components.Add("instance", schedule.hours, "Hours", hourlyRate,
+		instanceType+"/"+ec2Attrs.OS+"/"+ec2Attrs.Tenancy)
 	costPerMonth := computeCost
-	billingDetail := fmt.Sprintf("On-demand %s, %s tenancy, 730 hrs/month", ec2Attrs.OS, ec2Attrs.Tenancy)
+	billingDetail := fmt.Sprintf("On-demand %s, %s tenancy, %s", ec2Attrs.OS, ec2Attrs.Tenancy,
+		schedule.billingDetail())
 
 	// Root EBS volume cost: Include root volume storage when tag info is present
 	rootVol := ExtractRootVolumeFromTags(resource.GetTags(), *p.traceLogger(traceID, "GetProjectedCost"))
 	var rootVolumeCost float64
 	if rootVol.Present {
 		if ebsRate, ebsFound := p.pricing.EBSPricePerGBMonth(rootVol.VolumeType); ebsFound {
-			rootVolumeCost = ebsRate * float64(rootVol.SizeGB)
+			rootVolumeCost = components.Add("root_volume", float64(rootVol.SizeGB), "GB-Mo", ebsRate, rootVol.VolumeType)
 			costPerMonth += rootVolumeCost
 			billingDetail += fmt.Sprintf(
 				" + %dGB %s root volume ($%.2f/mo)",
@@ -355,6 +357,8 @@ func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Carbon estimation: Calculate carbon footprint for EC2 instance
 	var perResourceUtil *float64
@@ -363,7 +367,7 @@ func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
 	}
 	utilization := carbon.GetUtilization(req.GetUtilizationPercentage(), perResourceUtil)
 	carbonGrams, carbonOK := p.carbonEstimator.EstimateCarbonGrams(
-		instanceType, resource.GetRegion(), utilization, carbon.HoursPerMonth,
+		instanceType, resource.GetRegion(), utilization, schedule.hours,
 	)
 
 	// Add root volume EBS carbon if applicable
@@ -380,8 +384,21 @@ func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
 		}
 	}
 
-	totalCarbonGrams := carbonGrams + rootCarbonGrams
-	if carbonOK || rootCarbonGrams > 0 {
+	// Embodied carbon of the hardware for one month of its lifetime; it is
+	// amortized whether or not the instance runs, so the schedule does not apply
+	var embodiedCarbonGrams float64
+	if settings.embodiedCarbon {
+		if embodied, ok := p.embodiedEstimator.EstimateEmbodiedCarbonGrams(instanceType, 1); ok {
+			embodiedCarbonGrams = embodied
+			if resp.Metadata == nil {
+				resp.Metadata = make(map[string]string, 1)
+			}
+			resp.Metadata[metadataKeyEmbodiedCarbonGrams] = strconv.FormatFloat(embodied, 'f', 2, 64)
+		}
+	}
+
+	totalCarbonGrams := carbonGrams + rootCarbonGrams + embodiedCarbonGrams
+	if carbonOK || rootCarbonGrams > 0 || embodiedCarbonGrams > 0 {
 		resp.ImpactMetrics = []*pbc.ImpactMetric{
 			{
 				Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
@@ -396,6 +413,7 @@ func (p *AWSPublicPlugin) estimateEC2( //nolint:funlen
 			Float64("utilization", utilization).
 			Float64("compute_carbon_grams", carbonGrams).
 			Float64("root_ebs_carbon_grams", rootCarbonGrams).
+			Float64("embodied_carbon_grams", embodiedCarbonGrams).
 			Float64("total_carbon_grams", totalCarbonGrams).
 			Msg("Carbon estimation successful")
 	} else {
@@ -460,7 +478,8 @@ func (p *AWSPublicPlugin) estimateEBS(
 		Msg("EBS pricing lookup successful")
 
 	// Calculate monthly cost
-	costPerMonth := ratePerGBMonth * float64(sizeGB)
+	var components CostBreakdown
+	costPerMonth := components.Add("storage", float64(sizeGB), "GB-Mo", ratePerGBMonth, volumeType)
 
 	// FR-043: Include assumption in billing_detail if size was defaulted
 	var billingDetail string
@@ -484,6 +503,7 @@ func (p *AWSPublicPlugin) estimateEBS(
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
 
 	// Carbon estimation for EBS volume
 	carbonGrams, carbonOK := p.ebsEstimator.EstimateCarbonGrams(carbon.EBSVolumeConfig{
@@ -557,8 +577,19 @@ func (p *AWSPublicPlugin) estimateS3(
 		Float64("unit_price", ratePerGBMonth).
 		Msg("S3 pricing lookup successful")
 
-	// Calculate monthly cost
-	costPerMonth := ratePerGBMonth * sizeGB
+	// Calculate monthly cost. Tiered classes (S3 Standard) are priced through
+	// the volume tiers, reported at the blended rate. The SKU is the storage
+	// class, which BatchCost uses to sum the tiers per class (see aggregateTiers).
+	var components CostBreakdown
+	var costPerMonth float64
+	tiers, tiered := p.pricing.S3StorageTiers(storageClass)
+	if tiered && sizeGB > tiers[0].UpTo {
+		costPerMonth = components.AddSubtotal("storage", sizeGB, "GB-Mo",
+			calculateTieredCost(sizeGB, tiers), storageClass)
+		ratePerGBMonth = costPerMonth / sizeGB
+	} else {
+		costPerMonth = components.Add("storage", sizeGB, "GB-Mo", ratePerGBMonth, storageClass)
+	}
 
 	// Include assumption in billing_detail if size was defaulted
 	var billingDetail string
@@ -572,6 +603,9 @@ func (p *AWSPublicPlugin) estimateS3(
 	} else {
 		billingDetail = fmt.Sprintf("S3 %s storage, %.0f GB, $%.4f/GB-month", storageClass, sizeGB, ratePerGBMonth)
 	}
+	if tiered && sizeGB > tiers[0].UpTo {
+		billingDetail += " (blended across volume tiers)"
+	}
 
 	// Track defaults for metadata enrichment
 	var dt DefaultsTracker
@@ -589,6 +623,7 @@ func (p *AWSPublicPlugin) estimateS3(
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
 
 	// Carbon estimation for S3 storage
 	s3Estimator := carbon.NewS3Estimator()
@@ -698,9 +733,12 @@ func (p *AWSPublicPlugin) estimateDynamoDB( //nolint:gocognit,funlen // length f
 	}
 
 	storagePrice, storageFound := p.pricing.DynamoDBStoragePricePerGBMonth()
-	storageCost := storageGB * storagePrice
+	var components CostBreakdown
+	var storageCost float64
 	var unavailable []string
-	if !storageFound {
+	if storageFound {
+		storageCost = components.Add("storage", storageGB, "GB-Mo", storagePrice, "storage")
+	} else {
 		p.logger.Warn().
 			Str(pluginsdk.FieldTraceID, traceID).
 			Str("component", "Storage").
@@ -748,8 +786,13 @@ func (p *AWSPublicPlugin) estimateDynamoDB( //nolint:gocognit,funlen // length f
 		}
 
 		// Monthly cost = (RCU * 730 * price) + (WCU * 730 * price) + (Storage * price)
-		rcuCost := float64(readUnits) * 730 * rcuPrice
-		wcuCost := float64(writeUnits) * 730 * wcuPrice
+		var rcuCost, wcuCost float64
+		if rcuFound {
+			rcuCost = components.Add("read_capacity", float64(readUnits)*730, "RCU-Hours", rcuPrice, "provisioned-rcu")
+		}
+		if wcuFound {
+			wcuCost = components.Add("write_capacity", float64(writeUnits)*730, "WCU-Hours", wcuPrice, "provisioned-wcu")
+		}
 		totalCost := rcuCost + wcuCost + storageCost
 
 		billingDetail = fmt.Sprintf("DynamoDB provisioned, %d RCUs, %d WCUs, 730 hrs/month, %.0fGB storage",
@@ -778,6 +821,7 @@ func (p *AWSPublicPlugin) estimateDynamoDB( //nolint:gocognit,funlen // length f
 			BillingDetail: billingDetail,
 			Metadata:      dt.Metadata(),
 		}
+		attachCostComponents(resp, &components)
 
 		// Carbon estimation for DynamoDB (storage-based)
 		dynamoEstimator := carbon.NewDynamoDBEstimator()
@@ -849,8 +893,13 @@ func (p *AWSPublicPlugin) estimateDynamoDB( //nolint:gocognit,funlen // length f
 
 	// Monthly cost = (Reads * readPrice) + (Writes * writePrice) + (Storage * storagePrice)
 	// Prices are per request unit
-	readCost := float64(readUnits) * readPrice
-	writeCost := float64(writeUnits) * writePrice
+	var readCost, writeCost float64
+	if readFound {
+		readCost = components.Add("read_requests", float64(readUnits), "ReadRequestUnits", readPrice, "on-demand-read")
+	}
+	if writeFound {
+		writeCost = components.Add("write_requests", float64(writeUnits), "WriteRequestUnits", writePrice, "on-demand-write")
+	}
 	totalCost := readCost + writeCost + storageCost
 
 	billingDetail = fmt.Sprintf("DynamoDB on-demand, %d reads, %d writes, %.0fGB storage",
@@ -879,6 +928,7 @@ func (p *AWSPublicPlugin) estimateDynamoDB( //nolint:gocognit,funlen // length f
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
 
 	// Carbon estimation for DynamoDB (storage-based)
 	dynamoEstimator := carbon.NewDynamoDBEstimator()
@@ -1007,14 +1057,21 @@ func (p *AWSPublicPlugin) estimateELB(
 		}
 	}
 
+	schedule, err := p.usageSchedule(traceID, "aws:elasticloadbalancing:loadbalancer", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
 	// 4. Calculate Costs
-	fixedMonthly := carbon.HoursPerMonth * fixedRate
-	cuMonthly := carbon.HoursPerMonth * capacityUnits * cuRate
+	var components CostBreakdown
+	fixedMonthly := components.Add("hourly", schedule.hours, "Hours", fixedRate, lbType)
+	cuMonthly := components.Add(strings.ToLower(cuMetricName), schedule.hours*capacityUnits,
+		cuMetricName+"-Hours", cuRate, lbType+"-"+strings.ToLower(cuMetricName))
 	totalMonthly := fixedMonthly + cuMonthly
 
 	// 5. Build Billing Detail
-	billingDetail := fmt.Sprintf("%s, 730 hrs/month, %.1f %s avg/hr",
-		strings.ToUpper(lbType), capacityUnits, cuMetricName)
+	billingDetail := fmt.Sprintf("%s, %s, %.1f %s avg/hr",
+		strings.ToUpper(lbType), schedule.billingDetail(), capacityUnits, cuMetricName)
 
 	p.logger.Debug().
 		Str("lb_type", lbType).
@@ -1038,6 +1095,8 @@ func (p *AWSPublicPlugin) estimateELB(
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Apply growth hint enrichment
 	setGrowthHint(
@@ -1144,9 +1203,19 @@ func (p *AWSPublicPlugin) estimateRDS( //nolint:gocognit,funlen
 		Float64("storage_rate", storageRate).
 		Msg("RDS pricing lookup successful")
 
-	// Calculate monthly costs
-	instanceCostPerMonth := hourlyRate * carbon.HoursPerMonth
-	storageCostPerMonth := storageRate * float64(storageSizeGB)
+	schedule, err := p.usageSchedule(traceID, "aws:rds:instance", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate monthly costs; storage accrues whether or not the instance is running
+	var components CostBreakdown
+	instanceCostPerMonth := components.Add("instance", schedule.hours, "Hours", hourlyRate,
+		instanceType+"/"+normalizedEngine)
+	var storageCostPerMonth float64
+	if storageFound {
+		storageCostPerMonth = components.Add("storage", float64(storageSizeGB), "GB-Mo", storageRate, storageType)
+	}
 	totalCostPerMonth := instanceCostPerMonth + storageCostPerMonth
 
 	// Build billing detail message
@@ -1163,11 +1232,12 @@ func (p *AWSPublicPlugin) estimateRDS( //nolint:gocognit,funlen
 	}
 
 	if len(defaultNotes) > 0 {
-		billingDetail = fmt.Sprintf("RDS %s %s, 730 hrs/month + %dGB %s storage (%s)",
-			instanceType, normalizedEngine, storageSizeGB, storageType, strings.Join(defaultNotes, ", "))
+		billingDetail = fmt.Sprintf("RDS %s %s, %s + %dGB %s storage (%s)",
+			instanceType, normalizedEngine, schedule.billingDetail(), storageSizeGB, storageType,
+			strings.Join(defaultNotes, ", "))
 	} else {
-		billingDetail = fmt.Sprintf("RDS %s %s, 730 hrs/month + %dGB %s storage",
-			instanceType, normalizedEngine, storageSizeGB, storageType)
+		billingDetail = fmt.Sprintf("RDS %s %s, %s + %dGB %s storage",
+			instanceType, normalizedEngine, schedule.billingDetail(), storageSizeGB, storageType)
 	}
 
 	// Track defaults for metadata enrichment
@@ -1189,6 +1259,8 @@ func (p *AWSPublicPlugin) estimateRDS( //nolint:gocognit,funlen
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Carbon estimation for RDS instance (compute + storage)
 	rdsEstimator := carbon.NewRDSEstimator()
@@ -1229,24 +1301,9 @@ func (p *AWSPublicPlugin) estimateRDS( //nolint:gocognit,funlen
 // detectService maps a provider resource type string to a normalized service identifier.
 // The input resourceType is expected to be normalized by normalizeResourceType().
 func detectService(resourceType string) string {
-	// Fast path for canonical forms
-	switch resourceType {
-	case serviceEC2,
-		serviceEBS,
-		serviceRDS,
-		serviceS3,
-		serviceLambda,
-		serviceDynamoDB,
-		serviceEKS,
-		serviceELB,
-		serviceNATGW,
-		serviceCloudWatch,
-		serviceElastiCache:
-		return resourceType
-	case serviceALB, serviceNLB:
-		return serviceELB
-	case "nat_gateway", "nat-gateway", "natgateway":
-		return serviceNATGW
+	// Fast path for canonical forms and aliases
+	if svc, ok := canonicalService(resourceType); ok {
+		return svc
 	}
 
 	// Zero-cost networking resources (no direct AWS charges)
@@ -1256,47 +1313,8 @@ func detectService(resourceType string) string {
 	}
 
 	// Fallback for legacy patterns if normalization didn't catch them
-	resourceTypeLower := strings.ToLower(resourceType)
-
-	if strings.Contains(resourceTypeLower, "ec2/instance") {
-		return serviceEC2
-	}
-	if strings.Contains(resourceTypeLower, "ebs/volume") || strings.Contains(resourceTypeLower, "ec2/volume") {
-		return serviceEBS
-	}
-	if strings.Contains(resourceTypeLower, "rds/instance") {
-		return serviceRDS
-	}
-	if strings.Contains(resourceTypeLower, "eks/cluster") {
-		return serviceEKS
-	}
-	if strings.Contains(resourceTypeLower, "s3/bucket") {
-		return serviceS3
-	}
-	if strings.Contains(resourceTypeLower, "lambda/function") {
-		return serviceLambda
-	}
-	if strings.Contains(resourceTypeLower, "dynamodb/table") {
-		return serviceDynamoDB
-	}
-	if strings.Contains(resourceTypeLower, "lb/loadbalancer") ||
-		strings.Contains(resourceTypeLower, "alb/loadbalancer") ||
-		strings.Contains(resourceTypeLower, "nlb/loadbalancer") {
-		return serviceELB
-	}
-	if strings.Contains(resourceTypeLower, "ec2/natgateway") {
-		return serviceNATGW
-	}
-	if strings.Contains(resourceTypeLower, "cloudwatch/loggroup") ||
-		strings.Contains(resourceTypeLower, "cloudwatch/logstream") ||
-		strings.Contains(resourceTypeLower, "cloudwatch/metricalarm") {
-		return serviceCloudWatch
-	}
-	if strings.Contains(resourceTypeLower, "elasticache/") {
-		return serviceElastiCache
-	}
-	if strings.Contains(resourceTypeLower, "iam/") {
-		return serviceIAM
+	if svc, ok := serviceForPattern(strings.ToLower(resourceType)); ok {
+		return svc
 	}
 
 	return resourceType
@@ -1307,7 +1325,17 @@ func detectService(resourceType string) string {
 func (p *AWSPublicPlugin) estimateEKS(
 	traceID string,
 	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
 ) (*pbc.GetProjectedCostResponse, error) {
+	// Managed node groups and Fargate profiles share the "eks" service but are
+	// billed as worker compute rather than control plane hours.
+	switch {
+	case isEKSNodeGroup(resource):
+		return p.estimateEKSNodeGroup(traceID, resource, req)
+	case isEKSFargateProfile(resource):
+		return p.estimateEKSFargateProfile(traceID)
+	}
+
 	// Determine support type from resource SKU or tags
 	// SKU = "cluster" (standard) or "cluster-extended" (extended support)
 	// OR use tags: tags["support_type"] == "extended" (case-insensitive)
@@ -1333,15 +1361,21 @@ func (p *AWSPublicPlugin) estimateEKS(
 		Float64("hourly_rate", hourlyRate).
 		Msg("EKS pricing lookup successful")
 
-	// Calculate monthly cost (730 hours/month)
-	costPerMonth := hourlyRate * carbon.HoursPerMonth
-
 	// Determine support type description
 	supportType := "standard support"
 	if extendedSupport {
 		supportType = "extended support"
 	}
 
+	schedule, err := p.usageSchedule(traceID, "aws:eks:cluster", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	// Calculate monthly cost (730 hours/month unless scheduled)
+	var components CostBreakdown
+	costPerMonth := components.Add("control_plane", schedule.hours, "Hours", hourlyRate, supportType)
+
 	// Track defaults for metadata enrichment
 	var dt DefaultsTracker
 	if !supportTypeExplicit {
@@ -1353,11 +1387,13 @@ func (p *AWSPublicPlugin) estimateEKS(
 		UnitPrice:    hourlyRate,
 		Currency:     "USD",
 		BillingDetail: fmt.Sprintf(
-			"EKS cluster (%s), 730 hrs/month (control plane only, excludes worker nodes)",
-			supportType,
+			"EKS cluster (%s), %s (control plane only, excludes worker nodes)",
+			supportType, schedule.billingDetail(),
 		),
 		Metadata: dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Carbon estimation for EKS (control plane is shared, returns 0)
 	eksEstimator := carbon.NewEKSEstimator()
@@ -1387,6 +1423,248 @@ func (p *AWSPublicPlugin) estimateEKS(
 	return resp, nil
 }
 
+// isEKSNodeGroup reports whether the resource is an EKS managed node group.
+func isEKSNodeGroup(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "nodegroup")
+}
+
+// isEKSFargateProfile reports whether the resource is an EKS Fargate profile.
+func isEKSFargateProfile(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "fargateprofile")
+}
+
+// EKS managed node group defaults applied when the corresponding tags are absent.
+const (
+	defaultEKSNodeInstanceType      = "t3.medium" // EKS managed node group default
+	defaultEKSNodeDesiredSize       = 2           // EKS managed node group default
+	defaultEKSNodeDiskSizeGB        = 20          // GiB, EKS default for Linux AMIs
+	defaultEKSWindowsNodeDiskSizeGB = 50          // GiB, EKS default for Windows AMIs
+	eksNodeDiskVolumeType           = "gp2"       // Volume type of the node group default launch template
+	eksCapacityOnDemand             = "ON_DEMAND"
+	eksCapacitySpot                 = "SPOT"
+	// eksSpotDiscount is the assumed saving of spot over on-demand capacity. Spot
+	// prices are not in the public price list; AWS quotes savings of up to 90%,
+	// and 70% is a typical long-run average for general purpose instance types.
+	eksSpotDiscount = 0.70
+)
+
+// eksNodeGroupInstanceType resolves the node group instance type. A SKU that looks
+// like an EC2 instance type wins, then the first entry of the instance_types
+// attribute, then the generic instance type tags. Returns true when defaulted.
+func eksNodeGroupInstanceType(resource *pbc.ResourceDescriptor) (string, bool) {
+	if sku := resource.GetSku(); strings.Contains(sku, ".") {
+		return sku, false
+	}
+	tags := resource.GetTags()
+	for _, key := range []string{"instance_types", "instanceTypes"} {
+		list := strings.Trim(strings.TrimSpace(tags[key]), "[]")
+		list = strings.NewReplacer(`"`, " ", ",", " ").Replace(list)
+		if fields := strings.Fields(list); len(fields) > 0 {
+			return fields[0], false
+		}
+	}
+	if instanceType := extractAWSSKU(tags); strings.Contains(instanceType, ".") {
+		return instanceType, false
+	}
+	return defaultEKSNodeInstanceType, true
+}
+
+// eksNodeGroupDesiredSize resolves the node count from desired_size or from the
+// desiredSize field of a serialized scalingConfig attribute.
+func eksNodeGroupDesiredSize(tags map[string]string) (int, bool) {
+	for _, key := range []string{"desired_size", "desiredSize"} {
+		if v, ok := parseNonNegativeTag(tags, key); ok {
+			return int(v), true
+		}
+	}
+	for _, key := range []string{"scaling_config", "scalingConfig"} {
+		cfg := parseGoMapString(tags[key])
+		for _, field := range []string{"desiredSize", "desired_size"} {
+			if v, ok := parseNonNegativeTag(cfg, field); ok {
+				return int(v), true
+			}
+		}
+	}
+	return 0, false
+}
+
+// eksNodeGroupTag returns the first non-empty value among snake_case and camelCase keys.
+func eksNodeGroupTag(tags map[string]string, keys ...string) string {
+	for _, key := range keys {
+		if v := strings.TrimSpace(tags[key]); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// estimateEKSNodeGroup calculates projected monthly cost for an EKS managed node
+// group as desired_size EC2 instances plus one node disk per instance. SPOT capacity
+// is priced at eksSpotDiscount below the on-demand rate because spot prices are not
+// part of the public price list, and is reported as a low quality estimate.
+func (p *AWSPublicPlugin) estimateEKSNodeGroup( //nolint:funlen
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	instanceType, typeDefaulted := eksNodeGroupInstanceType(resource)
+	if typeDefaulted {
+		dt.Add("instance_types", instanceType, KindConfig)
+	}
+
+	desiredSize, sizeFound := eksNodeGroupDesiredSize(tags)
+	if !sizeFound {
+		desiredSize = defaultEKSNodeDesiredSize
+		dt.Add("desired_size", strconv.Itoa(desiredSize), KindConfig)
+	}
+
+	capacityType := strings.ToUpper(eksNodeGroupTag(tags, "capacity_type", "capacityType"))
+	switch capacityType {
+	case eksCapacityOnDemand, eksCapacitySpot:
+	case "":
+		capacityType = eksCapacityOnDemand
+		dt.Add("capacity_type", capacityType, KindConfig)
+	default:
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid capacity_type %q: must be ON_DEMAND or SPOT", capacityType),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	operatingSystem := "Linux"
+	defaultDiskGB := defaultEKSNodeDiskSizeGB
+	if strings.Contains(strings.ToUpper(eksNodeGroupTag(tags, "ami_type", "amiType")), "WINDOWS") {
+		operatingSystem = "Windows"
+		defaultDiskGB = defaultEKSWindowsNodeDiskSizeGB
+	}
+
+	diskSizeGB := float64(defaultDiskGB)
+	if v, ok := parseNonNegativeTag(tags, "disk_size"); ok {
+		diskSizeGB = v
+	} else if v, ok = parseNonNegativeTag(tags, "diskSize"); ok {
+		diskSizeGB = v
+	} else {
+		dt.Add("disk_size", strconv.Itoa(defaultDiskGB), KindConfig)
+	}
+
+	hourlyRate, found := p.pricing.EC2OnDemandPricePerHour(instanceType, operatingSystem, "Shared")
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "EKS",
+			SKU:           instanceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "EC2 instance type", instanceType),
+		}
+	}
+
+	schedule, err := p.usageSchedule(traceID, "aws:eks:nodegroup", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	nodeSKU := instanceType + "/" + operatingSystem + "/Shared"
+	if capacityType == eksCapacitySpot {
+		// An assumed discount rather than a published rate, so the quality is low
+		hourlyRate *= 1 - eksSpotDiscount
+		nodeSKU += "/Spot"
+		dt.Add("spot_discount", strconv.FormatFloat(eksSpotDiscount, 'f', -1, 64), KindUsageZero)
+	}
+
+	nodes := float64(desiredSize)
+	var components CostBreakdown
+	costPerMonth := components.Add("node_instances", nodes*schedule.hours, "Hours", hourlyRate, nodeSKU)
+	billingDetail := fmt.Sprintf(
+		"EKS node group %s %s, %d node(s), %s",
+		capacityType, instanceType, desiredSize, schedule.billingDetail(),
+	)
+
+	if diskSizeGB > 0 {
+		if ebsRate, ebsFound := p.pricing.EBSPricePerGBMonth(eksNodeDiskVolumeType); ebsFound {
+			costPerMonth += components.Add("node_disks", diskSizeGB*nodes, "GB-Mo", ebsRate, eksNodeDiskVolumeType)
+			billingDetail += fmt.Sprintf(" + %gGB %s disk per node", diskSizeGB, eksNodeDiskVolumeType)
+		} else {
+			p.traceLogger(traceID, "GetProjectedCost").Warn().
+				Str("volume_type", eksNodeDiskVolumeType).
+				Msg("EKS node disk volume type not found in pricing data, skipping disk cost")
+		}
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: costPerMonth,
+		UnitPrice:    hourlyRate,
+		Currency:     "USD",
+		Metadata:     dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
+	if capacityType == eksCapacitySpot {
+		resp.PricingCategory = pbc.FocusPricingCategory_FOCUS_PRICING_CATEGORY_DYNAMIC
+		billingDetail += fmt.Sprintf(" (spot capacity priced at an assumed %.0f%% discount to the on-demand rate)",
+			eksSpotDiscount*100)
+	}
+	resp.BillingDetail = billingDetail
+
+	p.logger.Debug().
+		Str("aws_region", p.region).
+		Str("instance_type", instanceType).
+		Str("capacity_type", capacityType).
+		Int("desired_size", desiredSize).
+		Float64("hourly_rate", hourlyRate).
+		Msg("EKS node group pricing lookup successful")
+
+	// Carbon: one EC2 instance plus one node disk per desired node
+	var perResourceUtil *float64
+	if u := resource.GetUtilizationPercentage(); u > 0 {
+		perResourceUtil = &u
+	}
+	utilization := carbon.GetUtilization(req.GetUtilizationPercentage(), perResourceUtil)
+	computeCarbon, computeOK := p.carbonEstimator.EstimateCarbonGrams(
+		instanceType, resource.GetRegion(), utilization, schedule.hours,
+	)
+	var diskCarbon float64
+	if diskSizeGB > 0 {
+		if grams, ok := p.ebsEstimator.EstimateCarbonGrams(carbon.EBSVolumeConfig{
+			VolumeType: eksNodeDiskVolumeType,
+			SizeGB:     diskSizeGB,
+			Region:     resource.GetRegion(),
+			Hours:      carbon.HoursPerMonth,
+		}); ok {
+			diskCarbon = grams
+		}
+	}
+	if computeOK || diskCarbon > 0 {
+		resp.ImpactMetrics = []*pbc.ImpactMetric{
+			{
+				Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
+				Value: (computeCarbon + diskCarbon) * nodes,
+				Unit:  "gCO2e",
+			},
+		}
+	}
+
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:eks:nodegroup", resp)
+
+	return resp, nil
+}
+
+// estimateEKSFargateProfile returns $0 for an EKS Fargate profile. The profile only
+// selects which pods run on Fargate; the pods are billed per vCPU and GB-hour while
+// running, which cannot be derived from the profile itself.
+func (p *AWSPublicPlugin) estimateEKSFargateProfile(traceID string) (*pbc.GetProjectedCostResponse, error) {
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: 0,
+		UnitPrice:    0,
+		Currency:     "USD",
+		BillingDetail: "EKS Fargate profile has no direct cost; " +
+			"pods scheduled on Fargate are billed per vCPU and GB-hour while running",
+	}
+
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:eks:fargateprofile", resp)
+
+	return resp, nil
+}
+
 // estimateLambda calculates projected monthly cost for Lambda functions.
 // Uses request count and GB-seconds from resource tags.
 func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
@@ -1457,9 +1735,24 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 	// Total GB-Seconds = Memory (GB) * Duration (Seconds) * Request Count
 	totalGBSec := memoryGB * durationSeconds * float64(requestsPerMonth)
 
-	requestCost := float64(requestsPerMonth) * reqPrice
-	computeCost := totalGBSec * gbSecPrice
-	totalCost := requestCost + computeCost
+	var components CostBreakdown
+	requestCost := components.Add("requests", float64(requestsPerMonth), "Requests", reqPrice, "request")
+
+	// 4b. Optional add-ons (provisioned concurrency, ephemeral storage, SnapStart)
+	addOns, err := p.estimateLambdaAddOns(traceID, resource.GetTags(), architecture, lambdaUsage{
+		memoryGB:        memoryGB,
+		durationSeconds: durationSeconds,
+		requests:        requestsPerMonth,
+		totalGBSec:      totalGBSec,
+		gbSecPrice:      gbSecPrice,
+	})
+	if err != nil {
+		return nil, err
+	}
+	computeCost := components.AddSubtotal("duration", totalGBSec, "GB-Seconds", addOns.computeCost,
+		lambdaPriceArch(architecture))
+	components.Append(&addOns.components)
+	totalCost := requestCost + computeCost + addOns.totalAddOnCost()
 
 	// 5. Build Billing Detail
 	var notes []string
@@ -1491,6 +1784,9 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 		detail += fmt.Sprintf(" (%s)", strings.Join(notes, ", "))
 	}
 	detail += fmt.Sprintf(", %.0f GB-seconds", totalGBSec)
+	if len(addOns.details) > 0 {
+		detail += ", " + strings.Join(addOns.details, ", ")
+	}
 
 	p.logger.Debug().
 		Int("memory_mb", memoryMB).
@@ -1498,6 +1794,7 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 		Int64("requests", requestsPerMonth).
 		Int("duration_ms", avgDurationMs).
 		Float64("gb_seconds", totalGBSec).
+		Float64("add_on_cost", addOns.totalAddOnCost()).
 		Float64("total_cost", totalCost).
 		Msg("Lambda cost estimated")
 
@@ -1515,6 +1812,9 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 	if requestsDefaulted {
 		dt.Add("requests_per_month", "0", KindUsageZero)
 	}
+	if addOns.restoresDefaulted {
+		dt.Add("snapstart_restores_per_month", "0", KindUsageZero)
+	}
 
 	resp := &pbc.GetProjectedCostResponse{
 		CostPerMonth:  totalCost,
@@ -1523,6 +1823,7 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 		BillingDetail: detail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
 
 	// Carbon estimation for Lambda function
 	lambdaEstimator := carbon.NewLambdaEstimator()
@@ -1558,6 +1859,160 @@ func (p *AWSPublicPlugin) estimateLambda( //nolint:gocognit,funlen
 	return resp, nil
 }
 
+// Lambda ephemeral storage bounds in MB. The first 512 MB is included in the
+// function price; only the configured amount above it is billed.
+const (
+	lambdaFreeEphemeralStorageMB = 512
+	lambdaMaxEphemeralStorageMB  = 10240
+)
+
+// lambdaUsage carries the base Lambda usage figures that add-on pricing builds on.
+type lambdaUsage struct {
+	memoryGB        float64
+	durationSeconds float64
+	requests        int64
+	totalGBSec      float64
+	gbSecPrice      float64
+}
+
+// lambdaAddOnCost is the priced result of the optional Lambda features.
+type lambdaAddOnCost struct {
+	// computeCost is the duration cost after invocations served by provisioned
+	// concurrency are re-priced at the provisioned duration rate.
+	computeCost float64
+
+	provisionedConcurrencyCost float64
+	ephemeralStorageCost       float64
+	snapStartCost              float64
+
+	// components holds one cost component per add-on charge.
+	components CostBreakdown
+
+	// details are billing detail fragments, one per add-on in use.
+	details []string
+
+	// restoresDefaulted is true when SnapStart is enabled without a restore count.
+	restoresDefaulted bool
+}
+
+// totalAddOnCost returns the sum of add-on charges.
+func (c *lambdaAddOnCost) totalAddOnCost() float64 {
+	return c.provisionedConcurrencyCost + c.ephemeralStorageCost + c.snapStartCost
+}
+
+// lambdaPriceArch returns the architecture whose Lambda rates apply: "arm64" for
+// arm and arm64, "x86_64" for anything else, mirroring the pricing lookups.
+func lambdaPriceArch(architecture string) string {
+	switch strings.ToLower(architecture) {
+	case "arm64", archARM:
+		return "arm64"
+	default:
+		return archX86
+	}
+}
+
+// estimateLambdaAddOns prices optional Lambda features configured through tags.
+// When none are in use the result carries only the unchanged duration cost.
+//
+// Optional tags:
+//   - "provisioned_concurrency": Concurrent executions kept warm 730 hours/month.
+//     Invocations up to that capacity are billed at the provisioned duration rate.
+//   - "ephemeral_storage_mb": Configured /tmp size, 512-10240 (only the excess over 512 MB is billed)
+//   - "snapstart": "true" to price the SnapStart cache, sized as the function memory
+//   - "snapstart_restores_per_month": Snapshot restores per month (default: 0)
+//   - "runtime": Java runtimes (e.g., "java21") carry no SnapStart charge
+func (p *AWSPublicPlugin) estimateLambdaAddOns(
+	traceID string,
+	tags map[string]string,
+	architecture string,
+	usage lambdaUsage,
+) (*lambdaAddOnCost, error) {
+	concurrency, _ := parseNonNegativeTag(tags, "provisioned_concurrency")
+
+	ephemeralMB := lambdaFreeEphemeralStorageMB
+	if s, ok := tags["ephemeral_storage_mb"]; ok {
+		mb, err := strconv.Atoi(s)
+		if err != nil || mb < lambdaFreeEphemeralStorageMB || mb > lambdaMaxEphemeralStorageMB {
+			return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("invalid ephemeral_storage_mb %q: must be an integer between %d and %d",
+					s, lambdaFreeEphemeralStorageMB, lambdaMaxEphemeralStorageMB),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		ephemeralMB = mb
+	}
+
+	snapStart, _ := strconv.ParseBool(tags["snapstart"])
+	if strings.HasPrefix(strings.ToLower(tags["runtime"]), "java") {
+		snapStart = false
+	}
+
+	result := &lambdaAddOnCost{computeCost: usage.totalGBSec * usage.gbSecPrice}
+	if concurrency == 0 && ephemeralMB == lambdaFreeEphemeralStorageMB && !snapStart {
+		return result, nil
+	}
+
+	rates, found := p.pricing.LambdaAddOnPrices(architecture)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "Lambda",
+			SKU:           architecture,
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Lambda add-on", p.region),
+		}
+	}
+	missing := func(feature string) error {
+		return &PricingUnavailableError{
+			Service:       "Lambda",
+			SKU:           architecture,
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Lambda "+feature, p.region),
+		}
+	}
+
+	secondsPerMonth := carbon.HoursPerMonth * 3600.0
+	priceArch := lambdaPriceArch(architecture)
+
+	if concurrency > 0 {
+		if rates.ProvisionedConcurrencyRate == 0 || rates.ProvisionedDurationRate == 0 {
+			return nil, missing("provisioned concurrency")
+		}
+		allocatedGBSec := concurrency * usage.memoryGB * secondsPerMonth
+		result.provisionedConcurrencyCost = result.components.Add("provisioned_concurrency",
+			allocatedGBSec, "GB-Seconds", rates.ProvisionedConcurrencyRate, "provisioned-concurrency/"+priceArch)
+
+		coveredGBSec := math.Min(usage.totalGBSec, allocatedGBSec)
+		result.computeCost = coveredGBSec*rates.ProvisionedDurationRate +
+			(usage.totalGBSec-coveredGBSec)*usage.gbSecPrice
+		result.details = append(result.details,
+			fmt.Sprintf("provisioned concurrency %.0f ($%.2f)", concurrency, result.provisionedConcurrencyCost))
+	}
+
+	if ephemeralMB > lambdaFreeEphemeralStorageMB {
+		if rates.EphemeralStorageRate == 0 {
+			return nil, missing("ephemeral storage")
+		}
+		extraGB := float64(ephemeralMB-lambdaFreeEphemeralStorageMB) / 1024.0
+		result.ephemeralStorageCost = result.components.Add("ephemeral_storage",
+			extraGB*usage.durationSeconds*float64(usage.requests), "GB-Seconds",
+			rates.EphemeralStorageRate, "storage-duration/"+priceArch)
+		result.details = append(result.details,
+			fmt.Sprintf("%dMB ephemeral storage ($%.2f)", ephemeralMB, result.ephemeralStorageCost))
+	}
+
+	if snapStart {
+		if rates.SnapStartCacheRate == 0 || rates.SnapStartRestoreRate == 0 {
+			return nil, missing("SnapStart")
+		}
+		restores, restoresFound := parseNonNegativeTag(tags, "snapstart_restores_per_month")
+		result.restoresDefaulted = !restoresFound
+		result.snapStartCost = result.components.Add("snapstart_cache",
+			usage.memoryGB*secondsPerMonth, "GB-Seconds", rates.SnapStartCacheRate, "snapstart-cache/"+priceArch) +
+			result.components.Add("snapstart_restores",
+				restores*usage.memoryGB, "GB", rates.SnapStartRestoreRate, "snapstart-restore/"+priceArch)
+		result.details = append(result.details, fmt.Sprintf("SnapStart ($%.2f)", result.snapStartCost))
+	}
+
+	return result, nil
+}
+
 // estimateNATGateway calculates projected monthly cost for VPC NAT Gateways.
 // Combines fixed hourly cost and variable data processing cost.
 func (p *AWSPublicPlugin) estimateNATGateway(
@@ -1609,13 +2064,20 @@ func (p *AWSPublicPlugin) estimateNATGateway(
 		}
 	}
 
+	schedule, err := p.usageSchedule(traceID, "aws:ec2:nat-gateway", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
 	// 3. Calculate Costs
-	hourlyCost := pricing.HourlyRate * carbon.HoursPerMonth
-	processingCost := dataProcessedGB * pricing.DataProcessingRate
+	var components CostBreakdown
+	hourlyCost := components.Add("hourly", schedule.hours, "Hours", pricing.HourlyRate, "nat-gateway-hours")
+	processingCost := components.Add("data_processed", dataProcessedGB, "GB", pricing.DataProcessingRate,
+		"nat-gateway-bytes")
 	totalCost := hourlyCost + processingCost
 
 	// 4. Build Billing Detail
-	detail := fmt.Sprintf("NAT Gateway, %d hrs/month ($%.3f/hr)", int(carbon.HoursPerMonth), pricing.HourlyRate)
+	detail := fmt.Sprintf("NAT Gateway, %s ($%.3f/hr)", schedule.billingDetail(), pricing.HourlyRate)
 	switch {
 	case tagPresent && dataProcessedGB > 0:
 		detail += fmt.Sprintf(" + %.2f GB data processed ($%.3f/GB)", dataProcessedGB, pricing.DataProcessingRate)
@@ -1645,6 +2107,8 @@ func (p *AWSPublicPlugin) estimateNATGateway(
 		BillingDetail: detail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Apply growth hint enrichment
 	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:ec2:nat-gateway", resp)
@@ -1786,6 +2250,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch( //nolint:gocognit,gocyclo,cyclop,f
 	// Calculate costs based on SKU
 	var totalCost float64
 	var details []string
+	var components CostBreakdown
 
 	// Logs cost calculation
 	if sku == skuLogs || sku == skuCombined {
@@ -1796,7 +2261,8 @@ func (p *AWSPublicPlugin) estimateCloudWatch( //nolint:gocognit,gocyclo,cyclop,f
 		if logIngestionGB > 0 {
 			tiers, found := p.pricing.CloudWatchLogsIngestionTiers()
 			if found {
-				ingestionCost = calculateTieredCost(logIngestionGB, tiers)
+				ingestionCost = components.AddSubtotal("log_ingestion", logIngestionGB, "GB",
+					calculateTieredCost(logIngestionGB, tiers), "logs-ingestion")
 				details = append(details, fmt.Sprintf("%.2f GB logs ingested ($%.2f)", logIngestionGB, ingestionCost))
 			} else {
 				details = append(
@@ -1810,7 +2276,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch( //nolint:gocognit,gocyclo,cyclop,f
 		if logStorageGB > 0 {
 			storageRate, found := p.pricing.CloudWatchLogsStoragePrice()
 			if found {
-				storageCost = logStorageGB * storageRate
+				storageCost = components.Add("log_storage", logStorageGB, "GB-Mo", storageRate, "logs-storage")
 				details = append(
 					details,
 					fmt.Sprintf("%.2f GB logs stored @ $%.4f/GB-mo ($%.2f)", logStorageGB, storageRate, storageCost),
@@ -1830,7 +2296,8 @@ func (p *AWSPublicPlugin) estimateCloudWatch( //nolint:gocognit,gocyclo,cyclop,f
 		if customMetrics > 0 {
 			tiers, found := p.pricing.CloudWatchMetricsTiers()
 			if found {
-				metricsCost = calculateTieredCost(customMetrics, tiers)
+				metricsCost = components.AddSubtotal("custom_metrics", customMetrics, "Metrics",
+					calculateTieredCost(customMetrics, tiers), "custom-metrics")
 				details = append(details, fmt.Sprintf("%.0f custom metrics ($%.2f)", customMetrics, metricsCost))
 			} else {
 				details = append(details, fmt.Sprintf(PricingUnavailableTemplate, "CloudWatch Metrics", p.region))
@@ -1878,6 +2345,7 @@ func (p *AWSPublicPlugin) estimateCloudWatch( //nolint:gocognit,gocyclo,cyclop,f
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
 
 	// Apply growth hint enrichment
 	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:cloudwatch:metric", resp)
@@ -1964,15 +2432,23 @@ func (p *AWSPublicPlugin) estimateElastiCache( //nolint:gocognit,funlen
 		}
 	}
 
+	schedule, err := p.usageSchedule(traceID, "aws:elasticache:cluster", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
 	// Calculate monthly cost: hourly_rate × num_nodes × hours_per_month
-	monthlyCost := hourlyRate * float64(numNodes) * carbon.HoursPerMonth
+	var components CostBreakdown
+	monthlyCost := components.Add("nodes", float64(numNodes)*schedule.hours, "Hours", hourlyRate,
+		nodeType+"/"+engine)
 
 	// Build billing detail
 	var billingDetail string
 	if numNodes == 1 {
-		billingDetail = fmt.Sprintf("ElastiCache %s (%s), 1 node, 730 hrs/month", nodeType, engine)
+		billingDetail = fmt.Sprintf("ElastiCache %s (%s), 1 node, %s", nodeType, engine, schedule.billingDetail())
 	} else {
-		billingDetail = fmt.Sprintf("ElastiCache %s (%s), %d nodes, 730 hrs/month", nodeType, engine, numNodes)
+		billingDetail = fmt.Sprintf("ElastiCache %s (%s), %d nodes, %s", nodeType, engine, numNodes,
+			schedule.billingDetail())
 	}
 
 	p.logger.Debug().
@@ -1999,6 +2475,8 @@ func (p *AWSPublicPlugin) estimateElastiCache( //nolint:gocognit,funlen
 		BillingDetail: billingDetail,
 		Metadata:      dt.Metadata(),
 	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
 
 	// Carbon estimation for ElastiCache cluster
 	elasticacheEstimator := carbon.NewElastiCacheEstimator()
@@ -2008,7 +2486,7 @@ func (p *AWSPublicPlugin) estimateElastiCache( //nolint:gocognit,funlen
 		Nodes:       numNodes,
 		Region:      resource.GetRegion(),
 		Utilization: carbon.DefaultUtilization, // Use CCF default (50%)
-		Hours:       carbon.HoursPerMonth,
+		Hours:       schedule.hours,
 	})
 
 	if carbonOK {
@@ -2034,6 +2512,2252 @@ func (p *AWSPublicPlugin) estimateElastiCache( //nolint:gocognit,funlen
 	return resp, nil
 }
 
+// apiCallsPer10k is the request block size AWS uses when quoting API call pricing
+// for Secrets Manager and KMS (e.g., "$0.05 per 10,000 API calls").
+const apiCallsPer10k = 10000
+
+// estimateSecretsManager calculates projected monthly cost for Secrets Manager secrets.
+// Combines the fixed per-secret monthly fee with API call charges from the
+// optional 'api_calls_per_month' tag.
+func (p *AWSPublicPlugin) estimateSecretsManager(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	// 1. Lookup Pricing
+	secretRate, secretFound := p.pricing.SecretsManagerPricePerSecretMonth()
+	apiRate, apiFound := p.pricing.SecretsManagerPricePerAPIRequest()
+	if !secretFound || !apiFound {
+		return nil, &PricingUnavailableError{
+			Service:       "SecretsManager",
+			SKU:           p.region,
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Secrets Manager", p.region),
+		}
+	}
+
+	// 2. Extract API call volume
+	apiCalls, tagFound := parseNonNegativeTag(resource.GetTags(), "api_calls_per_month")
+
+	// 3. Calculate Costs (secret storage is billed per month, not per hour)
+	var components CostBreakdown
+	storageCost := components.Add("secret", 1, "Secrets", secretRate, "secret")
+	apiCost := components.Add("api_calls", apiCalls, "Requests", apiRate, "api-request")
+	totalCost := storageCost + apiCost
+
+	// 4. Build Billing Detail
+	detail := fmt.Sprintf("Secrets Manager secret ($%.2f/month), %.0f API calls ($%.2f/10k)",
+		secretRate, apiCalls, apiRate*apiCallsPer10k)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("secret_rate", secretRate).
+		Float64("api_rate", apiRate).
+		Float64("api_calls", apiCalls).
+		Float64("total_cost", totalCost).
+		Msg("Secrets Manager cost estimated")
+
+	// Track defaults for metadata enrichment
+	var dt DefaultsTracker
+	if !tagFound {
+		dt.Add("api_calls_per_month", "0", KindUsageZero)
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  totalCost,
+		UnitPrice:     secretRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:secretsmanager:secret", resp)
+
+	return resp, nil
+}
+
+// estimateKMS calculates projected monthly cost for KMS customer managed keys.
+// Combines the fixed per-key monthly fee with request charges from the optional
+// 'api_calls_per_month' tag. The account-level free tier is not applied because
+// it is shared across all keys in the account.
+func (p *AWSPublicPlugin) estimateKMS(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	// 1. Lookup Pricing
+	keyRate, keyFound := p.pricing.KMSPricePerKeyMonth()
+	requestRate, requestFound := p.pricing.KMSPricePerRequest()
+	if !keyFound || !requestFound {
+		return nil, &PricingUnavailableError{
+			Service:       "KMS",
+			SKU:           p.region,
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "KMS", p.region),
+		}
+	}
+
+	// 2. Extract API call volume
+	apiCalls, tagFound := parseNonNegativeTag(resource.GetTags(), "api_calls_per_month")
+
+	// 3. Calculate Costs (keys are billed per month, not per hour)
+	var components CostBreakdown
+	keyCost := components.Add("key", 1, "Keys", keyRate, "customer-managed-key")
+	requestCost := components.Add("requests", apiCalls, "Requests", requestRate, "request")
+	totalCost := keyCost + requestCost
+
+	// 4. Build Billing Detail
+	detail := fmt.Sprintf("KMS customer managed key ($%.2f/month), %.0f requests ($%.2f/10k)",
+		keyRate, apiCalls, requestRate*apiCallsPer10k)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("key_rate", keyRate).
+		Float64("request_rate", requestRate).
+		Float64("api_calls", apiCalls).
+		Float64("total_cost", totalCost).
+		Msg("KMS cost estimated")
+
+	// Track defaults for metadata enrichment
+	var dt DefaultsTracker
+	if !tagFound {
+		dt.Add("api_calls_per_month", "0", KindUsageZero)
+	}
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  totalCost,
+		UnitPrice:     keyRate,
+		Currency:      "USD",
+		BillingDetail: detail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:kms:key", resp)
+
+	return resp, nil
+}
+
+// Default values for OpenSearch domain attributes.
+const (
+	defaultOpenSearchVolumeType  = "gp3"
+	defaultOpenSearchVolumeSize  = 10 // GB per data node (AWS console default)
+	defaultOpenSearchMasterCount = 3
+	defaultOpenSearchWarmCount   = 2 // UltraWarm requires at least two nodes
+	defaultOpenSearchOCUs        = 1 // Per OCU type (indexing and search)
+)
+
+// parseNodeCountTag reads an integer node count tag, validating it the same
+// way as ElastiCache node counts (1-1000).
+// Returns the parsed count and whether the tag was present.
+func (p *AWSPublicPlugin) parseNodeCountTag(
+	traceID string,
+	tags map[string]string,
+	key string,
+) (int, bool, error) {
+	val, ok := tags[key]
+	if !ok || val == "" {
+		return 0, false, nil
+	}
+	parsed, err := strconv.Atoi(val)
+	if err != nil {
+		return 0, true, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for %s: %q is not a valid integer", key, val),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	if parsed < 1 || parsed > 1000 {
+		return 0, true, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for %s: %d must be between 1 and 1000", key, parsed),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	return parsed, true, nil
+}
+
+// isOpenSearchServerless reports whether the resource is an OpenSearch Serverless
+// collection rather than a provisioned domain.
+func isOpenSearchServerless(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "serverless") ||
+		strings.EqualFold(resource.GetSku(), "serverless")
+}
+
+// estimateOpenSearch calculates projected monthly cost for OpenSearch Service domains.
+// Like ElastiCache, each node tier is priced as hourly_rate × node_count × 730 hours;
+// EBS storage is added per data node.
+//
+// Required fields:
+//   - resource SKU: The data node type (e.g., "r6g.large.search")
+//
+// Optional tags:
+//   - "instance_count": Number of data nodes (default: 1)
+//   - "dedicated_master_type" / "dedicated_master_count": Dedicated master nodes (count default: 3)
+//   - "warm_type" / "warm_count": UltraWarm nodes (count default: 2)
+//   - "volume_type" / "volume_size": EBS storage per data node (default: gp3, 10 GB)
+//
+// Serverless collections (resource type containing "serverless" or SKU "serverless")
+// are priced from the "indexing_ocu" and "search_ocu" tags instead.
+func (p *AWSPublicPlugin) estimateOpenSearch( //nolint:gocognit,funlen
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	if isOpenSearchServerless(resource) {
+		return p.estimateOpenSearchServerless(traceID, resource)
+	}
+
+	tags := resource.GetTags()
+
+	// Extract data node type from SKU, then instanceType tags
+	instanceType := resource.GetSku()
+	if instanceType == "" {
+		instanceType = tags["instance_type"]
+	}
+	if instanceType == "" {
+		instanceType = tags["instanceType"]
+	}
+	if instanceType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"OpenSearch instance type not specified: use 'sku' field or 'instance_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	var dt DefaultsTracker
+	var components CostBreakdown
+
+	// Data nodes
+	dataNodes, found, err := p.parseNodeCountTag(traceID, tags, "instance_count")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		dataNodes = 1
+		dt.Add("instance_count", "1", KindConfig)
+	}
+
+	dataRate, rateFound := p.pricing.OpenSearchInstancePricePerHour(instanceType)
+	if !rateFound {
+		return nil, &PricingUnavailableError{
+			Service:       "OpenSearch",
+			SKU:           instanceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "OpenSearch instance", instanceType),
+		}
+	}
+	dataCost := components.Add("data_nodes", float64(dataNodes)*carbon.HoursPerMonth, "Hours", dataRate, instanceType)
+	parts := []string{fmt.Sprintf("%d data nodes", dataNodes)}
+	if dataNodes == 1 {
+		parts[0] = "1 data node"
+	}
+
+	// Dedicated master nodes (optional)
+	masterType := tags["dedicated_master_type"]
+	var masterNodes int
+	var masterCost float64
+	if masterType != "" {
+		masterNodes, found, err = p.parseNodeCountTag(traceID, tags, "dedicated_master_count")
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			masterNodes = defaultOpenSearchMasterCount
+			dt.Add("dedicated_master_count", strconv.Itoa(defaultOpenSearchMasterCount), KindConfig)
+		}
+		masterRate, masterFound := p.pricing.OpenSearchInstancePricePerHour(masterType)
+		if !masterFound {
+			return nil, &PricingUnavailableError{
+				Service:       "OpenSearch",
+				SKU:           masterType,
+				BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "OpenSearch dedicated master", masterType),
+			}
+		}
+		masterCost = components.Add("master_nodes", float64(masterNodes)*carbon.HoursPerMonth, "Hours", masterRate,
+			masterType)
+		parts = append(parts, fmt.Sprintf("%d %s masters", masterNodes, masterType))
+	}
+
+	// UltraWarm nodes (optional)
+	warmType := tags["warm_type"]
+	var warmCost float64
+	if warmType != "" {
+		warmNodes, warmCountFound, warmErr := p.parseNodeCountTag(traceID, tags, "warm_count")
+		if warmErr != nil {
+			return nil, warmErr
+		}
+		if !warmCountFound {
+			warmNodes = defaultOpenSearchWarmCount
+			dt.Add("warm_count", strconv.Itoa(defaultOpenSearchWarmCount), KindConfig)
+		}
+		warmRate, warmFound := p.pricing.OpenSearchInstancePricePerHour(warmType)
+		if !warmFound {
+			return nil, &PricingUnavailableError{
+				Service:       "OpenSearch",
+				SKU:           warmType,
+				BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "OpenSearch UltraWarm", warmType),
+			}
+		}
+		warmCost = components.Add("warm_nodes", float64(warmNodes)*carbon.HoursPerMonth, "Hours", warmRate, warmType)
+		parts = append(parts, fmt.Sprintf("%d %s warm nodes", warmNodes, warmType))
+	}
+
+	// EBS storage per data node
+	volumeType := strings.ToLower(tags["volume_type"])
+	if volumeType == "" {
+		volumeType = defaultOpenSearchVolumeType
+		dt.Add("volume_type", defaultOpenSearchVolumeType, KindConfig)
+	}
+	volumeSize, sizeFound := parseNonNegativeTag(tags, "volume_size")
+	if !sizeFound {
+		volumeSize = defaultOpenSearchVolumeSize
+		dt.Add("volume_size", strconv.Itoa(defaultOpenSearchVolumeSize), KindConfig)
+	}
+	var storageCost float64
+	if volumeSize > 0 {
+		storageRate, storageFound := p.pricing.OpenSearchStoragePricePerGBMonth(volumeType)
+		if !storageFound {
+			return nil, &PricingUnavailableError{
+				Service:       "OpenSearch",
+				SKU:           volumeType,
+				BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "OpenSearch storage", volumeType),
+			}
+		}
+		storageCost = components.Add("storage", volumeSize*float64(dataNodes), "GB-Mo", storageRate, volumeType)
+		parts = append(parts, fmt.Sprintf("%.0fGB %s per node", volumeSize, volumeType))
+	}
+
+	monthlyCost := dataCost + masterCost + warmCost + storageCost
+	billingDetail := fmt.Sprintf("OpenSearch %s, %s, 730 hrs/month", instanceType, strings.Join(parts, ", "))
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("instance_type", instanceType).
+		Int("data_nodes", dataNodes).
+		Float64("data_cost", dataCost).
+		Float64("master_cost", masterCost).
+		Float64("warm_cost", warmCost).
+		Float64("storage_cost", storageCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("OpenSearch cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     dataRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Carbon estimation uses the same EC2-equivalent node mapping as ElastiCache
+	openSearchEstimator := carbon.NewOpenSearchEstimator()
+	carbonGrams, carbonOK := openSearchEstimator.EstimateCarbonGrams(carbon.OpenSearchConfig{
+		DataNodeType:   instanceType,
+		DataNodes:      dataNodes,
+		MasterNodeType: masterType,
+		MasterNodes:    masterNodes,
+		Region:         resource.GetRegion(),
+		Utilization:    carbon.DefaultUtilization, // Use CCF default (50%)
+		Hours:          carbon.HoursPerMonth,
+	})
+
+	if carbonOK {
+		resp.ImpactMetrics = []*pbc.ImpactMetric{
+			{
+				Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
+				Value: carbonGrams,
+				Unit:  "gCO2e",
+			},
+		}
+
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("instance_type", instanceType).
+			Int("data_nodes", dataNodes).
+			Str("aws_region", resource.GetRegion()).
+			Float64("carbon_grams", carbonGrams).
+			Msg("OpenSearch carbon estimation successful")
+	}
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:opensearch:domain", resp)
+
+	return resp, nil
+}
+
+// estimateOpenSearchServerless calculates projected monthly cost for an OpenSearch
+// Serverless collection from its indexing and search OCU counts.
+// OCU counts may be fractional (0.5 OCU is the minimum for non-redundant collections).
+func (p *AWSPublicPlugin) estimateOpenSearchServerless(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	ocuRate, found := p.pricing.OpenSearchServerlessOCUPricePerHour()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "OpenSearch",
+			SKU:           "serverless",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "OpenSearch Serverless", p.region),
+		}
+	}
+
+	var dt DefaultsTracker
+	indexingOCUs, indexingFound := parseNonNegativeTag(resource.GetTags(), "indexing_ocu")
+	if !indexingFound {
+		indexingOCUs = defaultOpenSearchOCUs
+		dt.Add("indexing_ocu", strconv.Itoa(defaultOpenSearchOCUs), KindConfig)
+	}
+	searchOCUs, searchFound := parseNonNegativeTag(resource.GetTags(), "search_ocu")
+	if !searchFound {
+		searchOCUs = defaultOpenSearchOCUs
+		dt.Add("search_ocu", strconv.Itoa(defaultOpenSearchOCUs), KindConfig)
+	}
+
+	var components CostBreakdown
+	monthlyCost := components.Add("indexing_ocus", indexingOCUs*carbon.HoursPerMonth, "OCU-Hours", ocuRate, "ocu") +
+		components.Add("search_ocus", searchOCUs*carbon.HoursPerMonth, "OCU-Hours", ocuRate, "ocu")
+	billingDetail := fmt.Sprintf(
+		"OpenSearch Serverless, %g indexing OCUs + %g search OCUs ($%.3f/OCU-hour), 730 hrs/month",
+		indexingOCUs, searchOCUs, ocuRate)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("indexing_ocu", indexingOCUs).
+		Float64("search_ocu", searchOCUs).
+		Float64("ocu_rate", ocuRate).
+		Float64("monthly_cost", monthlyCost).
+		Msg("OpenSearch Serverless cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     ocuRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:opensearch:domain", resp)
+
+	return resp, nil
+}
+
+// Default values for Redshift attributes.
+const (
+	defaultRedshiftBaseRPU = 128 // AWS default base capacity for new workgroups
+	hoursPerDay            = 24
+)
+
+// isRedshiftServerless reports whether the resource is a Redshift Serverless
+// workgroup rather than a provisioned cluster.
+func isRedshiftServerless(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "redshiftserverless") ||
+		strings.EqualFold(resource.GetSku(), "serverless")
+}
+
+// estimateRedshift calculates projected monthly cost for provisioned Redshift clusters.
+// Cost is node_rate × number_of_nodes × 730 hours; RA3 clusters add Redshift Managed
+// Storage charged per GB-month.
+//
+// Required fields:
+//   - resource SKU: The node type (e.g., "ra3.xlplus", "dc2.large")
+//
+// Optional tags:
+//   - "number_of_nodes": Number of compute nodes (default: 1)
+//   - "managed_storage_gb": RA3 managed storage in GB (default: 0)
+//
+// Serverless workgroups (resource type containing "redshiftserverless" or SKU
+// "serverless") are priced by estimateRedshiftServerless.
+func (p *AWSPublicPlugin) estimateRedshift(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	if isRedshiftServerless(resource) {
+		return p.estimateRedshiftServerless(traceID, resource)
+	}
+
+	tags := resource.GetTags()
+
+	nodeType := strings.ToLower(resource.GetSku())
+	if nodeType == "" {
+		nodeType = strings.ToLower(tags["node_type"])
+	}
+	if nodeType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"Redshift node type not specified: use 'sku' field or 'node_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	var dt DefaultsTracker
+
+	numNodes, found, err := p.parseNodeCountTag(traceID, tags, "number_of_nodes")
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		numNodes = 1
+		dt.Add("number_of_nodes", "1", KindConfig)
+	}
+
+	hourlyRate, rateFound := p.pricing.RedshiftNodePricePerHour(nodeType)
+	if !rateFound {
+		return nil, &PricingUnavailableError{
+			Service:       "Redshift",
+			SKU:           nodeType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "Redshift node", nodeType),
+		}
+	}
+
+	// Paused clusters accrue no node hours; managed storage is billed in full.
+	schedule, err := p.usageSchedule(traceID, "aws:redshift:cluster", tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var components CostBreakdown
+	nodeCost := components.Add("nodes", float64(numNodes)*schedule.hours, "Hours", hourlyRate, nodeType)
+
+	nodeLabel := "nodes"
+	if numNodes == 1 {
+		nodeLabel = "node"
+	}
+	billingDetail := fmt.Sprintf("Redshift %s, %d %s, %s", nodeType, numNodes, nodeLabel, schedule.billingDetail())
+
+	// RA3 nodes separate compute from storage; DC2 storage is included in the node rate.
+	var storageCost float64
+	if strings.HasPrefix(nodeType, "ra3.") {
+		storageGB, storageFound := parseNonNegativeTag(tags, "managed_storage_gb")
+		if !storageFound {
+			dt.Add("managed_storage_gb", "0", KindUsageZero)
+		}
+		if storageGB > 0 {
+			storageRate, rmsFound := p.pricing.RedshiftManagedStoragePricePerGBMonth()
+			if !rmsFound {
+				return nil, &PricingUnavailableError{
+					Service:       "Redshift",
+					SKU:           "managed-storage",
+					BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Redshift Managed Storage", p.region),
+				}
+			}
+			storageCost = components.Add("managed_storage", storageGB, "GB-Mo", storageRate, "managed-storage")
+			billingDetail += fmt.Sprintf(", %.0fGB managed storage", storageGB)
+		}
+	}
+
+	monthlyCost := nodeCost + storageCost
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("node_type", nodeType).
+		Int("num_nodes", numNodes).
+		Float64("hourly_rate", hourlyRate).
+		Float64("storage_cost", storageCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("Redshift cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     hourlyRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:redshift:cluster", resp)
+
+	return resp, nil
+}
+
+// estimateRedshiftServerless calculates projected monthly cost for a Redshift
+// Serverless workgroup. Compute is billed only while queries run, so the
+// "hours_per_day" usage hint scales the base RPU capacity:
+//
+//	base_capacity × hours_per_day × (730 / 24) × rpu_rate + managed_storage_gb × storage_rate
+func (p *AWSPublicPlugin) estimateRedshiftServerless(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	rpuRate, found := p.pricing.RedshiftServerlessPricePerRPUHour()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "Redshift",
+			SKU:           "serverless",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Redshift Serverless", p.region),
+		}
+	}
+
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	baseRPU, rpuFound := parseNonNegativeTag(tags, "base_capacity")
+	if !rpuFound || baseRPU == 0 {
+		baseRPU = defaultRedshiftBaseRPU
+		dt.Add("base_capacity", strconv.Itoa(defaultRedshiftBaseRPU), KindConfig)
+	}
+
+	activeHours, hoursFound := parseNonNegativeTag(tags, "hours_per_day")
+	if !hoursFound {
+		dt.Add("hours_per_day", "0", KindUsageZero)
+	}
+	if activeHours > hoursPerDay {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for hours_per_day: %g must be between 0 and 24", activeHours),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	var components CostBreakdown
+	computeCost := components.Add("compute", baseRPU*activeHours*(carbon.HoursPerMonth/hoursPerDay), "RPU-Hours",
+		rpuRate, "rpu")
+	billingDetail := fmt.Sprintf("Redshift Serverless, %g RPUs × %g hrs/day ($%.3f/RPU-hour)",
+		baseRPU, activeHours, rpuRate)
+
+	var storageCost float64
+	storageGB, storageFound := parseNonNegativeTag(tags, "managed_storage_gb")
+	if !storageFound {
+		dt.Add("managed_storage_gb", "0", KindUsageZero)
+	}
+	if storageGB > 0 {
+		storageRate, rmsFound := p.pricing.RedshiftManagedStoragePricePerGBMonth()
+		if !rmsFound {
+			return nil, &PricingUnavailableError{
+				Service:       "Redshift",
+				SKU:           "managed-storage",
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Redshift Managed Storage", p.region),
+			}
+		}
+		storageCost = components.Add("managed_storage", storageGB, "GB-Mo", storageRate, "managed-storage")
+		billingDetail += fmt.Sprintf(", %.0fGB managed storage", storageGB)
+	}
+
+	monthlyCost := computeCost + storageCost
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("base_rpu", baseRPU).
+		Float64("hours_per_day", activeHours).
+		Float64("rpu_rate", rpuRate).
+		Float64("storage_cost", storageCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("Redshift Serverless cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     rpuRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:redshift:cluster", resp)
+
+	return resp, nil
+}
+
+// estimateECR calculates projected monthly cost for an ECR repository.
+// Repositories accumulate image layers over time, so the estimate prices the
+// current storage and surfaces the expected growth as a hint for Core's
+// forecasting rather than baking it into the monthly figure.
+//
+// Optional tags:
+//   - "storage_gb": Current image storage in GB (default: 0)
+//   - "monthly_growth_gb": Expected storage growth per month in GB
+//
+// Data transfer out of the region is not included.
+func (p *AWSPublicPlugin) estimateECR(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	storageRate, found := p.pricing.ECRStoragePricePerGBMonth()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "ECR",
+			SKU:           "storage",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "ECR", p.region),
+		}
+	}
+
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	storageGB, storageFound := parseNonNegativeTag(tags, "storage_gb")
+	if !storageFound {
+		dt.Add("storage_gb", "0", KindUsageZero)
+	}
+
+	var components CostBreakdown
+	monthlyCost := components.Add("storage", storageGB, "GB-Mo", storageRate, "storage")
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("storage_gb", storageGB).
+		Float64("storage_rate", storageRate).
+		Float64("monthly_cost", monthlyCost).
+		Msg("ECR cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     storageRate,
+		Currency:      "USD",
+		BillingDetail: fmt.Sprintf("ECR repository, %.0fGB image storage, $%.4f/GB-month", storageGB, storageRate),
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	setMonthlyGrowthHint(tags, resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:ecr:repository", resp)
+
+	return resp, nil
+}
+
+// backupResourceTypes maps lowercase protected resource types to the display
+// names used in AWS Backup pricing and billing details.
+var backupResourceTypes = map[string]string{
+	"ebs":      "EBS",
+	"efs":      "EFS",
+	"rds":      "RDS",
+	"dynamodb": "DynamoDB",
+	"s3":       "S3",
+}
+
+// defaultBackupResourceType is the protected resource type assumed for AWS Backup
+// vaults when neither the "resource_type" tag nor the SKU names one.
+const defaultBackupResourceType = "EBS"
+
+// backupProtectedResourceType resolves the protected resource type of an AWS Backup
+// vault from the "resource_type" tag, then the SKU. Returns false when neither names
+// a supported type.
+func backupProtectedResourceType(resource *pbc.ResourceDescriptor) (string, bool) {
+	if resourceType, ok := backupResourceTypes[strings.ToLower(resource.GetTags()["resource_type"])]; ok {
+		return resourceType, true
+	}
+	resourceType, ok := backupResourceTypes[strings.ToLower(resource.GetSku())]
+	return resourceType, ok
+}
+
+// estimateBackup calculates projected monthly cost for an AWS Backup vault.
+// Recovery points are billed per GB-month at a rate that depends on the protected
+// resource type and on whether they sit in the warm or cold tier:
+//
+//	warm_storage_gb × warm_rate + cold_storage_gb × cold_rate
+//
+// The protected resource type comes from the "resource_type" tag, then the SKU
+// when it names a supported type, and defaults to EBS.
+//
+// Optional tags:
+//   - "resource_type": EBS, EFS, RDS, DynamoDB or S3 (default: EBS)
+//   - "warm_storage_gb": Recovery point storage in the warm tier (default: 0)
+//   - "cold_storage_gb": Recovery point storage in the cold tier (default: 0)
+//   - "monthly_growth_gb": Expected warm storage growth per month in GB
+func (p *AWSPublicPlugin) estimateBackup(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	resourceType, known := backupProtectedResourceType(resource)
+	if !known {
+		if tagValue := tags["resource_type"]; tagValue != "" {
+			return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+				fmt.Sprintf("unsupported AWS Backup resource_type %q: use EBS, EFS, RDS, DynamoDB or S3", tagValue),
+				pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+		}
+		resourceType = defaultBackupResourceType
+		dt.Add("resource_type", resourceType, KindConfig)
+	}
+
+	warmRate, found := p.pricing.BackupStoragePricePerGBMonth(resourceType, "warm")
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "Backup",
+			SKU:           resourceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "AWS Backup warm storage resource type", resourceType),
+		}
+	}
+
+	warmGB, warmFound := parseNonNegativeTag(tags, "warm_storage_gb")
+	if !warmFound {
+		dt.Add("warm_storage_gb", "0", KindUsageZero)
+	}
+	coldGB, _ := parseNonNegativeTag(tags, "cold_storage_gb")
+
+	var components CostBreakdown
+	monthlyCost := components.Add("warm_storage", warmGB, "GB-Mo", warmRate, resourceType+"/warm")
+	billingDetail := fmt.Sprintf("AWS Backup %s, %.0fGB warm storage, $%.4f/GB-month", resourceType, warmGB, warmRate)
+
+	if coldGB > 0 {
+		coldRate, coldFound := p.pricing.BackupStoragePricePerGBMonth(resourceType, "cold")
+		if !coldFound {
+			return nil, &PricingUnavailableError{
+				Service:       "Backup",
+				SKU:           resourceType,
+				BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "AWS Backup cold storage resource type", resourceType),
+			}
+		}
+		monthlyCost += components.Add("cold_storage", coldGB, "GB-Mo", coldRate, resourceType+"/cold")
+		billingDetail += fmt.Sprintf(", %.0fGB cold storage, $%.4f/GB-month", coldGB, coldRate)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("backup_resource_type", resourceType).
+		Float64("warm_storage_gb", warmGB).
+		Float64("cold_storage_gb", coldGB).
+		Float64("monthly_cost", monthlyCost).
+		Msg("AWS Backup cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     warmRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	setMonthlyGrowthHint(tags, resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:backup:vault", resp)
+
+	return resp, nil
+}
+
+// Step Functions workflow types (the state machine "type" property).
+const (
+	sfnTypeStandard = "STANDARD"
+	sfnTypeExpress  = "EXPRESS"
+)
+
+// Step Functions Express workflows bill duration in 100 ms increments and memory
+// in 64 MB increments.
+const (
+	sfnExpressDurationIncrementMs = 100
+	sfnExpressMemoryIncrementMB   = 64
+	defaultSFNExpressDurationMs   = 100
+)
+
+// stepFunctionsWorkflowType resolves the workflow type from the "type" tag, then the
+// SKU. Returns false when neither names STANDARD or EXPRESS.
+func stepFunctionsWorkflowType(resource *pbc.ResourceDescriptor) (string, bool) {
+	for _, v := range []string{resource.GetTags()["type"], resource.GetSku()} {
+		switch strings.ToUpper(v) {
+		case sfnTypeStandard:
+			return sfnTypeStandard, true
+		case sfnTypeExpress:
+			return sfnTypeExpress, true
+		}
+	}
+	return "", false
+}
+
+// roundUpToIncrement rounds a positive value up to the next multiple of increment.
+func roundUpToIncrement(value, increment float64) float64 {
+	return math.Ceil(value/increment) * increment
+}
+
+// estimateStepFunctions calculates projected monthly cost for a Step Functions state machine.
+//
+// Standard workflows are billed per state transition:
+//
+//	state_transitions_per_month × transition_rate
+//
+// Express workflows are billed per request plus tiered GB-second duration, with
+// duration rounded up to 100 ms and memory to 64 MB:
+//
+//	requests_per_month × request_rate + tiered(requests × memory_GB × duration_s)
+//
+// The workflow type comes from the "type" tag or the SKU and defaults to STANDARD.
+//
+// Optional tags:
+//   - "state_transitions_per_month": Standard transitions per month (default: 0)
+//   - "requests_per_month": Express executions per month (default: 0)
+//   - "avg_duration_ms": Express average execution duration (default: 100)
+//   - "memory_mb": Express memory used per execution (default: 64)
+func (p *AWSPublicPlugin) estimateStepFunctions(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	workflowType, known := stepFunctionsWorkflowType(resource)
+	if !known {
+		workflowType = sfnTypeStandard
+		dt.Add("type", sfnTypeStandard, KindConfig)
+	}
+
+	var resp *pbc.GetProjectedCostResponse
+	var components CostBreakdown
+	if workflowType == sfnTypeStandard {
+		transitionRate, found := p.pricing.StepFunctionsPricePerStateTransition()
+		if !found {
+			return nil, &PricingUnavailableError{
+				Service:       "StepFunctions",
+				SKU:           workflowType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Step Functions", p.region),
+			}
+		}
+
+		transitions, transitionsFound := parseNonNegativeTag(tags, "state_transitions_per_month")
+		if !transitionsFound {
+			dt.Add("state_transitions_per_month", "0", KindUsageZero)
+		}
+
+		monthlyCost := components.Add("state_transitions", transitions, "Transitions", transitionRate,
+			"state-transition")
+
+		resp = &pbc.GetProjectedCostResponse{
+			CostPerMonth: monthlyCost,
+			UnitPrice:    transitionRate,
+			Currency:     "USD",
+			BillingDetail: fmt.Sprintf("Step Functions Standard workflow, %.0f state transitions/month",
+				transitions),
+		}
+	} else {
+		requestRate, reqFound := p.pricing.StepFunctionsExpressPricePerRequest()
+		durationTiers, tiersFound := p.pricing.StepFunctionsExpressDurationTiers()
+		if !reqFound || !tiersFound {
+			return nil, &PricingUnavailableError{
+				Service:       "StepFunctions",
+				SKU:           workflowType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Step Functions Express", p.region),
+			}
+		}
+
+		requests, requestsFound := parseNonNegativeTag(tags, "requests_per_month")
+		if !requestsFound {
+			dt.Add("requests_per_month", "0", KindUsageZero)
+		}
+		durationMs, durationFound := parseNonNegativeTag(tags, "avg_duration_ms")
+		if !durationFound || durationMs == 0 {
+			durationMs = defaultSFNExpressDurationMs
+			dt.Add("avg_duration_ms", strconv.Itoa(defaultSFNExpressDurationMs), KindConfig)
+		}
+		memoryMB, memoryFound := parseNonNegativeTag(tags, "memory_mb")
+		if !memoryFound || memoryMB == 0 {
+			memoryMB = sfnExpressMemoryIncrementMB
+			dt.Add("memory_mb", strconv.Itoa(sfnExpressMemoryIncrementMB), KindConfig)
+		}
+
+		billedSeconds := roundUpToIncrement(durationMs, sfnExpressDurationIncrementMs) / 1000.0
+		billedGB := roundUpToIncrement(memoryMB, sfnExpressMemoryIncrementMB) / 1024.0
+		gbSeconds := requests * billedGB * billedSeconds
+
+		monthlyCost := components.Add("requests", requests, "Requests", requestRate, "express-request") +
+			components.AddSubtotal("duration", gbSeconds, "GB-Seconds",
+				calculateTieredCost(gbSeconds, durationTiers), "express-duration")
+
+		resp = &pbc.GetProjectedCostResponse{
+			CostPerMonth: monthlyCost,
+			UnitPrice:    requestRate,
+			Currency:     "USD",
+			BillingDetail: fmt.Sprintf("Step Functions Express workflow, %.0f requests/month, %.0fms avg duration, "+
+				"%.0fMB memory, %.0f GB-seconds", requests, durationMs, memoryMB, gbSeconds),
+		}
+	}
+	resp.Metadata = dt.Metadata()
+	attachCostComponents(resp, &components)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("workflow_type", workflowType).
+		Float64("monthly_cost", resp.GetCostPerMonth()).
+		Msg("Step Functions cost estimated")
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:sfn:statemachine", resp)
+
+	return resp, nil
+}
+
+// eventBridgeChunkKB is the payload size billed as one EventBridge event or Pipes request.
+const eventBridgeChunkKB = 64
+
+// isEventBridgePipe reports whether the resource is an EventBridge Pipe rather
+// than a custom event bus.
+func isEventBridgePipe(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "pipes/") ||
+		strings.EqualFold(resource.GetSku(), "pipe")
+}
+
+// estimateEventBridge calculates projected monthly cost for an EventBridge custom
+// event bus or Pipe. Each 64 KB chunk of a payload is billed as one event or request:
+//
+//	events_per_month × ceil(avg_event_size_kb / 64) × event_rate        (event bus)
+//	requests_per_month × ceil(avg_event_size_kb / 64) × request_rate    (pipe)
+//
+// Optional tags:
+//   - "events_per_month": Custom events published to the bus (default: 0)
+//   - "requests_per_month": Events processed by the pipe (default: 0)
+//   - "avg_event_size_kb": Average payload size (default: up to 64 KB, one chunk)
+func (p *AWSPublicPlugin) estimateEventBridge(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	label, usageTag, usageUnit := "custom event bus", "events_per_month", "events/month"
+	classificationKey, rateKey := "aws:eventbridge:eventbus", "custom-event"
+	rate, found := p.pricing.EventBridgePricePerCustomEvent()
+	if isEventBridgePipe(resource) {
+		label, usageTag, usageUnit = "Pipe", "requests_per_month", "requests/month"
+		classificationKey, rateKey = "aws:eventbridge:pipe", "pipes-request"
+		rate, found = p.pricing.EventBridgePipesPricePerRequest()
+	}
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "EventBridge",
+			SKU:           resource.GetSku(),
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "EventBridge "+label, p.region),
+		}
+	}
+
+	count, countFound := parseNonNegativeTag(tags, usageTag)
+	if !countFound {
+		dt.Add(usageTag, "0", KindUsageZero)
+	}
+
+	chunks := 1.0
+	if sizeKB, ok := parseNonNegativeTag(tags, "avg_event_size_kb"); ok && sizeKB > eventBridgeChunkKB {
+		chunks = math.Ceil(sizeKB / eventBridgeChunkKB)
+	}
+
+	var components CostBreakdown
+	monthlyCost := components.Add(strings.ReplaceAll(usageTag, "_per_month", ""), count*chunks, "Events", rate,
+		rateKey)
+	billingDetail := fmt.Sprintf("EventBridge %s, %.0f %s", label, count, usageUnit)
+	if chunks > 1 {
+		billingDetail += fmt.Sprintf(" × %.0f 64KB chunks", chunks)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("eventbridge_resource", label).
+		Float64("count", count).
+		Float64("chunks", chunks).
+		Float64("monthly_cost", monthlyCost).
+		Msg("EventBridge cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     rate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), classificationKey, resp)
+
+	return resp, nil
+}
+
+// Default values for MemoryDB attributes.
+const (
+	defaultMemoryDBReplicasPerShard = 1 // AWS default for new clusters
+	maxMemoryDBReplicasPerShard     = 5
+)
+
+// clusterDatabaseLabels maps DocumentDB-style cluster database services to display names.
+var clusterDatabaseLabels = map[string]string{
+	serviceDocDB:    "DocumentDB",
+	serviceNeptune:  "Neptune",
+	serviceMemoryDB: "MemoryDB",
+}
+
+// clusterDatabaseStorageRates holds the cluster-level storage, I/O and backup
+// rates for DocumentDB or Neptune. A zero rate means the rate was not found.
+type clusterDatabaseStorageRates struct {
+	storage float64
+	io      float64
+	backup  float64
+}
+
+// clusterDatabaseInstancePrice returns the hourly rate for a DocumentDB, Neptune
+// or MemoryDB instance class.
+func (p *AWSPublicPlugin) clusterDatabaseInstancePrice(service, instanceClass string) (float64, bool) {
+	switch service {
+	case serviceDocDB:
+		return p.pricing.DocumentDBInstancePricePerHour(instanceClass)
+	case serviceNeptune:
+		return p.pricing.NeptuneInstancePricePerHour(instanceClass)
+	case serviceMemoryDB:
+		return p.pricing.MemoryDBNodePricePerHour(instanceClass)
+	default:
+		return 0, false
+	}
+}
+
+// clusterDatabaseStorageRatesFor returns the storage, I/O and backup rates for a
+// DocumentDB or Neptune cluster.
+func (p *AWSPublicPlugin) clusterDatabaseStorageRatesFor(service string) clusterDatabaseStorageRates {
+	var rates clusterDatabaseStorageRates
+	switch service {
+	case serviceDocDB:
+		rates.storage, _ = p.pricing.DocumentDBStoragePricePerGBMonth()
+		rates.io, _ = p.pricing.DocumentDBPricePerIORequest()
+		rates.backup, _ = p.pricing.DocumentDBBackupPricePerGBMonth()
+	case serviceNeptune:
+		rates.storage, _ = p.pricing.NeptuneStoragePricePerGBMonth()
+		rates.io, _ = p.pricing.NeptunePricePerIORequest()
+		rates.backup, _ = p.pricing.NeptuneBackupPricePerGBMonth()
+	}
+	return rates
+}
+
+// clusterDatabaseInstanceClass returns the instance class for a cluster database
+// resource from the SKU, falling back to instance class tags (as estimateRDS does)
+// and then the MemoryDB "node_type" tag.
+func clusterDatabaseInstanceClass(resource *pbc.ResourceDescriptor) string {
+	instanceClass := resource.GetSku()
+	if instanceClass == "" {
+		instanceClass = extractAWSSKU(resource.GetTags())
+	}
+	if instanceClass == "" {
+		instanceClass = resource.GetTags()["node_type"]
+	}
+	return strings.ToLower(instanceClass)
+}
+
+// isClusterInstanceResource reports whether the resource is an individual cluster
+// instance (e.g., aws:docdb/clusterInstance) rather than the cluster itself.
+func isClusterInstanceResource(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "clusterinstance")
+}
+
+// estimateClusterDatabase calculates projected monthly cost for an Amazon DocumentDB
+// or Amazon Neptune cluster. Like estimateRDS it prices instance-hours by class, and
+// adds the cluster volume's storage, I/O and backup charges:
+//
+//	instance_rate × instance_count × 730 + storage_gb × storage_rate
+//	  + io_requests_per_month × io_rate + max(0, backup_storage_gb − storage_gb) × backup_rate
+//
+// Backup storage up to the size of the cluster volume is free. Cluster instance
+// resources (aws:docdb/clusterInstance, aws:neptune/clusterInstance) are priced as a
+// single instance; their storage is billed on the cluster.
+func (p *AWSPublicPlugin) estimateClusterDatabase( //nolint:gocognit,funlen
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	service string,
+) (*pbc.GetProjectedCostResponse, error) {
+	label := clusterDatabaseLabels[service]
+	tags := resource.GetTags()
+
+	instanceClass := clusterDatabaseInstanceClass(resource)
+	if instanceClass == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("%s instance class not specified: use 'sku' field or 'instance_class' tag", label),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	hourlyRate, found := p.clusterDatabaseInstancePrice(service, instanceClass)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       label,
+			SKU:           instanceClass,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, label+" instance class", instanceClass),
+		}
+	}
+
+	var dt DefaultsTracker
+	classificationKey := fmt.Sprintf("aws:%s:cluster", service)
+
+	// Stopped clusters accrue no instance hours; storage, I/O and backup are billed in full.
+	schedule, err := p.usageSchedule(traceID, classificationKey, tags)
+	if err != nil {
+		return nil, err
+	}
+
+	var components CostBreakdown
+
+	if isClusterInstanceResource(resource) {
+		resp := &pbc.GetProjectedCostResponse{
+			CostPerMonth: components.Add("instance", schedule.hours, "Hours", hourlyRate, instanceClass),
+			UnitPrice:    hourlyRate,
+			Currency:     "USD",
+			BillingDetail: fmt.Sprintf("%s %s instance, %s (storage billed on the cluster)", label, instanceClass,
+				schedule.billingDetail()),
+		}
+		attachCostComponents(resp, &components)
+		schedule.annotate(resp)
+		setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), classificationKey, resp)
+		return resp, nil
+	}
+
+	instanceCount, countFound, err := p.parseNodeCountTag(traceID, tags, "instance_count")
+	if err != nil {
+		return nil, err
+	}
+	if !countFound {
+		instanceCount = 1
+		dt.Add("instance_count", "1", KindConfig)
+	}
+
+	storageGB, storageFound := parseNonNegativeTag(tags, "storage_gb")
+	if !storageFound {
+		dt.Add("storage_gb", "0", KindUsageZero)
+	}
+	ioRequests, ioFound := parseNonNegativeTag(tags, "io_requests_per_month")
+	if !ioFound {
+		dt.Add("io_requests_per_month", "0", KindUsageZero)
+	}
+	backupGB, _ := parseNonNegativeTag(tags, "backup_storage_gb")
+	billableBackupGB := math.Max(0, backupGB-storageGB)
+
+	rates := p.clusterDatabaseStorageRatesFor(service)
+	charges := []struct {
+		name      string
+		dimension string
+		quantity  float64
+		unit      string
+		rate      float64
+	}{
+		{"storage", "storage", storageGB, "GB-Mo", rates.storage},
+		{"I/O", "io_requests", ioRequests, "Requests", rates.io},
+		{"backup storage", "backup_storage", billableBackupGB, "GB-Mo", rates.backup},
+	}
+	for _, charge := range charges {
+		if charge.quantity > 0 && charge.rate == 0 {
+			return nil, &PricingUnavailableError{
+				Service:       label,
+				SKU:           instanceClass,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, label+" "+charge.name, p.region),
+			}
+		}
+	}
+
+	instanceCost := components.Add("instances", float64(instanceCount)*schedule.hours, "Hours", hourlyRate,
+		instanceClass)
+	chargeCosts := make([]float64, len(charges))
+	for i, charge := range charges {
+		if charge.rate > 0 {
+			chargeCosts[i] = components.Add(charge.dimension, charge.quantity, charge.unit, charge.rate, charge.dimension)
+		}
+	}
+	storageCost, ioCost, backupCost := chargeCosts[0], chargeCosts[1], chargeCosts[2]
+	monthlyCost := instanceCost + storageCost + ioCost + backupCost
+
+	instanceLabel := "instances"
+	if instanceCount == 1 {
+		instanceLabel = "instance"
+	}
+	billingDetail := fmt.Sprintf("%s %s, %d %s, %s + %.0fGB storage, %.0f I/O requests/month",
+		label, instanceClass, instanceCount, instanceLabel, schedule.billingDetail(), storageGB, ioRequests)
+	if billableBackupGB > 0 {
+		billingDetail += fmt.Sprintf(", %.0fGB backup storage beyond the free allowance", billableBackupGB)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("service", service).
+		Str("instance_class", instanceClass).
+		Int("instance_count", instanceCount).
+		Float64("hourly_rate", hourlyRate).
+		Float64("storage_cost", storageCost).
+		Float64("io_cost", ioCost).
+		Float64("backup_cost", backupCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("cluster database cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     hourlyRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), classificationKey, resp)
+
+	return resp, nil
+}
+
+// estimateMemoryDB calculates projected monthly cost for an Amazon MemoryDB cluster.
+// Every shard runs one primary plus its replicas, all billed at the node rate:
+//
+//	node_rate × num_shards × (1 + num_replicas_per_shard) × 730
+//	  + data_written_gb_per_month × write_rate + snapshot_storage_gb × snapshot_rate
+func (p *AWSPublicPlugin) estimateMemoryDB(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+
+	nodeType := clusterDatabaseInstanceClass(resource)
+	if nodeType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"MemoryDB node type not specified: use 'sku' field or 'node_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	hourlyRate, found := p.pricing.MemoryDBNodePricePerHour(nodeType)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "MemoryDB",
+			SKU:           nodeType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "MemoryDB node type", nodeType),
+		}
+	}
+
+	var dt DefaultsTracker
+
+	numShards, shardsFound, err := p.parseNodeCountTag(traceID, tags, "num_shards")
+	if err != nil {
+		return nil, err
+	}
+	if !shardsFound {
+		numShards = 1
+		dt.Add("num_shards", "1", KindConfig)
+	}
+
+	replicas, replicasFound := parseNonNegativeTag(tags, "num_replicas_per_shard")
+	if !replicasFound {
+		replicas = defaultMemoryDBReplicasPerShard
+		dt.Add("num_replicas_per_shard", strconv.Itoa(defaultMemoryDBReplicasPerShard), KindConfig)
+	}
+	if replicas > maxMemoryDBReplicasPerShard {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for num_replicas_per_shard: %g must be between 0 and %d",
+				replicas, maxMemoryDBReplicasPerShard),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	numNodes := numShards * (1 + int(replicas))
+
+	dataWrittenGB, writtenFound := parseNonNegativeTag(tags, "data_written_gb_per_month")
+	if !writtenFound {
+		dt.Add("data_written_gb_per_month", "0", KindUsageZero)
+	}
+	snapshotGB, _ := parseNonNegativeTag(tags, "snapshot_storage_gb")
+
+	var components CostBreakdown
+	nodeCost := components.Add("nodes", float64(numNodes)*carbon.HoursPerMonth, "Hours", hourlyRate, nodeType)
+
+	var writeCost, snapshotCost float64
+	if dataWrittenGB > 0 {
+		writeRate, rateFound := p.pricing.MemoryDBDataWrittenPricePerGB()
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service:       "MemoryDB",
+				SKU:           nodeType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MemoryDB data written", p.region),
+			}
+		}
+		writeCost = components.Add("data_written", dataWrittenGB, "GB", writeRate, "data-written")
+	}
+	if snapshotGB > 0 {
+		snapshotRate, rateFound := p.pricing.MemoryDBSnapshotPricePerGBMonth()
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service:       "MemoryDB",
+				SKU:           nodeType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MemoryDB snapshot storage", p.region),
+			}
+		}
+		snapshotCost = components.Add("snapshot_storage", snapshotGB, "GB-Mo", snapshotRate, "snapshot-storage")
+	}
+
+	monthlyCost := nodeCost + writeCost + snapshotCost
+
+	billingDetail := fmt.Sprintf("MemoryDB %s, %d shard(s) × %d node(s), 730 hrs/month + %.0fGB written/month",
+		nodeType, numShards, 1+int(replicas), dataWrittenGB)
+	if snapshotGB > 0 {
+		billingDetail += fmt.Sprintf(", %.0fGB snapshot storage", snapshotGB)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("node_type", nodeType).
+		Int("num_nodes", numNodes).
+		Float64("hourly_rate", hourlyRate).
+		Float64("write_cost", writeCost).
+		Float64("snapshot_cost", snapshotCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("MemoryDB cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     hourlyRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:memorydb:cluster", resp)
+
+	return resp, nil
+}
+
+// Default values for MSK attributes.
+const (
+	defaultMSKBrokerNodes = 3    // One broker per AZ across three AZs
+	defaultMSKVolumeSize  = 1000 // GiB per broker (AWS console default)
+)
+
+// isMSKServerless reports whether the resource is an MSK Serverless cluster rather
+// than a provisioned cluster.
+func isMSKServerless(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "serverless") ||
+		strings.EqualFold(resource.GetSku(), "serverless")
+}
+
+// estimateMSK calculates projected monthly cost for provisioned Amazon MSK clusters.
+// Like OpenSearch, brokers are priced as hourly_rate × broker_count × 730 hours and
+// EBS storage is added per broker:
+//
+//	broker_rate × number_of_broker_nodes × 730
+//	  + volume_size × storage_rate × number_of_broker_nodes
+//	  + provisioned_throughput_mbps × throughput_rate × number_of_broker_nodes
+//
+// Required fields:
+//   - resource SKU: The broker instance type (e.g., "kafka.m5.large")
+//
+// Optional tags:
+//   - "number_of_broker_nodes": Number of brokers (default: 3)
+//   - "volume_size": EBS storage per broker in GiB (default: 1000)
+//   - "provisioned_throughput_mbps": Provisioned storage throughput per broker (default: 0)
+//
+// Serverless clusters (resource type containing "serverless" or SKU "serverless")
+// are priced by estimateMSKServerless.
+func (p *AWSPublicPlugin) estimateMSK( //nolint:funlen
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	if isMSKServerless(resource) {
+		return p.estimateMSKServerless(traceID, resource)
+	}
+
+	tags := resource.GetTags()
+
+	// Extract broker type from SKU, then instanceType tags
+	instanceType := resource.GetSku()
+	if instanceType == "" {
+		instanceType = tags["instance_type"]
+	}
+	if instanceType == "" {
+		instanceType = tags["instanceType"]
+	}
+	instanceType = strings.ToLower(instanceType)
+	if instanceType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"MSK broker instance type not specified: use 'sku' field or 'instance_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	brokerRate, found := p.pricing.MSKBrokerPricePerHour(instanceType)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "MSK",
+			SKU:           instanceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "MSK broker type", instanceType),
+		}
+	}
+
+	var dt DefaultsTracker
+
+	brokers, brokersFound, err := p.parseNodeCountTag(traceID, tags, "number_of_broker_nodes")
+	if err != nil {
+		return nil, err
+	}
+	if !brokersFound {
+		brokers = defaultMSKBrokerNodes
+		dt.Add("number_of_broker_nodes", strconv.Itoa(defaultMSKBrokerNodes), KindConfig)
+	}
+
+	volumeSize, sizeFound := parseNonNegativeTag(tags, "volume_size")
+	if !sizeFound {
+		volumeSize = defaultMSKVolumeSize
+		dt.Add("volume_size", strconv.Itoa(defaultMSKVolumeSize), KindConfig)
+	}
+	throughputMBps, _ := parseNonNegativeTag(tags, "provisioned_throughput_mbps")
+
+	var components CostBreakdown
+	brokerCost := components.Add("brokers", float64(brokers)*carbon.HoursPerMonth, "Hours", brokerRate, instanceType)
+
+	var storageCost, throughputCost float64
+	if volumeSize > 0 {
+		storageRate, rateFound := p.pricing.MSKStoragePricePerGBMonth()
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service:       "MSK",
+				SKU:           instanceType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MSK broker storage", p.region),
+			}
+		}
+		storageCost = components.Add("storage", volumeSize*float64(brokers), "GB-Mo", storageRate, "broker-storage")
+	}
+	if throughputMBps > 0 {
+		throughputRate, rateFound := p.pricing.MSKThroughputPricePerMBpsMonth()
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service:       "MSK",
+				SKU:           instanceType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MSK provisioned storage throughput", p.region),
+			}
+		}
+		throughputCost = components.Add("provisioned_throughput", throughputMBps*float64(brokers), "MBps-Mo",
+			throughputRate, "provisioned-throughput")
+	}
+
+	monthlyCost := brokerCost + storageCost + throughputCost
+
+	billingDetail := fmt.Sprintf("MSK %s, %d broker(s), 730 hrs/month + %.0fGB storage per broker",
+		instanceType, brokers, volumeSize)
+	if throughputMBps > 0 {
+		billingDetail += fmt.Sprintf(", %.0f MB/s provisioned throughput per broker", throughputMBps)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("instance_type", instanceType).
+		Int("brokers", brokers).
+		Float64("broker_cost", brokerCost).
+		Float64("storage_cost", storageCost).
+		Float64("throughput_cost", throughputCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("MSK cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     brokerRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:msk:cluster", resp)
+
+	return resp, nil
+}
+
+// estimateMSKServerless calculates projected monthly cost for an MSK Serverless
+// cluster:
+//
+//	cluster_rate × 730 + partition_count × partition_rate × 730
+//	  + data_in_gb_per_month × data_in_rate + data_out_gb_per_month × data_out_rate
+//	  + storage_gb × storage_rate
+//
+// Partition count and data volumes default to 0 and are reported as usage defaults.
+func (p *AWSPublicPlugin) estimateMSKServerless(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	clusterRate, found := p.pricing.MSKServerlessClusterPricePerHour()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "MSK",
+			SKU:           "serverless",
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MSK Serverless", p.region),
+		}
+	}
+
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	partitions, partitionsFound := parseNonNegativeTag(tags, "partition_count")
+	if !partitionsFound {
+		dt.Add("partition_count", "0", KindUsageZero)
+	}
+	dataInGB, inFound := parseNonNegativeTag(tags, "data_in_gb_per_month")
+	if !inFound {
+		dt.Add("data_in_gb_per_month", "0", KindUsageZero)
+	}
+	dataOutGB, outFound := parseNonNegativeTag(tags, "data_out_gb_per_month")
+	if !outFound {
+		dt.Add("data_out_gb_per_month", "0", KindUsageZero)
+	}
+	storageGB, _ := parseNonNegativeTag(tags, "storage_gb")
+
+	partitionRate, _ := p.pricing.MSKServerlessPartitionPricePerHour()
+	dataInRate, _ := p.pricing.MSKServerlessDataInPricePerGB()
+	dataOutRate, _ := p.pricing.MSKServerlessDataOutPricePerGB()
+	storageRate, _ := p.pricing.MSKServerlessStoragePricePerGBMonth()
+
+	var components CostBreakdown
+	monthlyCost := components.Add("cluster", carbon.HoursPerMonth, "Hours", clusterRate, "serverless-cluster")
+	for _, charge := range []struct {
+		name      string
+		dimension string
+		quantity  float64
+		unit      string
+		rate      float64
+	}{
+		{"partition-hours", "partitions", partitions * carbon.HoursPerMonth, "Partition-Hours", partitionRate},
+		{"data in", "data_in", dataInGB, "GB", dataInRate},
+		{"data out", "data_out", dataOutGB, "GB", dataOutRate},
+		{"storage", "storage", storageGB, "GB-Mo", storageRate},
+	} {
+		if charge.quantity > 0 && charge.rate == 0 {
+			return nil, &PricingUnavailableError{
+				Service:       "MSK",
+				SKU:           "serverless",
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "MSK Serverless "+charge.name, p.region),
+			}
+		}
+		if charge.rate > 0 {
+			monthlyCost += components.Add(charge.dimension, charge.quantity, charge.unit, charge.rate,
+				"serverless-"+charge.dimension)
+		}
+	}
+
+	billingDetail := fmt.Sprintf(
+		"MSK Serverless, 730 cluster-hrs/month + %.0f partition(s), %.0fGB in, %.0fGB out/month",
+		partitions, dataInGB, dataOutGB)
+	if storageGB > 0 {
+		billingDetail += fmt.Sprintf(", %.0fGB storage", storageGB)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("partitions", partitions).
+		Float64("data_in_gb", dataInGB).
+		Float64("data_out_gb", dataOutGB).
+		Float64("storage_gb", storageGB).
+		Float64("monthly_cost", monthlyCost).
+		Msg("MSK Serverless cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     clusterRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:msk:cluster", resp)
+
+	return resp, nil
+}
+
+// SageMaker defaults applied when the corresponding tags are absent.
+const (
+	defaultSageMakerNotebookVolumeSize = 5             // GiB (AWS console default)
+	defaultSageMakerInstanceType       = "ml.m5.large" // Endpoint instance type when unknown
+	defaultSageMakerServerlessMemoryMB = 2048          // MB
+	defaultSageMakerServerlessDuration = 100           // ms per invocation
+	maxSageMakerServerlessMemoryMB     = 6144          // Largest Serverless Inference memory size
+	sageMakerServerlessMemoryStepMB    = 1024          // Memory sizes are 1 GB multiples
+)
+
+// isSageMakerNotebook reports whether the resource is a SageMaker notebook instance.
+func isSageMakerNotebook(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "notebookinstance")
+}
+
+// isSageMakerServerless reports whether the resource is a SageMaker Serverless
+// Inference endpoint rather than an instance-backed real-time endpoint.
+func isSageMakerServerless(resource *pbc.ResourceDescriptor) bool {
+	return strings.Contains(strings.ToLower(resource.GetResourceType()), "serverless") ||
+		strings.EqualFold(resource.GetSku(), "serverless")
+}
+
+// estimateSageMaker calculates projected monthly cost for Amazon SageMaker
+// inference and development resources. Dispatch is by resource type:
+//   - Notebook instances: estimateSageMakerNotebook
+//   - Serverless Inference endpoints (SKU "serverless"): estimateSageMakerServerless
+//   - Real-time endpoints: hosting_rate × instance_count × 730 hours
+//
+// Optional tags for real-time endpoints:
+//   - "instance_type": ML instance type when SKU is empty (e.g., "ml.m5.large")
+//   - "instance_count": Number of instances behind the endpoint (default: 1)
+func (p *AWSPublicPlugin) estimateSageMaker( //nolint:funlen
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	switch {
+	case isSageMakerNotebook(resource):
+		return p.estimateSageMakerNotebook(traceID, resource)
+	case isSageMakerServerless(resource):
+		return p.estimateSageMakerServerless(traceID, resource)
+	}
+
+	tags := resource.GetTags()
+	instanceType := sageMakerInstanceType(resource)
+	if instanceType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"SageMaker instance type not specified: use 'sku' field or 'instance_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	hourlyRate, found := p.pricing.SageMakerHostingPricePerHour(instanceType)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "SageMaker",
+			SKU:           instanceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "SageMaker hosting instance type", instanceType),
+		}
+	}
+
+	var dt DefaultsTracker
+	instanceCount, countFound, err := p.parseNodeCountTag(traceID, tags, "instance_count")
+	if err != nil {
+		return nil, err
+	}
+	if !countFound {
+		instanceCount = 1
+		dt.Add("instance_count", "1", KindConfig)
+	}
+
+	var components CostBreakdown
+	monthlyCost := components.Add("instances", float64(instanceCount)*carbon.HoursPerMonth, "Hours", hourlyRate,
+		instanceType)
+	billingDetail := fmt.Sprintf("SageMaker endpoint %s, %d instance(s), 730 hrs/month",
+		instanceType, instanceCount)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("instance_type", instanceType).
+		Int("instance_count", instanceCount).
+		Float64("hourly_rate", hourlyRate).
+		Float64("monthly_cost", monthlyCost).
+		Msg("SageMaker endpoint cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     hourlyRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	p.addSageMakerCarbon(traceID, resource, instanceType, instanceCount, carbon.HoursPerMonth, resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:sagemaker:endpoint", resp)
+
+	return resp, nil
+}
+
+// estimateSageMakerNotebook calculates projected monthly cost for a SageMaker
+// notebook instance:
+//
+//	notebook_rate × 730 + volume_size × storage_rate
+//
+// Optional tags:
+//   - "instance_type": ML instance type when SKU is empty (e.g., "ml.t3.medium")
+//   - "volume_size": ML storage volume in GiB (default: 5)
+func (p *AWSPublicPlugin) estimateSageMakerNotebook(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	instanceType := sageMakerInstanceType(resource)
+	if instanceType == "" {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			"SageMaker notebook instance type not specified: use 'sku' field or 'instance_type' tag",
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	hourlyRate, found := p.pricing.SageMakerNotebookPricePerHour(instanceType)
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "SageMaker",
+			SKU:           instanceType,
+			BillingDetail: fmt.Sprintf(PricingNotFoundTemplate, "SageMaker notebook instance type", instanceType),
+		}
+	}
+
+	var dt DefaultsTracker
+	volumeSize, sizeFound := parseNonNegativeTag(resource.GetTags(), "volume_size")
+	if !sizeFound {
+		volumeSize = defaultSageMakerNotebookVolumeSize
+		dt.Add("volume_size", strconv.Itoa(defaultSageMakerNotebookVolumeSize), KindConfig)
+	}
+
+	// Stopped notebooks accrue no instance hours; the ML storage volume is billed in full.
+	schedule, err := p.usageSchedule(traceID, "aws:sagemaker:notebookinstance", resource.GetTags())
+	if err != nil {
+		return nil, err
+	}
+
+	var components CostBreakdown
+	instanceCost := components.Add("instance", schedule.hours, "Hours", hourlyRate, "notebook/"+instanceType)
+
+	var storageCost float64
+	if volumeSize > 0 {
+		storageRate, rateFound := p.pricing.SageMakerNotebookStoragePricePerGBMonth()
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service:       "SageMaker",
+				SKU:           instanceType,
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "SageMaker notebook storage", p.region),
+			}
+		}
+		storageCost = components.Add("storage", volumeSize, "GB-Mo", storageRate, "notebook-storage")
+	}
+
+	monthlyCost := instanceCost + storageCost
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Str("instance_type", instanceType).
+		Float64("instance_cost", instanceCost).
+		Float64("storage_cost", storageCost).
+		Float64("monthly_cost", monthlyCost).
+		Msg("SageMaker notebook cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: monthlyCost,
+		UnitPrice:    hourlyRate,
+		Currency:     "USD",
+		BillingDetail: fmt.Sprintf("SageMaker notebook %s, %s + %.0fGB storage",
+			instanceType, schedule.billingDetail(), volumeSize),
+		Metadata: dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+	schedule.annotate(resp)
+	p.addSageMakerCarbon(traceID, resource, instanceType, 1, schedule.hours, resp)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(),
+		"aws:sagemaker:notebookinstance", resp)
+
+	return resp, nil
+}
+
+// estimateSageMakerServerless calculates projected monthly cost for a SageMaker
+// Serverless Inference endpoint:
+//
+//	requests × (avg_duration_ms / 1000) × memory_gb × gb_second_rate
+//	  + data_processed_gb_per_month × data_rate
+//
+// Optional tags:
+//   - "memory_mb": Memory size, rounded up to a 1 GB multiple (default: 2048, max: 6144)
+//   - "avg_duration_ms": Average inference duration (default: 100)
+//   - "requests_per_month": Monthly invocations (default: 0)
+//   - "data_processed_gb_per_month": Data in and out of the endpoint (default: 0)
+//
+// Serverless capacity is not tied to an instance type, so no carbon is reported.
+func (p *AWSPublicPlugin) estimateSageMakerServerless(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	memoryMB, memFound := parseNonNegativeTag(tags, "memory_mb")
+	if !memFound || memoryMB == 0 {
+		memoryMB = defaultSageMakerServerlessMemoryMB
+		dt.Add("memory_mb", strconv.Itoa(defaultSageMakerServerlessMemoryMB), KindConfig)
+	}
+	memoryMB = math.Ceil(memoryMB/sageMakerServerlessMemoryStepMB) * sageMakerServerlessMemoryStepMB
+	if memoryMB > maxSageMakerServerlessMemoryMB {
+		return nil, p.newErrorWithID(traceID, codes.InvalidArgument,
+			fmt.Sprintf("invalid value for memory_mb: %.0f exceeds the SageMaker Serverless maximum of %d",
+				memoryMB, maxSageMakerServerlessMemoryMB),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+
+	durationMs, durationFound := parseNonNegativeTag(tags, "avg_duration_ms")
+	if !durationFound {
+		durationMs = defaultSageMakerServerlessDuration
+		dt.Add("avg_duration_ms", strconv.Itoa(defaultSageMakerServerlessDuration), KindConfig)
+	}
+	requests, requestsFound := parseNonNegativeTag(tags, "requests_per_month")
+	if !requestsFound {
+		dt.Add("requests_per_month", "0", KindUsageZero)
+	}
+	dataGB, _ := parseNonNegativeTag(tags, "data_processed_gb_per_month")
+
+	gbSecondRate, _ := p.pricing.SageMakerServerlessPricePerGBSecond()
+	dataRate, _ := p.pricing.SageMakerServerlessDataPricePerGB()
+
+	memoryGB := memoryMB / sageMakerServerlessMemoryStepMB
+	gbSeconds := requests * (durationMs / 1000) * memoryGB
+
+	var monthlyCost float64
+	var components CostBreakdown
+	for _, charge := range []struct {
+		name      string
+		dimension string
+		quantity  float64
+		unit      string
+		rate      float64
+	}{
+		{"compute", "compute", gbSeconds, "GB-Seconds", gbSecondRate},
+		{"data processing", "data_processed", dataGB, "GB", dataRate},
+	} {
+		if charge.quantity > 0 && charge.rate == 0 {
+			return nil, &PricingUnavailableError{
+				Service:       "SageMaker",
+				SKU:           "serverless",
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "SageMaker Serverless Inference "+charge.name, p.region),
+			}
+		}
+		if charge.rate > 0 {
+			monthlyCost += components.Add(charge.dimension, charge.quantity, charge.unit, charge.rate, "serverless-"+charge.dimension)
+		}
+	}
+
+	billingDetail := fmt.Sprintf(
+		"SageMaker Serverless Inference, %.0fMB, %.0f requests/month × %.0fms",
+		memoryMB, requests, durationMs)
+	if dataGB > 0 {
+		billingDetail += fmt.Sprintf(" + %.0fGB data processed", dataGB)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("memory_mb", memoryMB).
+		Float64("requests", requests).
+		Float64("gb_seconds", gbSeconds).
+		Float64("data_gb", dataGB).
+		Float64("monthly_cost", monthlyCost).
+		Msg("SageMaker Serverless cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     gbSecondRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(),
+		"aws:sagemaker:serverlessendpoint", resp)
+
+	return resp, nil
+}
+
+// sageMakerInstanceType extracts the ML instance type from the SKU, then the
+// instance_type tag, normalized to lowercase.
+func sageMakerInstanceType(resource *pbc.ResourceDescriptor) string {
+	instanceType := resource.GetSku()
+	if instanceType == "" {
+		instanceType = resource.GetTags()["instance_type"]
+	}
+	return strings.ToLower(instanceType)
+}
+
+// addSageMakerCarbon attaches the carbon footprint for SageMaker ML instances
+// running the given hours per month, including GPU power for ml.g* and ml.p*
+// families, when the EC2-equivalent instance type is present in CCF data.
+func (p *AWSPublicPlugin) addSageMakerCarbon(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	instanceType string,
+	instanceCount int,
+	hours float64,
+	resp *pbc.GetProjectedCostResponse,
+) {
+	sageMakerEstimator := carbon.NewSageMakerEstimator()
+	cpuCarbon, gpuCarbon, carbonOK := sageMakerEstimator.EstimateCarbonGramsWithBreakdown(carbon.SageMakerConfig{
+		InstanceType:  instanceType,
+		InstanceCount: instanceCount,
+		Region:        resource.GetRegion(),
+		Utilization:   carbon.DefaultUtilization, // Use CCF default (50%)
+		Hours:         hours,
+	})
+	if !carbonOK {
+		p.traceLogger(traceID, "GetProjectedCost").Debug().
+			Str("instance_type", instanceType).
+			Msg("Carbon estimation skipped - instance type not in CCF data")
+		return
+	}
+
+	resp.ImpactMetrics = []*pbc.ImpactMetric{
+		{
+			Kind:  pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT,
+			Value: cpuCarbon + gpuCarbon,
+			Unit:  "gCO2e",
+		},
+	}
+
+	p.traceLogger(traceID, "GetProjectedCost").Debug().
+		Str("instance_type", instanceType).
+		Int("instance_count", instanceCount).
+		Str("aws_region", resource.GetRegion()).
+		Float64("cpu_carbon_grams", cpuCarbon).
+		Float64("gpu_carbon_grams", gpuCarbon).
+		Msg("SageMaker carbon estimation successful")
+}
+
+// countSerializedList returns the number of top-level elements in a list attribute
+// serialized as a string, either in Go fmt form ("[map[name:a] map[name:b]]") as
+// Pulumi passes nested properties, or as a JSON array ("[{...},{...}]").
+// Returns (0, false) if s is not a bracketed list.
+func countSerializedList(s string) (int, bool) {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "[") || !strings.HasSuffix(s, "]") {
+		return 0, false
+	}
+
+	count, depth := 0, 0
+	inElement := false
+	for _, r := range s[1 : len(s)-1] {
+		switch {
+		case depth == 0 && (r == ' ' || r == ','):
+			inElement = false
+			continue
+		case r == '[' || r == '{':
+			depth++
+		case r == ']' || r == '}':
+			depth--
+		}
+		if !inElement {
+			count++
+			inElement = true
+		}
+	}
+	return count, true
+}
+
+// estimateWAF calculates projected monthly cost for an AWS WAF web ACL:
+//
+//	web_acl_rate + rule_count × rule_rate + requests_per_month × request_rate
+//
+// The rule count is read from the "rule_count" tag, or counted from the "rules"
+// attribute when present. Supporting resources (IP sets, rule groups, regex pattern
+// sets, associations) have no charge of their own; their rules are billed on the
+// web ACL that references them.
+//
+// Optional tags:
+//   - "rule_count": Rules and rule group references in the web ACL (default: 0)
+//   - "rules": Serialized rules attribute, counted when rule_count is absent
+//   - "requests_per_month": Web requests inspected per month (default: 0)
+func (p *AWSPublicPlugin) estimateWAF(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	webACLRate, found := p.pricing.WAFPricePerWebACLMonth()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "WAF",
+			SKU:           resource.GetSku(),
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "AWS WAF", p.region),
+		}
+	}
+
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	rules, rulesFound := parseNonNegativeTag(tags, "rule_count")
+	if !rulesFound {
+		if count, ok := countSerializedList(tags["rules"]); ok {
+			rules, rulesFound = float64(count), true
+		}
+	}
+	if !rulesFound {
+		dt.Add("rule_count", "0", KindConfig)
+	}
+	rules = math.Floor(rules)
+
+	requests, requestsFound := parseNonNegativeTag(tags, "requests_per_month")
+	if !requestsFound {
+		dt.Add("requests_per_month", "0", KindUsageZero)
+	}
+
+	ruleRate, _ := p.pricing.WAFPricePerRuleMonth()
+	requestRate, _ := p.pricing.WAFPricePerRequest()
+
+	var components CostBreakdown
+	monthlyCost := components.Add("web_acl", 1, "Web ACLs", webACLRate, "web-acl")
+	for _, charge := range []struct {
+		name      string
+		dimension string
+		quantity  float64
+		unit      string
+		rate      float64
+	}{
+		{"rules", "rules", rules, "Rules", ruleRate},
+		{"requests", "requests", requests, "Requests", requestRate},
+	} {
+		if charge.quantity > 0 && charge.rate == 0 {
+			return nil, &PricingUnavailableError{
+				Service:       "WAF",
+				SKU:           resource.GetSku(),
+				BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "AWS WAF "+charge.name, p.region),
+			}
+		}
+		if charge.rate > 0 {
+			monthlyCost += components.Add(charge.dimension, charge.quantity, charge.unit, charge.rate, charge.dimension)
+		}
+	}
+
+	billingDetail := fmt.Sprintf("AWS WAF web ACL, %.0f rule(s), %.0f requests/month", rules, requests)
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("rules", rules).
+		Float64("requests", requests).
+		Float64("monthly_cost", monthlyCost).
+		Msg("WAF cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     webACLRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:wafv2:webacl", resp)
+
+	return resp, nil
+}
+
+// estimateShield calculates projected monthly cost for AWS Shield resources.
+// A Shield Advanced subscription carries the fixed monthly fee, which AWS charges
+// once per organization. Protections and protection groups have no per-resource
+// fee; they are covered by the subscription. Shield Standard is free.
+func (p *AWSPublicPlugin) estimateShield(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	monthlyFee, found := p.pricing.ShieldAdvancedPricePerMonth()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "Shield",
+			SKU:           resource.GetSku(),
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Shield Advanced", p.region),
+		}
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("monthly_cost", monthlyFee).
+		Msg("Shield Advanced cost estimated")
+
+	var components CostBreakdown
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth: components.Add("subscription", 1, "Months", monthlyFee, "shield-advanced"),
+		UnitPrice:    monthlyFee,
+		Currency:     "USD",
+		BillingDetail: fmt.Sprintf("Shield Advanced subscription, $%.0f/month per organization "+
+			"(data transfer out not included)", monthlyFee),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(), "aws:shield:subscription", resp)
+
+	return resp, nil
+}
+
+// regionGeography maps an AWS region code to the Global Accelerator pricing
+// geography that contains it. Returns "" for unrecognized regions.
+func regionGeography(region string) string {
+	prefix, _, _ := strings.Cut(region, "-")
+	switch prefix {
+	case "us", "ca", "mx":
+		return "North America"
+	case "eu":
+		return "Europe"
+	case "ap":
+		return "Asia Pacific"
+	case "sa":
+		return "South America"
+	case "me", "il":
+		return "Middle East"
+	case "af":
+		return "Africa"
+	default:
+		return ""
+	}
+}
+
+// estimateGlobalAccelerator calculates projected monthly cost for an AWS Global
+// Accelerator (standard or custom routing):
+//
+//	hourly_rate × 730 + data_transfer_premium_gb_per_month × dt_premium_rate
+//
+// Data Transfer-Premium is charged on the dominant direction of traffic and is
+// priced by source and destination geography. The source defaults to the
+// geography of the plugin region and the destination to the source.
+//
+// Listeners and endpoint groups have no charge of their own.
+//
+// Optional tags:
+//   - "data_transfer_premium_gb_per_month": Dominant-direction traffic (default: 0)
+//   - "source_geography": e.g., "North America", "Europe", "Asia Pacific"
+//   - "destination_geography": e.g., "North America", "Europe", "Asia Pacific"
+func (p *AWSPublicPlugin) estimateGlobalAccelerator(
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) (*pbc.GetProjectedCostResponse, error) {
+	hourlyRate, found := p.pricing.GlobalAcceleratorPricePerHour()
+	if !found {
+		return nil, &PricingUnavailableError{
+			Service:       "GlobalAccelerator",
+			SKU:           resource.GetSku(),
+			BillingDetail: fmt.Sprintf(PricingUnavailableTemplate, "Global Accelerator", p.region),
+		}
+	}
+
+	tags := resource.GetTags()
+	var dt DefaultsTracker
+
+	dataGB, dataFound := parseNonNegativeTag(tags, "data_transfer_premium_gb_per_month")
+	if !dataFound {
+		dt.Add("data_transfer_premium_gb_per_month", "0", KindUsageZero)
+	}
+
+	var components CostBreakdown
+	fixedCost := components.Add("accelerator", carbon.HoursPerMonth, "Hours", hourlyRate, "accelerator")
+	monthlyCost := fixedCost
+	billingDetail := fmt.Sprintf("Global Accelerator, 730 hrs/month ($%.3f/hr)", hourlyRate)
+
+	if dataGB > 0 {
+		source := tags["source_geography"]
+		if source == "" {
+			source = regionGeography(p.region)
+			dt.Add("source_geography", source, KindConfig)
+		}
+		destination := tags["destination_geography"]
+		if destination == "" {
+			destination = source
+			dt.Add("destination_geography", destination, KindConfig)
+		}
+
+		premiumRate, rateFound := p.pricing.GlobalAcceleratorDTPremiumPricePerGB(source, destination)
+		if !rateFound {
+			return nil, &PricingUnavailableError{
+				Service: "GlobalAccelerator",
+				SKU:     resource.GetSku(),
+				BillingDetail: fmt.Sprintf(PricingNotFoundTemplate,
+					"Global Accelerator DT-Premium route", source+" to "+destination),
+			}
+		}
+		monthlyCost += components.Add("data_transfer_premium", dataGB, "GB", premiumRate, source+"/"+destination)
+		billingDetail += fmt.Sprintf(" + %.0fGB DT-Premium %s to %s", dataGB, source, destination)
+	}
+
+	p.logger.Debug().
+		Str(pluginsdk.FieldTraceID, traceID).
+		Float64("fixed_cost", fixedCost).
+		Float64("data_transfer_gb", dataGB).
+		Float64("monthly_cost", monthlyCost).
+		Msg("Global Accelerator cost estimated")
+
+	resp := &pbc.GetProjectedCostResponse{
+		CostPerMonth:  monthlyCost,
+		UnitPrice:     hourlyRate,
+		Currency:      "USD",
+		BillingDetail: billingDetail,
+		Metadata:      dt.Metadata(),
+	}
+	attachCostComponents(resp, &components)
+
+	// Apply growth hint enrichment
+	setGrowthHint(p.logger.With().Str(pluginsdk.FieldTraceID, traceID).Logger(),
+		"aws:globalaccelerator:accelerator", resp)
+
+	return resp, nil
+}
+
 // zeroCostResourceDescriptions provides billing detail messages for resources with no direct AWS charges.
 var zeroCostResourceDescriptions = map[string]string{
 	serviceVPC:           "VPC has no direct hourly or monthly charge. Costs may apply for associated resources (NAT Gateway, VPN, etc.)",
@@ -2042,6 +4766,7 @@ var zeroCostResourceDescriptions = map[string]string{
 	serviceIAM:           "IAM resources (users, roles, policies) have no direct charge. They are a free AWS feature.",
 	serviceLaunchTmpl:    "Launch Templates are configuration-only resources with no direct charge. Costs apply to instances launched from them.",
 	serviceLaunchConfig:  "Launch Configurations are configuration-only resources with no direct charge. Costs apply to instances launched from them.",
+	serviceConfigOnly:    "Configuration resources (aliases, policies, versions, associations) have no direct charge. Costs apply to the resource they configure.",
 }
 
 // estimateZeroCostResource returns a $0 cost estimate for AWS resources that have no direct charges.
@@ -4665,8 +4665,11 @@ func assertMetadata(t *testing.T, resp *pbc.GetProjectedCostResponse, wantQualit
 	t.Helper()
 	m := resp.GetMetadata()
 	if wantNil {
-		if m != nil {
-			t.Errorf("Metadata = %v, want nil (no defaults applied)", m)
+		// Cost components may still be present; only defaults keys must be absent.
+		for _, key := range []string{"estimate_quality", "defaults_applied"} {
+			if _, ok := m[key]; ok {
+				t.Errorf("Metadata = %v, want no %s (no defaults applied)", m, key)
+			}
 		}
 		return
 	}
@@ -0,0 +1,205 @@
+package plugin
+
+import (
+	"encoding/json"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// Metadata keys for price provenance.
+const (
+	// metadataKeyPriceSources holds the JSON-encoded rate provenance of a pricing spec.
+	metadataKeyPriceSources = "price_sources"
+
+	// metadataKeyPriceVintages holds the JSON-encoded price list vintages per service.
+	metadataKeyPriceVintages = "price_vintages"
+
+	// metadataKeyOfferCode, metadataKeyPriceListVersion and metadataKeyPublicationDate
+	// identify the primary price list of a pricing spec.
+	metadataKeyOfferCode        = "offer_code"
+	metadataKeyPriceListVersion = "price_list_version"
+	metadataKeyPublicationDate  = "publication_date"
+)
+
+// FOCUS extended columns carrying price provenance on actual cost records.
+const (
+	focusColumnSourceSKU        = "x_SourceSku"
+	focusColumnRateCode         = "x_RateCode"
+	focusColumnOfferCode        = "x_OfferCode"
+	focusColumnPriceListVersion = "x_PriceListVersion"
+	focusColumnPublicationDate  = "x_PublicationDate"
+)
+
+// PriceSource traces a rate back to the AWS price list it was published in.
+// Fields are empty when the embedded pricing data has no matching entry.
+type PriceSource struct {
+	// SourceSKU is the AWS product SKU the rate belongs to.
+	SourceSKU string `json:"source_sku,omitempty"`
+
+	// RateCode is the OnDemand price dimension rate code.
+	RateCode string `json:"rate_code,omitempty"`
+
+	// OfferCode identifies the AWS price list offer (e.g., "AmazonEC2").
+	OfferCode string `json:"offer_code,omitempty"`
+
+	// PriceListVersion is the version of the price list.
+	PriceListVersion string `json:"price_list_version,omitempty"`
+
+	// PublicationDate is when AWS published the price list.
+	PublicationDate string `json:"publication_date,omitempty"`
+}
+
+// RateProvenance is one rate of a pricing spec and the price list entry behind it.
+type RateProvenance struct {
+	// Dimension names the charge the rate applies to (e.g., "instance", "storage").
+	Dimension string `json:"dimension"`
+
+	// Unit is the pricing unit of Rate.
+	Unit string `json:"unit"`
+
+	// Rate is the public on-demand price per Unit in USD.
+	Rate float64 `json:"rate"`
+
+	// SKU is the lookup key the rate was found by (see CostComponent.SKU).
+	SKU string `json:"sku,omitempty"`
+
+	PriceSource
+}
+
+// priceSource resolves the price list entry behind the rate looked up by key.
+// Each offer is tried in order; when no offer knows the key, the vintage of the
+// first parsed offer is returned so the estimate still names its price list.
+func (p *AWSPublicPlugin) priceSource(offerCodes []string, key string) PriceSource {
+	var fallback PriceSource
+	for _, offerCode := range offerCodes {
+		source, found := p.pricing.RateSource(offerCode, key)
+		resolved := PriceSource{
+			SourceSKU:        source.SKU,
+			RateCode:         source.RateCode,
+			OfferCode:        source.OfferCode,
+			PriceListVersion: source.Version,
+			PublicationDate:  source.PublicationDate,
+		}
+		if found {
+			return resolved
+		}
+		if fallback.OfferCode == "" {
+			fallback = resolved
+		}
+	}
+	return fallback
+}
+
+// attachPriceSources fills in the price list provenance of every cost component
+// in the response metadata.
+func (p *AWSPublicPlugin) attachPriceSources(resp *pbc.GetProjectedCostResponse, offerCodes []string) {
+	if resp == nil || len(offerCodes) == 0 {
+		return
+	}
+	components := costComponentsFromMetadata(resp.GetMetadata())
+	if len(components) == 0 {
+		return
+	}
+	for i := range components {
+		components[i].PriceSource = p.priceSource(offerCodes, components[i].SKU)
+	}
+	attachCostComponents(resp, &CostBreakdown{components: components})
+}
+
+// pricingSpecProvenance records the price list behind a pricing spec in its
+// plugin metadata: the primary offer's vintage, the age of its price lists and,
+// when the resource can be estimated, the source of every rate it is billed at.
+func (p *AWSPublicPlugin) pricingSpecProvenance(
+	settings *pluginSettings,
+	spec *pbc.PricingSpec,
+	estimator serviceEstimator,
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+) {
+	offerCodes := estimator.OfferCodes()
+	if spec == nil || len(offerCodes) == 0 {
+		return
+	}
+	if spec.PluginMetadata == nil {
+		spec.PluginMetadata = make(map[string]string)
+	}
+	primary := p.priceSource(offerCodes[:1], "")
+	spec.PluginMetadata[metadataKeyOfferCode] = offerCodes[0]
+	if primary.PriceListVersion != "" {
+		spec.PluginMetadata[metadataKeyPriceListVersion] = primary.PriceListVersion
+		spec.PluginMetadata[metadataKeyPublicationDate] = primary.PublicationDate
+	}
+	if freshness, ok := p.priceFreshness(settings, offerCodes); ok {
+		for key, value := range freshness.metadata() {
+			spec.PluginMetadata[key] = value
+		}
+	}
+
+	resp, err := estimator.ProjectedCost(p, settings, traceID, resource, &pbc.GetProjectedCostRequest{Resource: resource})
+	if err != nil {
+		return
+	}
+	components := costComponentsFromMetadata(resp.GetMetadata())
+	if len(components) == 0 {
+		return
+	}
+	rates := make([]RateProvenance, 0, len(components))
+	for _, component := range components {
+		rates = append(rates, RateProvenance{
+			Dimension:   component.Dimension,
+			Unit:        component.Unit,
+			Rate:        component.Rate,
+			SKU:         component.SKU,
+			PriceSource: component.PriceSource,
+		})
+	}
+	if encoded, marshalErr := json.Marshal(rates); marshalErr == nil {
+		spec.PluginMetadata[metadataKeyPriceSources] = string(encoded)
+	}
+}
+
+// priceVintagesByService returns the price list vintages each registered service
+// is priced from, keyed by canonical service type. Services whose price lists
+// were not loaded are omitted.
+func (p *AWSPublicPlugin) priceVintagesByService() map[string][]PriceSource {
+	byOffer := make(map[string]PriceSource)
+	for _, vintage := range p.pricing.PriceVintages() {
+		byOffer[vintage.OfferCode] = PriceSource{
+			OfferCode:        vintage.OfferCode,
+			PriceListVersion: vintage.Version,
+			PublicationDate:  vintage.PublicationDate,
+		}
+	}
+
+	services := make(map[string][]PriceSource)
+	for serviceType, estimator := range serviceRegistry {
+		for _, offerCode := range estimator.OfferCodes() {
+			if vintage, ok := byOffer[offerCode]; ok {
+				services[serviceType] = append(services[serviceType], vintage)
+			}
+		}
+	}
+	return services
+}
+
+// focusProvenanceColumns returns the FOCUS extended columns for a price source,
+// omitting empty values. Returns nil when the source is empty.
+func focusProvenanceColumns(source PriceSource) map[string]string {
+	values := map[string]string{
+		focusColumnSourceSKU:        source.SourceSKU,
+		focusColumnRateCode:         source.RateCode,
+		focusColumnOfferCode:        source.OfferCode,
+		focusColumnPriceListVersion: source.PriceListVersion,
+		focusColumnPublicationDate:  source.PublicationDate,
+	}
+	columns := make(map[string]string, len(values))
+	for key, value := range values {
+		if value != "" {
+			columns[key] = value
+		}
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+	return columns
+}
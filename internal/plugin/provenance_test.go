@@ -0,0 +1,192 @@
+package plugin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// rdsVintage is the RDS price list vintage used by the provenance tests.
+var rdsVintage = pricing.PriceVintage{
+	OfferCode:       "AmazonRDS",
+	Version:         "20250101000000",
+	PublicationDate: "2025-01-01T00:00:00Z",
+}
+
+// createProvenanceMockPlugin returns the conformance mock with an RDS price list
+// vintage and a rate source for the db.t3.micro MySQL instance rate only.
+func createProvenanceMockPlugin() *AWSPublicPlugin {
+	plugin := createConformanceMockPlugin("us-east-1")
+	mock := plugin.pricing.(*mockPricingClient)
+	mock.priceVintages = []pricing.PriceVintage{rdsVintage}
+	mock.rateSources["AmazonRDS|db.t3.micro/MySQL"] = pricing.RateSource{
+		PriceVintage: rdsVintage,
+		SKU:          "ABC123",
+		RateCode:     "ABC123.JRTCKXETXF.6YS6EN2CT7",
+	}
+	return plugin
+}
+
+// rdsProvenanceResource is a db.t3.micro instance with 100 GB of storage.
+func rdsProvenanceResource() *pbc.ResourceDescriptor {
+	return &pbc.ResourceDescriptor{
+		Provider:     providerAWS,
+		ResourceType: "aws:rds/instance:Instance",
+		Sku:          "db.t3.micro",
+		Region:       "us-east-1",
+		Tags:         map[string]string{"storage_size": "100"},
+	}
+}
+
+// TestGetProjectedCost_PriceSources verifies each cost component names the price
+// list entry behind its rate, falling back to the vintage alone.
+func TestGetProjectedCost_PriceSources(t *testing.T) {
+	plugin := createProvenanceMockPlugin()
+
+	resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+		Resource: rdsProvenanceResource(),
+	})
+	require.NoError(t, err)
+
+	components := costComponentsFromMetadata(resp.GetMetadata())
+	require.Len(t, components, 2)
+	assert.Equal(t, PriceSource{
+		SourceSKU:        "ABC123",
+		RateCode:         "ABC123.JRTCKXETXF.6YS6EN2CT7",
+		OfferCode:        "AmazonRDS",
+		PriceListVersion: "20250101000000",
+		PublicationDate:  "2025-01-01T00:00:00Z",
+	}, components[0].PriceSource)
+	assert.Equal(t, PriceSource{
+		OfferCode:        "AmazonRDS",
+		PriceListVersion: "20250101000000",
+		PublicationDate:  "2025-01-01T00:00:00Z",
+	}, components[1].PriceSource, "storage has no recorded rate source")
+	assert.Contains(t, resp.GetMetadata()[metadataKeyCostComponents], `"rate_code":"ABC123.JRTCKXETXF.6YS6EN2CT7"`)
+}
+
+// TestGetActualCost_PriceSources verifies FOCUS records use the rate code as the
+// SKU price ID and carry provenance in extended columns.
+func TestGetActualCost_PriceSources(t *testing.T) {
+	plugin := createProvenanceMockPlugin()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	resp, err := plugin.GetActualCost(context.Background(), &pbc.GetActualCostRequest{
+		ResourceId: makeResourceJSON("aws", "aws:rds/instance:Instance", "db.t3.micro", "us-east-1",
+			map[string]string{"storage_size": "100"}),
+		Start: timestamppb.New(from),
+		End:   timestamppb.New(from.Add(24 * time.Hour)),
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 2)
+
+	instance := resp.GetResults()[0].GetFocusRecord()
+	assert.Equal(t, "ABC123.JRTCKXETXF.6YS6EN2CT7", instance.GetSkuPriceId())
+	assert.Equal(t, map[string]string{
+		focusColumnSourceSKU:        "ABC123",
+		focusColumnRateCode:         "ABC123.JRTCKXETXF.6YS6EN2CT7",
+		focusColumnOfferCode:        "AmazonRDS",
+		focusColumnPriceListVersion: "20250101000000",
+		focusColumnPublicationDate:  "2025-01-01T00:00:00Z",
+	}, instance.GetExtendedColumns())
+
+	storage := resp.GetResults()[1].GetFocusRecord()
+	assert.Equal(t, "gp2", storage.GetSkuPriceId(), "falls back to the lookup key")
+	assert.Equal(t, "20250101000000", storage.GetExtendedColumns()[focusColumnPriceListVersion])
+	assert.NotContains(t, storage.GetExtendedColumns(), focusColumnRateCode)
+}
+
+// TestGetPricingSpec_PriceSources verifies the pricing spec names its price list
+// and the source of each rate.
+func TestGetPricingSpec_PriceSources(t *testing.T) {
+	plugin := createProvenanceMockPlugin()
+
+	resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+		Resource: rdsProvenanceResource(),
+	})
+	require.NoError(t, err)
+
+	metadata := resp.GetSpec().GetPluginMetadata()
+	assert.Equal(t, "AmazonRDS", metadata[metadataKeyOfferCode])
+	assert.Equal(t, "20250101000000", metadata[metadataKeyPriceListVersion])
+	assert.Equal(t, "2025-01-01T00:00:00Z", metadata[metadataKeyPublicationDate])
+
+	var rates []RateProvenance
+	require.NoError(t, json.Unmarshal([]byte(metadata[metadataKeyPriceSources]), &rates))
+	require.Len(t, rates, 2)
+	assert.Equal(t, "instance", rates[0].Dimension)
+	assert.InDelta(t, 0.017, rates[0].Rate, 1e-9)
+	assert.Equal(t, "ABC123.JRTCKXETXF.6YS6EN2CT7", rates[0].RateCode)
+	assert.Equal(t, "storage", rates[1].Dimension)
+	assert.Equal(t, "AmazonRDS", rates[1].OfferCode)
+}
+
+// TestGetPluginInfo_PriceVintages verifies the per-service price list vintages.
+func TestGetPluginInfo_PriceVintages(t *testing.T) {
+	plugin := createProvenanceMockPlugin()
+
+	resp, err := plugin.GetPluginInfo(context.Background(), &pbc.GetPluginInfoRequest{})
+	require.NoError(t, err)
+
+	var vintages map[string][]PriceSource
+	require.NoError(t, json.Unmarshal([]byte(resp.GetMetadata()[metadataKeyPriceVintages]), &vintages))
+	assert.Equal(t, map[string][]PriceSource{
+		serviceRDS: {{
+			OfferCode:        "AmazonRDS",
+			PriceListVersion: "20250101000000",
+			PublicationDate:  "2025-01-01T00:00:00Z",
+		}},
+	}, vintages, "only services whose price list was loaded are listed")
+
+	t.Run("no vintages", func(t *testing.T) {
+		resp, err := createConformanceMockPlugin("us-east-1").GetPluginInfo(context.Background(), nil)
+		require.NoError(t, err)
+		assert.NotContains(t, resp.GetMetadata(), metadataKeyPriceVintages)
+	})
+}
+
+// TestEstimateCost_PriceSources verifies EstimateCost returns the cost components
+// and their price sources as response header metadata.
+func TestEstimateCost_PriceSources(t *testing.T) {
+	plugin := createProvenanceMockPlugin()
+
+	attrs, err := structpb.NewStruct(map[string]any{
+		"instanceClass":    "db.t3.micro",
+		"engine":           "mysql",
+		"allocatedStorage": float64(100),
+		"storageType":      "gp2",
+	})
+	require.NoError(t, err)
+
+	stream := &headerCaptureStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+		ResourceType: "aws:rds/instance:Instance",
+		Attributes:   attrs,
+	})
+	require.NoError(t, err)
+
+	encoded := stream.header.Get(metadataKeyCostComponents)
+	require.Len(t, encoded, 1)
+	components := costComponentsFromMetadata(map[string]string{metadataKeyCostComponents: encoded[0]})
+	require.NotEmpty(t, components)
+	assert.Equal(t, "ABC123.JRTCKXETXF.6YS6EN2CT7", components[0].RateCode)
+}
+
+// TestLambdaPriceArch verifies architecture tags map to the rate source keys.
+func TestLambdaPriceArch(t *testing.T) {
+	assert.Equal(t, "arm64", lambdaPriceArch("arm64"))
+	assert.Equal(t, "arm64", lambdaPriceArch("ARM"))
+	assert.Equal(t, "x86_64", lambdaPriceArch("x86_64"))
+	assert.Equal(t, "x86_64", lambdaPriceArch("unknown"))
+}
@@ -0,0 +1,210 @@
+package plugin
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// Pulumi input values translated by the attribute adapters.
+const (
+	dynamoDBBillingPayPerRequest = "PAY_PER_REQUEST"
+	dynamoDBBillingProvisioned   = "PROVISIONED"
+	lambdaSnapStartPublished     = "PublishedVersions"
+)
+
+// attributeAdapter translates the Pulumi inputs of one service whose name or
+// encoding differs from the tag its estimator reads. It may add tags and returns
+// the resource SKU, or "" to let the estimator apply its own default.
+type attributeAdapter func(attrs *structpb.Struct, tags map[string]string, dt *DefaultsTracker) string
+
+// pulumiAttributeAdapters holds the per-service adapters. Services without an
+// entry are fully covered by the generic snake_case mapping.
+var pulumiAttributeAdapters = map[string]attributeAdapter{
+	serviceEC2: func(attrs *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		// rootBlockDevice may arrive as a single-element list
+		if list := attrs.GetFields()["rootBlockDevice"].GetListValue(); len(list.GetValues()) > 0 {
+			tags["rootBlockDevice"] = renderAttribute(list.GetValues()[0])
+		}
+		return tags["instanceType"]
+	},
+	serviceEBS: func(_ *structpb.Struct, tags map[string]string, dt *DefaultsTracker) string {
+		if volumeType := tags["type"]; volumeType != "" {
+			return volumeType
+		}
+		dt.Add("type", defaultRootVolumeType, KindConfig)
+		return defaultRootVolumeType
+	},
+	serviceRDS: func(_ *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		setTagIfAbsent(tags, "storage_size", tags["allocatedStorage"])
+		return tags["instanceClass"]
+	},
+	serviceLambda: func(_ *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		if archs := strings.Fields(strings.Trim(tags["architectures"], "[]")); len(archs) > 0 {
+			setTagIfAbsent(tags, "arch", archs[0])
+		}
+		if ephemeral := parseGoMapString(tags["ephemeralStorage"]); ephemeral["size"] != "" {
+			setTagIfAbsent(tags, "ephemeral_storage_mb", ephemeral["size"])
+		}
+		if snapStart := parseGoMapString(tags["snapStart"]); snapStart["applyOn"] != "" {
+			setTagIfAbsent(tags, "snapstart", strconv.FormatBool(snapStart["applyOn"] == lambdaSnapStartPublished))
+		}
+		return tags["memorySize"]
+	},
+	serviceDynamoDB: func(_ *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		setTagIfAbsent(tags, "read_capacity_units", tags["readCapacity"])
+		setTagIfAbsent(tags, "write_capacity_units", tags["writeCapacity"])
+		switch strings.ToUpper(tags["billingMode"]) {
+		case dynamoDBBillingPayPerRequest:
+			return "on-demand"
+		case dynamoDBBillingProvisioned:
+			return "provisioned"
+		}
+		return ""
+	},
+	serviceELB: func(_ *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		return tags["loadBalancerType"]
+	},
+	serviceSageMaker: func(_ *structpb.Struct, tags map[string]string, dt *DefaultsTracker) string {
+		// An endpoint's instances are declared on its endpoint configuration, which
+		// is not among its inputs, so the instance type is a guess and the estimate
+		// is marked low quality.
+		if tags["instance_type"] == "" {
+			tags["instance_type"] = defaultSageMakerInstanceType
+			dt.Add("instance_type", defaultSageMakerInstanceType, KindUsageZero)
+		}
+		return ""
+	},
+	serviceElastiCache: func(_ *structpb.Struct, tags map[string]string, _ *DefaultsTracker) string {
+		setTagIfAbsent(tags, "num_cache_nodes", tags["numCacheClusters"])
+		return tags["nodeType"]
+	},
+}
+
+// resourceFromAttributes maps Pulumi input attributes onto the ResourceDescriptor
+// that GetProjectedCost consumes. Every attribute is copied as a tag under its
+// Pulumi name and its snake_case form, and nested objects are flattened into
+// snake_case leaf keys (shallower keys win), so inputs such as numCacheNodes or
+// clusterConfig.instanceType reach the estimator as num_cache_nodes and
+// instance_type. Lists and objects are rendered in Go fmt form, as Pulumi passes
+// nested properties in tags. The service's attributeAdapter then handles inputs
+// the generic mapping cannot, and chooses the SKU.
+//
+// The returned tracker records defaults applied during mapping; the estimator
+// records its own.
+func resourceFromAttributes(
+	resourceType, region, service string,
+	attrs *structpb.Struct,
+) (*pbc.ResourceDescriptor, DefaultsTracker) {
+	fields := attrs.GetFields()
+	tags := make(map[string]string, len(fields)*2)
+
+	for key, value := range fields {
+		if rendered := renderAttribute(value); rendered != "" {
+			tags[key] = rendered
+		}
+	}
+
+	// Breadth-first so a top-level input is never shadowed by a nested leaf.
+	level := []*structpb.Struct{attrs}
+	for len(level) > 0 {
+		var next []*structpb.Struct
+		for _, s := range level {
+			for _, key := range sortedFieldKeys(s) {
+				value := s.GetFields()[key]
+				setTagIfAbsent(tags, snakeCase(key), renderAttribute(value))
+				if nested := value.GetStructValue(); nested != nil {
+					next = append(next, nested)
+				}
+			}
+		}
+		level = next
+	}
+
+	var dt DefaultsTracker
+	var sku string
+	if adapt, ok := pulumiAttributeAdapters[service]; ok {
+		sku = adapt(attrs, tags, &dt)
+	}
+
+	return &pbc.ResourceDescriptor{
+		Provider:     providerAWS,
+		ResourceType: resourceType,
+		Sku:          sku,
+		Region:       region,
+		Tags:         tags,
+	}, dt
+}
+
+// renderAttribute renders a Pulumi attribute value as a tag string. Numbers are
+// rendered without exponents so integer parsers accept them; lists and objects use
+// Go fmt form ("[a b]", "map[k:v]") as understood by parseGoMapString and
+// countSerializedList. Null values render as "".
+func renderAttribute(value *structpb.Value) string {
+	switch v := value.GetKind().(type) {
+	case *structpb.Value_StringValue:
+		return v.StringValue
+	case *structpb.Value_NumberValue:
+		return strconv.FormatFloat(v.NumberValue, 'f', -1, 64)
+	case *structpb.Value_BoolValue:
+		return strconv.FormatBool(v.BoolValue)
+	case *structpb.Value_ListValue:
+		elements := make([]string, 0, len(v.ListValue.GetValues()))
+		for _, element := range v.ListValue.GetValues() {
+			elements = append(elements, renderAttribute(element))
+		}
+		return "[" + strings.Join(elements, " ") + "]"
+	case *structpb.Value_StructValue:
+		pairs := make([]string, 0, len(v.StructValue.GetFields()))
+		for _, key := range sortedFieldKeys(v.StructValue) {
+			pairs = append(pairs, key+":"+renderAttribute(v.StructValue.GetFields()[key]))
+		}
+		return "map[" + strings.Join(pairs, " ") + "]"
+	default:
+		return ""
+	}
+}
+
+// snakeCase converts a camelCase Pulumi input name to the snake_case tag key used
+// by the estimators (e.g., "allocatedStorage" → "allocated_storage"). Names that are
+// already snake_case are returned unchanged.
+func snakeCase(name string) string {
+	var b strings.Builder
+	b.Grow(len(name) + 4)
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			if i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// setTagIfAbsent sets tags[key] unless the key is already present or value is empty.
+func setTagIfAbsent(tags map[string]string, key, value string) {
+	if value == "" {
+		return
+	}
+	if _, exists := tags[key]; !exists {
+		tags[key] = value
+	}
+}
+
+// sortedFieldKeys returns the field names of s in sorted order for deterministic
+// rendering and flattening.
+func sortedFieldKeys(s *structpb.Struct) []string {
+	keys := make([]string, 0, len(s.GetFields()))
+	for key := range s.GetFields() {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
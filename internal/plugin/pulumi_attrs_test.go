@@ -0,0 +1,124 @@
+package plugin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestSnakeCase verifies Pulumi camelCase input names map to estimator tag keys.
+func TestSnakeCase(t *testing.T) {
+	tests := map[string]string{
+		"allocatedStorage":    "allocated_storage",
+		"multiAz":             "multi_az",
+		"numberOfBrokerNodes": "number_of_broker_nodes",
+		"kmsKeyID":            "kms_key_id",
+		"ipv6AddressCount":    "ipv6_address_count",
+		"engine":              "engine",
+		"storage_gb":          "storage_gb",
+	}
+	for in, want := range tests {
+		t.Run(in, func(t *testing.T) {
+			assert.Equal(t, want, snakeCase(in))
+		})
+	}
+}
+
+// TestRenderAttribute verifies attribute values render in the Go fmt form the
+// estimators' tag parsers expect.
+func TestRenderAttribute(t *testing.T) {
+	tests := []struct {
+		name  string
+		value any
+		want  string
+	}{
+		{"string", "gp3", "gp3"},
+		{"integer number", float64(1000000), "1000000"},
+		{"fractional number", 0.5, "0.5"},
+		{"bool", true, "true"},
+		{"null", nil, ""},
+		{"list", []any{"m5.large", "m5.xlarge"}, "[m5.large m5.xlarge]"},
+		{
+			"object with sorted keys",
+			map[string]any{"volumeType": "gp2", "volumeSize": float64(8)},
+			"map[volumeSize:8 volumeType:gp2]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := structpb.NewValue(tt.value)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, renderAttribute(value))
+		})
+	}
+}
+
+// TestResourceFromAttributes verifies the generic mapping and the per-service
+// adapters.
+func TestResourceFromAttributes(t *testing.T) {
+	newAttrs := func(t *testing.T, m map[string]any) *structpb.Struct {
+		t.Helper()
+		attrs, err := structpb.NewStruct(m)
+		require.NoError(t, err)
+		return attrs
+	}
+
+	t.Run("top-level inputs win over nested leaves", func(t *testing.T) {
+		attrs := newAttrs(t, map[string]any{
+			"volumeSize": float64(50),
+			"ebsOptions": map[string]any{"volumeSize": float64(100), "volumeType": "gp3"},
+		})
+		resource, dt := resourceFromAttributes("aws:opensearch/domain:Domain", "us-east-1", serviceOpenSearch, attrs)
+		assert.Equal(t, "50", resource.GetTags()["volume_size"])
+		assert.Equal(t, "gp3", resource.GetTags()["volume_type"])
+		assert.Equal(t, "50", resource.GetTags()["volumeSize"])
+		assert.Equal(t, "map[volumeSize:100 volumeType:gp3]", resource.GetTags()["ebsOptions"])
+		assert.Equal(t, "us-east-1", resource.GetRegion())
+		assert.Equal(t, providerAWS, resource.GetProvider())
+		assert.Nil(t, dt.Metadata())
+	})
+
+	t.Run("DynamoDB billing mode and capacity", func(t *testing.T) {
+		attrs := newAttrs(t, map[string]any{
+			"billingMode":   "PROVISIONED",
+			"readCapacity":  float64(5),
+			"writeCapacity": float64(10),
+		})
+		resource, _ := resourceFromAttributes("aws:dynamodb/table:Table", "us-east-1", serviceDynamoDB, attrs)
+		assert.Equal(t, "provisioned", resource.GetSku())
+		assert.Equal(t, "5", resource.GetTags()["read_capacity_units"])
+		assert.Equal(t, "10", resource.GetTags()["write_capacity_units"])
+	})
+
+	t.Run("Lambda architecture, ephemeral storage and SnapStart", func(t *testing.T) {
+		attrs := newAttrs(t, map[string]any{
+			"memorySize":       float64(1024),
+			"architectures":    []any{"arm64"},
+			"ephemeralStorage": map[string]any{"size": float64(2048)},
+			"snapStart":        map[string]any{"applyOn": "PublishedVersions"},
+		})
+		resource, _ := resourceFromAttributes("aws:lambda/function:Function", "us-east-1", serviceLambda, attrs)
+		assert.Equal(t, "1024", resource.GetSku())
+		assert.Equal(t, "arm64", resource.GetTags()["arch"])
+		assert.Equal(t, "2048", resource.GetTags()["ephemeral_storage_mb"])
+		assert.Equal(t, "true", resource.GetTags()["snapstart"])
+	})
+
+	t.Run("EBS volume type default is tracked", func(t *testing.T) {
+		resource, dt := resourceFromAttributes("aws:ebs/volume:Volume", "us-east-1", serviceEBS, newAttrs(t, nil))
+		assert.Equal(t, defaultRootVolumeType, resource.GetSku())
+		assert.Equal(t, "type=gp2", dt.Metadata()[metadataKeyDefaultsApplied])
+	})
+
+	t.Run("EC2 root block device list form", func(t *testing.T) {
+		attrs := newAttrs(t, map[string]any{
+			"instanceType":    "t3.micro",
+			"rootBlockDevice": []any{map[string]any{"volumeSize": float64(20)}},
+		})
+		resource, _ := resourceFromAttributes("aws:ec2/instance:Instance", "us-east-1", serviceEC2, attrs)
+		assert.Equal(t, "t3.micro", resource.GetSku())
+		assert.Equal(t, "map[volumeSize:20]", resource.GetTags()["rootBlockDevice"])
+	})
+}
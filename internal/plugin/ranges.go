@@ -0,0 +1,254 @@
+package plugin
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// Metadata keys for the cost range of usage-driven estimates.
+const (
+	// metadataKeyCostRangeLow, metadataKeyCostRangeExpected and
+	// metadataKeyCostRangeHigh are the monthly cost at the low, expected and
+	// high usage levels. cost_per_month is unchanged and still prices only the
+	// usage given in the tags.
+	metadataKeyCostRangeLow      = "cost_range_low"
+	metadataKeyCostRangeExpected = "cost_range_expected"
+	metadataKeyCostRangeHigh     = "cost_range_high"
+
+	// metadataKeyCostRangeBasis lists the usage levels the range was priced
+	// with as comma-separated "tag=low/expected/high" entries.
+	metadataKeyCostRangeBasis = "cost_range_basis"
+)
+
+// Suffixes of the tags supplying a user distribution for a usage tag, e.g.
+// "requests_per_month_low" and "requests_per_month_high".
+const (
+	rangeTagLowSuffix  = "_low"
+	rangeTagHighSuffix = "_high"
+)
+
+// usagePercentiles is the distribution of one usage tag across typical
+// resources: roughly the 10th, 50th and 90th percentile of monthly usage.
+type usagePercentiles struct {
+	tag                 string
+	low, expected, high float64
+}
+
+// usageLevel is one of the three points of a cost range.
+type usageLevel int
+
+const (
+	usageLow usageLevel = iota
+	usageExpected
+	usageHigh
+)
+
+// at returns the usage at the given level.
+func (u usagePercentiles) at(level usageLevel) float64 {
+	switch level {
+	case usageLow:
+		return u.low
+	case usageHigh:
+		return u.high
+	default:
+		return u.expected
+	}
+}
+
+// basis formats the distribution as "tag=low/expected/high".
+func (u usagePercentiles) basis() string {
+	return fmt.Sprintf("%s=%s/%s/%s", u.tag, formatUsage(u.low), formatUsage(u.expected), formatUsage(u.high))
+}
+
+// formatUsage formats a usage value as a tag value.
+func formatUsage(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+// Default usage profiles of the usage-driven services. They apply only to
+// usage tags the estimator defaulted; tags set on the resource are used as is
+// unless a "<tag>_low"/"<tag>_high" distribution is also given.
+var (
+	lambdaUsageProfile = []usagePercentiles{
+		{tag: "requests_per_month", low: 10_000, expected: 1_000_000, high: 20_000_000},
+	}
+	dynamoDBUsageProfile = []usagePercentiles{
+		{tag: "read_requests_per_month", low: 100_000, expected: 5_000_000, high: 100_000_000},
+		{tag: "write_requests_per_month", low: 50_000, expected: 1_000_000, high: 20_000_000},
+		{tag: "read_capacity_units", low: 5, expected: 25, high: 200},
+		{tag: "write_capacity_units", low: 5, expected: 25, high: 200},
+		{tag: "storage_gb", low: 1, expected: 10, high: 100},
+	}
+	elbUsageProfile = []usagePercentiles{
+		{tag: "capacity_units", low: 0.5, expected: 2, high: 10},
+	}
+	natGatewayUsageProfile = []usagePercentiles{
+		{tag: "data_processed_gb", low: 10, expected: 100, high: 1000},
+	}
+	cloudWatchUsageProfile = []usagePercentiles{
+		{tag: "log_ingestion_gb", low: 1, expected: 10, high: 100},
+		{tag: "log_storage_gb", low: 5, expected: 50, high: 500},
+		{tag: "custom_metrics", low: 1, expected: 10, high: 100},
+	}
+	apiCallsUsageProfile = []usagePercentiles{
+		{tag: "api_calls_per_month", low: 1_000, expected: 100_000, high: 10_000_000},
+	}
+	stepFunctionsUsageProfile = []usagePercentiles{
+		{tag: "state_transitions_per_month", low: 10_000, expected: 1_000_000, high: 50_000_000},
+		{tag: "requests_per_month", low: 100_000, expected: 1_000_000, high: 100_000_000},
+	}
+	eventBridgeUsageProfile = []usagePercentiles{
+		{tag: "events_per_month", low: 100_000, expected: 1_000_000, high: 100_000_000},
+		{tag: "requests_per_month", low: 100_000, expected: 1_000_000, high: 100_000_000},
+	}
+)
+
+// rangeDistributions returns the usage distributions a cost range is priced
+// with: the profile of each usage tag the estimator defaulted, and the
+// "<tag>_low"/"<tag>_high" distribution of each usage tag the resource sets.
+// A user distribution missing one bound uses the tag value for it.
+func rangeDistributions(
+	profile []usagePercentiles,
+	tags map[string]string,
+	defaulted map[string]bool,
+) ([]usagePercentiles, error) {
+	var out []usagePercentiles
+	for _, dist := range profile {
+		lowVal, hasLow := tags[dist.tag+rangeTagLowSuffix]
+		highVal, hasHigh := tags[dist.tag+rangeTagHighSuffix]
+		if !hasLow && !hasHigh {
+			if defaulted[dist.tag] {
+				out = append(out, dist)
+			}
+			continue
+		}
+
+		user := usagePercentiles{tag: dist.tag, expected: dist.expected}
+		if val, ok := tags[dist.tag]; ok {
+			expected, err := parseUsageBound(dist.tag, val)
+			if err != nil {
+				return nil, err
+			}
+			user.expected = expected
+		}
+		user.low, user.high = user.expected, user.expected
+		var err error
+		if hasLow {
+			if user.low, err = parseUsageBound(dist.tag+rangeTagLowSuffix, lowVal); err != nil {
+				return nil, err
+			}
+		}
+		if hasHigh {
+			if user.high, err = parseUsageBound(dist.tag+rangeTagHighSuffix, highVal); err != nil {
+				return nil, err
+			}
+		}
+		if user.low > user.expected || user.expected > user.high {
+			return nil, fmt.Errorf("invalid usage range for '%s': %s must satisfy low <= expected <= high",
+				dist.tag, strings.TrimPrefix(user.basis(), dist.tag+"="))
+		}
+		out = append(out, user)
+	}
+	return out, nil
+}
+
+// parseUsageBound parses a non-negative usage tag value.
+func parseUsageBound(tag, val string) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(val), 64)
+	if err != nil || math.IsNaN(v) || math.IsInf(v, 0) || v < 0 {
+		return 0, fmt.Errorf("invalid value for '%s': %q must be a non-negative number", tag, val)
+	}
+	return v, nil
+}
+
+// defaultedFields returns the field names listed in a response's defaults_applied metadata.
+func defaultedFields(md map[string]string) map[string]bool {
+	applied := md[metadataKeyDefaultsApplied]
+	if applied == "" {
+		return nil
+	}
+	fields := make(map[string]bool)
+	for pair := range strings.SplitSeq(applied, ",") {
+		name, _, _ := strings.Cut(pair, "=")
+		fields[name] = true
+	}
+	return fields
+}
+
+// attachCostRange prices the resource at the low, expected and high usage of
+// its uncertain usage tags and records the range in the response metadata, so
+// a defaulted usage-driven resource reports "$40-$310/mo" rather than a single
+// (often $0) figure. Nothing is recorded when the usage is fully specified.
+// Invalid distribution tags are reported as InvalidArgument errors.
+func (e *funcEstimator) attachCostRange(
+	p *AWSPublicPlugin,
+	settings *pluginSettings,
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
+	resp *pbc.GetProjectedCostResponse,
+) error {
+	if len(e.usage) == 0 || resp == nil {
+		return nil
+	}
+	dists, err := rangeDistributions(e.usage, resource.GetTags(), defaultedFields(resp.GetMetadata()))
+	if err != nil {
+		return p.newErrorWithID(traceID, codes.InvalidArgument, err.Error(),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	if len(dists) == 0 {
+		return nil
+	}
+
+	var costs [3]float64
+	for _, level := range []usageLevel{usageLow, usageExpected, usageHigh} {
+		priced, ok := proto.Clone(resource).(*pbc.ResourceDescriptor)
+		if !ok {
+			return nil
+		}
+		tags := make(map[string]string, len(priced.GetTags())+len(dists))
+		for k, v := range priced.GetTags() {
+			tags[k] = v
+		}
+		for _, dist := range dists {
+			tags[dist.tag] = formatUsage(dist.at(level))
+		}
+		priced.Tags = tags
+
+		levelResp, levelErr := e.projected(p, settings, traceID, priced, req)
+		if levelErr != nil {
+			p.traceLogger(traceID, "GetProjectedCost").Debug().
+				Err(levelErr).
+				Msg("unable to price cost range")
+			return nil
+		}
+		costs[level] = levelResp.GetCostPerMonth()
+	}
+	if costs[usageLow] == costs[usageHigh] {
+		return nil
+	}
+
+	basis := make([]string, 0, len(dists))
+	for _, dist := range dists {
+		basis = append(basis, dist.basis())
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 4)
+	}
+	resp.Metadata[metadataKeyCostRangeLow] = formatMonthlyCost(costs[usageLow])
+	resp.Metadata[metadataKeyCostRangeExpected] = formatMonthlyCost(costs[usageExpected])
+	resp.Metadata[metadataKeyCostRangeHigh] = formatMonthlyCost(costs[usageHigh])
+	resp.Metadata[metadataKeyCostRangeBasis] = strings.Join(basis, ",")
+	return nil
+}
+
+// formatMonthlyCost formats a monthly cost rounded to the cent.
+func formatMonthlyCost(cost float64) string {
+	return strconv.FormatFloat(math.Round(cost*100)/100, 'f', 2, 64)
+}
@@ -0,0 +1,196 @@
+package plugin
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// TestRangeDistributions verifies which usage tags a cost range is priced over.
+func TestRangeDistributions(t *testing.T) {
+	profile := []usagePercentiles{
+		{tag: "requests_per_month", low: 10, expected: 100, high: 1000},
+		{tag: "storage_gb", low: 1, expected: 10, high: 100},
+	}
+
+	tests := []struct {
+		name      string
+		tags      map[string]string
+		defaulted map[string]bool
+		want      []usagePercentiles
+		wantErr   string
+	}{
+		{
+			name:      "defaulted tags use the profile",
+			defaulted: map[string]bool{"requests_per_month": true},
+			want:      profile[:1],
+		},
+		{
+			name: "explicit tags have no range",
+			tags: map[string]string{"requests_per_month": "50", "storage_gb": "5"},
+		},
+		{
+			name: "user distribution",
+			tags: map[string]string{"requests_per_month": "50", "requests_per_month_low": "20", "requests_per_month_high": "500"},
+			want: []usagePercentiles{{tag: "requests_per_month", low: 20, expected: 50, high: 500}},
+		},
+		{
+			name: "missing bound uses the tag value",
+			tags: map[string]string{"storage_gb": "5", "storage_gb_high": "50"},
+			want: []usagePercentiles{{tag: "storage_gb", low: 5, expected: 5, high: 50}},
+		},
+		{
+			name:      "bounds without a tag value use the profile expectation",
+			tags:      map[string]string{"storage_gb_low": "2", "storage_gb_high": "20"},
+			defaulted: map[string]bool{"storage_gb": true},
+			want:      []usagePercentiles{{tag: "storage_gb", low: 2, expected: 10, high: 20}},
+		},
+		{
+			name:    "bounds out of order",
+			tags:    map[string]string{"storage_gb": "5", "storage_gb_high": "1"},
+			wantErr: "low <= expected <= high",
+		},
+		{
+			name:    "invalid bound",
+			tags:    map[string]string{"storage_gb_low": "-1"},
+			wantErr: "storage_gb_low",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := rangeDistributions(profile, tt.tags, tt.defaulted)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+// TestGetProjectedCost_CostRange verifies usage-driven estimates report the
+// cost at the low, expected and high usage alongside the point estimate.
+func TestGetProjectedCost_CostRange(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	lambda := func(tags map[string]string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:lambda/function:Function",
+			Sku:          "x86_64",
+			Region:       "us-east-1",
+			Tags:         tags,
+		}
+	}
+	costAt := func(t *testing.T, requests float64) string {
+		t.Helper()
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource: lambda(map[string]string{"requests_per_month": strconv.FormatFloat(requests, 'f', -1, 64)}),
+		})
+		require.NoError(t, err)
+		return formatMonthlyCost(resp.GetCostPerMonth())
+	}
+
+	t.Run("defaulted usage", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: lambda(nil)})
+		require.NoError(t, err)
+
+		md := resp.GetMetadata()
+		profile := lambdaUsageProfile[0]
+		assert.Equal(t, costAt(t, profile.low), md[metadataKeyCostRangeLow])
+		assert.Equal(t, costAt(t, profile.expected), md[metadataKeyCostRangeExpected])
+		assert.Equal(t, costAt(t, profile.high), md[metadataKeyCostRangeHigh])
+		assert.Equal(t, "requests_per_month=10000/1000000/20000000", md[metadataKeyCostRangeBasis])
+		assert.Equal(t, qualityLow, md[metadataKeyEstimateQuality])
+		assert.Zero(t, resp.GetCostPerMonth(), "the point estimate still prices zero requests")
+	})
+
+	t.Run("user distribution", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: lambda(map[string]string{
+			"requests_per_month":      "2000000",
+			"requests_per_month_low":  "500000",
+			"requests_per_month_high": "8000000",
+		})})
+		require.NoError(t, err)
+
+		md := resp.GetMetadata()
+		assert.Equal(t, costAt(t, 500_000), md[metadataKeyCostRangeLow])
+		assert.Equal(t, costAt(t, 2_000_000), md[metadataKeyCostRangeExpected])
+		assert.Equal(t, formatMonthlyCost(resp.GetCostPerMonth()), md[metadataKeyCostRangeExpected])
+		assert.Equal(t, costAt(t, 8_000_000), md[metadataKeyCostRangeHigh])
+	})
+
+	t.Run("fully specified usage has no range", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource: lambda(map[string]string{"requests_per_month": "1000000"}),
+		})
+		require.NoError(t, err)
+		assert.NotContains(t, resp.GetMetadata(), metadataKeyCostRangeLow)
+	})
+
+	t.Run("only defaulted tags of the capacity mode", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:dynamodb/table:Table",
+			Sku:          "on-demand",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"storage_gb": "20"},
+		}})
+		require.NoError(t, err)
+		assert.Equal(t,
+			"read_requests_per_month=100000/5000000/100000000,write_requests_per_month=50000/1000000/20000000",
+			resp.GetMetadata()[metadataKeyCostRangeBasis])
+	})
+
+	t.Run("time-billed resources have no range", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: &pbc.ResourceDescriptor{
+			Provider:     providerAWS,
+			ResourceType: "aws:ec2/instance:Instance",
+			Sku:          "t3.micro",
+			Region:       "us-east-1",
+		}})
+		require.NoError(t, err)
+		assert.NotContains(t, resp.GetMetadata(), metadataKeyCostRangeLow)
+	})
+
+	t.Run("invalid distribution", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: lambda(map[string]string{
+			"requests_per_month":      "1000",
+			"requests_per_month_high": "10",
+		})})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestEstimateCost_CostRange verifies EstimateCost returns the cost range as
+// response header metadata.
+func TestEstimateCost_CostRange(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	attrs, err := structpb.NewStruct(map[string]any{"memorySize": float64(128)})
+	require.NoError(t, err)
+
+	stream := &headerCaptureStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	_, err = plugin.EstimateCost(ctx, &pbc.EstimateCostRequest{
+		ResourceType: "aws:lambda/function:Function",
+		Attributes:   attrs,
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"requests_per_month=10000/1000000/20000000"},
+		stream.header.Get(metadataKeyCostRangeBasis))
+	assert.Len(t, stream.header.Get(metadataKeyCostRangeHigh), 1)
+}
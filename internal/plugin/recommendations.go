@@ -88,9 +88,10 @@ func (p *AWSPublicPlugin) GetRecommendations( //nolint:gocognit,funlen
 	}
 
 	// Validate batch size (max 100 resources per request)
-	if len(req.GetTargetResources()) > p.maxBatchSize {
+	settings := p.config()
+	if len(req.GetTargetResources()) > settings.maxBatchSize {
 		err := p.newErrorWithID(traceID, codes.InvalidArgument,
-			fmt.Sprintf("batch size %d exceeds maximum of %d", len(req.GetTargetResources()), p.maxBatchSize),
+			fmt.Sprintf("batch size %d exceeds maximum of %d", len(req.GetTargetResources()), settings.maxBatchSize),
 			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 		p.logErrorWithID(traceID, "GetRecommendations", err, pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
 		return nil, err
@@ -112,7 +113,7 @@ func (p *AWSPublicPlugin) GetRecommendations( //nolint:gocognit,funlen
 				Str("resource_type", resource.GetResourceType()).
 				Str("reason", "non-AWS provider").
 				Msg("skipping resource in recommendations batch")
-			if p.strictValidation {
+			if settings.strictValidation {
 				err := p.newErrorWithID(traceID, codes.InvalidArgument,
 					fmt.Sprintf("strict validation: unsupported provider %q (only %q supported)",
 						resource.GetProvider(), providerAWS),
@@ -147,16 +148,12 @@ func (p *AWSPublicPlugin) GetRecommendations( //nolint:gocognit,funlen
 		resolver := newServiceResolver(resource.GetResourceType())
 		service := resolver.ServiceType()
 		var recs []*pbc.Recommendation
+		supported := false
+		if estimator, ok := lookupEstimator(service); ok {
+			recs, supported = estimator.Recommendations(p, resource, region)
+		}
 
-		switch service {
-		case serviceEC2:
-			recs = p.generateEC2Recommendations(resource.GetSku(), region)
-		case serviceEBS:
-			recs = p.getEBSRecommendations(resource.GetSku(), region, resource.GetTags())
-		case serviceRDS:
-			engine := extractRDSEngine(resource.GetTags())
-			recs = p.generateRDSRecommendations(resource.GetSku(), engine, region)
-		default:
+		if !supported {
 			// Log unsupported service types at debug level
 			p.logger.Debug().
 				Str("trace_id", traceID).
@@ -164,7 +161,7 @@ func (p *AWSPublicPlugin) GetRecommendations( //nolint:gocognit,funlen
 				Str("detected_service", service).
 				Str("reason", "unsupported service for recommendations").
 				Msg("no recommendations generated for resource")
-			if p.strictValidation {
+			if settings.strictValidation {
 				err := p.newErrorWithID(traceID, codes.InvalidArgument,
 					fmt.Sprintf("strict validation: service %q does not support recommendations (resource_type: %s)",
 						service, resource.GetResourceType()),
@@ -745,6 +742,372 @@ func (p *AWSPublicPlugin) getRDSGravitonRecommendation(
 	}
 }
 
+// generateRedshiftRecommendations creates recommendations for a provisioned Redshift cluster.
+// Returns at most one recommendation: migrating previous-generation DC2 nodes to RA3.
+func (p *AWSPublicPlugin) generateRedshiftRecommendations(
+	nodeType string,
+	tags map[string]string,
+	region string,
+) []*pbc.Recommendation {
+	var recommendations []*pbc.Recommendation
+
+	if rec := p.getRedshiftGenerationUpgradeRecommendation(nodeType, tags, region); rec != nil {
+		recommendations = append(recommendations, rec)
+	}
+
+	return recommendations
+}
+
+// getRedshiftGenerationUpgradeRecommendation returns a recommendation to migrate a
+// DC2 cluster to RA3. DC2 is a previous-generation node type, so the recommendation
+// is made even when RA3 costs more; in that case it is categorized as a performance
+// recommendation with no estimated savings.
+func (p *AWSPublicPlugin) getRedshiftGenerationUpgradeRecommendation(
+	nodeType string,
+	tags map[string]string,
+	region string,
+) *pbc.Recommendation {
+	nodeType = strings.ToLower(nodeType)
+	newType, exists := redshiftGenerationUpgradeMap[nodeType]
+	if !exists {
+		return nil
+	}
+
+	currentPrice, found := p.pricing.RedshiftNodePricePerHour(nodeType)
+	if !found {
+		return nil
+	}
+
+	newPrice, found := p.pricing.RedshiftNodePricePerHour(newType)
+	if !found {
+		return nil
+	}
+
+	numNodes := 1
+	if parsed, err := strconv.Atoi(tags["number_of_nodes"]); err == nil && parsed > 0 {
+		numNodes = parsed
+	}
+	nodes := strconv.Itoa(numNodes)
+
+	currentMonthly := currentPrice * float64(numNodes) * carbon.HoursPerMonth
+	newMonthly := newPrice * float64(numNodes) * carbon.HoursPerMonth
+	savings := currentMonthly - newMonthly
+	savingsPercent := 0.0
+	if currentMonthly > 0 {
+		savingsPercent = (savings / currentMonthly) * 100
+	}
+
+	reasoning := []string{
+		fmt.Sprintf("%s is a previous-generation node type; RA3 is the current generation", nodeType),
+		"RA3 scales compute independently of storage; Redshift Managed Storage is billed per GB-month",
+	}
+
+	category := pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST
+	priority := pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM
+	confidence := confidenceHigh
+	description := fmt.Sprintf("Migrate Redshift cluster from %s to %s for better performance at lower cost",
+		nodeType, newType)
+	if savings < 0 {
+		category = pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_PERFORMANCE
+		priority = pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW
+		confidence = confidenceMedium
+		description = fmt.Sprintf("Migrate Redshift cluster from %s to %s (current generation)", nodeType, newType)
+		reasoning = append(reasoning,
+			fmt.Sprintf("Node cost increases by $%.2f/month; validate with elastic resize sizing guidance", -savings))
+		savings = 0
+		savingsPercent = 0
+	}
+
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   category,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: serviceRedshift,
+			Region:       region,
+			Sku:          nodeType,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modTypeGenUpgrade,
+				CurrentConfig:     map[string]string{"node_type": nodeType, "number_of_nodes": nodes},
+				RecommendedConfig: map[string]string{"node_type": newType, "number_of_nodes": nodes},
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  savings,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     newMonthly,
+			SavingsPercentage: savingsPercent,
+		},
+		Priority:        priority,
+		ConfidenceScore: &confidence,
+		Description:     description,
+		Reasoning:       reasoning,
+		Source:          sourceAWSPublic,
+	}
+}
+
+// generateClusterDatabaseRecommendations creates recommendations for a DocumentDB,
+// Neptune or MemoryDB cluster. Like generateRDSRecommendations it returns up to 2
+// recommendations: a generation upgrade and/or a Graviton migration, each only when
+// the target class costs the same or less. Costs are scaled by the instance (or
+// node) count.
+func (p *AWSPublicPlugin) generateClusterDatabaseRecommendations(
+	service, instanceClass string,
+	tags map[string]string,
+	region string,
+) []*pbc.Recommendation {
+	instanceClass = strings.ToLower(instanceClass)
+	family, size := parseRDSInstanceType(instanceClass)
+	if family == "" {
+		return nil
+	}
+
+	count := clusterDatabaseInstanceCount(service, tags)
+	var recommendations []*pbc.Recommendation
+
+	if newFamily, exists := clusterDatabaseGenerationUpgradeMap[family]; exists {
+		if rec := p.getClusterDatabaseModifyRecommendation(
+			service, instanceClass, newFamily+"."+size, modTypeGenUpgrade, count, region,
+		); rec != nil {
+			recommendations = append(recommendations, rec)
+		}
+	}
+
+	if gravitonFamily, exists := clusterDatabaseGravitonMap[family]; exists {
+		if rec := p.getClusterDatabaseModifyRecommendation(
+			service, instanceClass, gravitonFamily+"."+size, modTypeGraviton, count, region,
+		); rec != nil {
+			recommendations = append(recommendations, rec)
+		}
+	}
+
+	return recommendations
+}
+
+// clusterDatabaseInstanceCount returns the number of billed instances for a cluster
+// database: "instance_count" for DocumentDB and Neptune, and
+// num_shards × (1 + num_replicas_per_shard) for MemoryDB. Missing or invalid tags
+// fall back to the estimator defaults.
+func clusterDatabaseInstanceCount(service string, tags map[string]string) int {
+	nonNegativeInt := func(key string, fallback int) int {
+		if v, err := strconv.Atoi(tags[key]); err == nil && v >= 0 {
+			return v
+		}
+		return fallback
+	}
+
+	if service == serviceMemoryDB {
+		shards := max(nonNegativeInt("num_shards", 1), 1)
+		replicas := min(nonNegativeInt("num_replicas_per_shard", defaultMemoryDBReplicasPerShard),
+			maxMemoryDBReplicasPerShard)
+		return shards * (1 + replicas)
+	}
+	return max(nonNegativeInt("instance_count", 1), 1)
+}
+
+// getClusterDatabaseModifyRecommendation returns a generation upgrade or Graviton
+// migration recommendation from currentClass to newClass for a DocumentDB, Neptune
+// or MemoryDB cluster, or nil when the target is not priced or costs more.
+func (p *AWSPublicPlugin) getClusterDatabaseModifyRecommendation(
+	service, currentClass, newClass, modificationType string,
+	count int,
+	region string,
+) *pbc.Recommendation {
+	currentPrice, found := p.clusterDatabaseInstancePrice(service, currentClass)
+	if !found {
+		return nil
+	}
+	newPrice, found := p.clusterDatabaseInstancePrice(service, newClass)
+	if !found || newPrice > currentPrice {
+		return nil
+	}
+
+	label := clusterDatabaseLabels[service]
+	currentMonthly := currentPrice * float64(count) * carbon.HoursPerMonth
+	newMonthly := newPrice * float64(count) * carbon.HoursPerMonth
+	savings := currentMonthly - newMonthly
+	savingsPercent := 0.0
+	if currentMonthly > 0 {
+		savingsPercent = (savings / currentMonthly) * 100
+	}
+
+	classKey, countKey := "instance_class", "instance_count"
+	if service == serviceMemoryDB {
+		classKey, countKey = "node_type", "num_nodes"
+	}
+	countStr := strconv.Itoa(count)
+	currentConfig := map[string]string{classKey: currentClass, countKey: countStr}
+	recommendedConfig := map[string]string{classKey: newClass, countKey: countStr}
+
+	rec := &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: service,
+			Region:       region,
+			Sku:          currentClass,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType:  modificationType,
+				CurrentConfig:     currentConfig,
+				RecommendedConfig: recommendedConfig,
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  savings,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     newMonthly,
+			SavingsPercentage: savingsPercent,
+		},
+		Source: sourceAWSPublic,
+	}
+
+	if modificationType == modTypeGraviton {
+		confidence := confidenceMedium
+		currentConfig["architecture"] = archX86
+		recommendedConfig["architecture"] = archARM64
+		rec.Priority = pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW
+		rec.ConfidenceScore = &confidence
+		rec.Description = fmt.Sprintf("Migrate %s from %s to %s (Graviton) for ~%.0f%% cost savings",
+			label, currentClass, newClass, savingsPercent)
+		rec.Reasoning = []string{
+			fmt.Sprintf("Graviton %s instances are typically cheaper with comparable performance", label),
+			fmt.Sprintf("%s supports Graviton for all engine versions", label),
+		}
+		rec.Metadata = map[string]string{"architecture_change": "x86_64 -> arm64"}
+		return rec
+	}
+
+	confidence := confidenceHigh
+	rec.Priority = pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_MEDIUM
+	rec.ConfidenceScore = &confidence
+	rec.Description = fmt.Sprintf("Upgrade %s from %s to %s for better performance at same or lower cost",
+		label, currentClass, newClass)
+	rec.Reasoning = []string{
+		fmt.Sprintf("Newer %s instances offer better performance", newClass),
+		"Instance class change applied with a rolling modify; no data migration required",
+	}
+	return rec
+}
+
+// generateMSKRecommendations creates recommendations for a provisioned MSK cluster.
+// Returns at most one recommendation: migrating x86 brokers to Graviton. MSK
+// Serverless clusters have no broker type and get no recommendations.
+func (p *AWSPublicPlugin) generateMSKRecommendations(
+	instanceType string,
+	tags map[string]string,
+	region string,
+) []*pbc.Recommendation {
+	var recommendations []*pbc.Recommendation
+
+	if rec := p.getMSKGravitonRecommendation(instanceType, tags, region); rec != nil {
+		recommendations = append(recommendations, rec)
+	}
+
+	return recommendations
+}
+
+// getMSKGravitonRecommendation returns a recommendation to migrate MSK brokers to
+// Graviton (kafka.m7g) if available and cost-effective. Costs are scaled by the
+// "number_of_broker_nodes" tag (default: 3).
+func (p *AWSPublicPlugin) getMSKGravitonRecommendation(
+	instanceType string,
+	tags map[string]string,
+	region string,
+) *pbc.Recommendation {
+	instanceType = strings.ToLower(instanceType)
+	family, size := parseMSKBrokerType(instanceType)
+	if family == "" {
+		return nil
+	}
+
+	gravitonFamily, exists := mskGravitonMap[family]
+	if !exists {
+		return nil
+	}
+	gravitonType := gravitonFamily + "." + size
+
+	currentPrice, found := p.pricing.MSKBrokerPricePerHour(instanceType)
+	if !found {
+		return nil
+	}
+	gravitonPrice, found := p.pricing.MSKBrokerPricePerHour(gravitonType)
+	// Only recommend when new price <= current price
+	if !found || gravitonPrice > currentPrice {
+		return nil
+	}
+
+	brokers := defaultMSKBrokerNodes
+	if parsed, err := strconv.Atoi(tags["number_of_broker_nodes"]); err == nil && parsed > 0 {
+		brokers = parsed
+	}
+	brokerCount := strconv.Itoa(brokers)
+
+	currentMonthly := currentPrice * float64(brokers) * carbon.HoursPerMonth
+	gravitonMonthly := gravitonPrice * float64(brokers) * carbon.HoursPerMonth
+	savings := currentMonthly - gravitonMonthly
+	savingsPercent := 0.0
+	if currentMonthly > 0 {
+		savingsPercent = (savings / currentMonthly) * 100
+	}
+
+	confidence := confidenceMedium
+	return &pbc.Recommendation{
+		Id:         uuid.New().String(),
+		Category:   pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+		ActionType: pbc.RecommendationActionType_RECOMMENDATION_ACTION_TYPE_MODIFY,
+		Resource: &pbc.ResourceRecommendationInfo{
+			Provider:     providerAWS,
+			ResourceType: serviceMSK,
+			Region:       region,
+			Sku:          instanceType,
+		},
+		ActionDetail: &pbc.Recommendation_Modify{
+			Modify: &pbc.ModifyAction{
+				ModificationType: modTypeGraviton,
+				CurrentConfig: map[string]string{
+					"instance_type":          instanceType,
+					"number_of_broker_nodes": brokerCount,
+					"architecture":           archX86,
+				},
+				RecommendedConfig: map[string]string{
+					"instance_type":          gravitonType,
+					"number_of_broker_nodes": brokerCount,
+					"architecture":           archARM64,
+				},
+			},
+		},
+		Impact: &pbc.RecommendationImpact{
+			EstimatedSavings:  savings,
+			Currency:          "USD",
+			ProjectionPeriod:  "monthly",
+			CurrentCost:       currentMonthly,
+			ProjectedCost:     gravitonMonthly,
+			SavingsPercentage: savingsPercent,
+		},
+		Priority:        pbc.RecommendationPriority_RECOMMENDATION_PRIORITY_LOW,
+		ConfidenceScore: &confidence,
+		Description: fmt.Sprintf("Migrate MSK brokers from %s to %s (Graviton) for ~%.0f%% cost savings",
+			instanceType, gravitonType, savingsPercent),
+		Reasoning: []string{
+			"Graviton3 (M7g) brokers offer higher throughput per broker at a lower hourly rate",
+			"Broker type change is applied as a rolling update; Kafka clients are unaffected",
+		},
+		Metadata: map[string]string{"architecture_change": "x86_64 -> arm64"},
+		Source:   sourceAWSPublic,
+	}
+}
+
 // matchesFilter checks if a resource matches the given filter criteria.
 // Implements FR-005 (AND operation).
 func (p *AWSPublicPlugin) matchesFilter(resource *pbc.ResourceDescriptor, filter *pbc.RecommendationFilter) bool {
@@ -0,0 +1,258 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_Redshift verifies node × count × 730h pricing and RA3 managed storage.
+func TestGetProjectedCost_Redshift(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+	}{
+		{
+			name:         "single dc2 node (defaults)",
+			sku:          "dc2.large",
+			tags:         nil,
+			wantCost:     0.25 * 730,
+			wantDefaults: "number_of_nodes=1",
+		},
+		{
+			name:         "dc2 cluster ignores managed storage",
+			sku:          "dc2.large",
+			tags:         map[string]string{"number_of_nodes": "4", "managed_storage_gb": "500"},
+			wantCost:     0.25 * 4 * 730,
+			wantDefaults: "",
+		},
+		{
+			name:         "ra3 cluster with managed storage",
+			sku:          "ra3.4xlarge",
+			tags:         map[string]string{"number_of_nodes": "2", "managed_storage_gb": "1000"},
+			wantCost:     3.26*2*730 + 1000*0.024,
+			wantDefaults: "",
+		},
+		{
+			name:         "ra3 cluster without storage hint",
+			sku:          "ra3.4xlarge",
+			tags:         map[string]string{"number_of_nodes": "2"},
+			wantCost:     3.26 * 2 * 730,
+			wantDefaults: "managed_storage_gb=0",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:redshift/cluster:Cluster",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), "Redshift "+tt.sku)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestGetProjectedCost_RedshiftServerless verifies RPU × hours-per-day pricing.
+func TestGetProjectedCost_RedshiftServerless(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		tags         map[string]string
+		wantCost     float64
+		wantDefaults string
+	}{
+		{
+			name:         "no usage hint",
+			tags:         nil,
+			wantCost:     0,
+			wantDefaults: "base_capacity=128,hours_per_day=0,managed_storage_gb=0",
+		},
+		{
+			name:         "8 RPUs for 4 hours a day plus storage",
+			tags:         map[string]string{"base_capacity": "8", "hours_per_day": "4", "managed_storage_gb": "100"},
+			wantCost:     8*4*(730.0/24)*0.375 + 100*0.024,
+			wantDefaults: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:redshiftserverless/workgroup:Workgroup",
+					Sku:          "serverless",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.InDelta(t, 0.375, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "Redshift Serverless")
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("hours_per_day above 24 is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:redshiftserverless/workgroup:Workgroup",
+				Sku:          "serverless",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"hours_per_day": "25"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestDetectService_Redshift verifies cluster and serverless workgroup types route to Redshift.
+func TestDetectService_Redshift(t *testing.T) {
+	for _, rt := range []string{
+		"aws:redshift/cluster:Cluster",
+		"aws:redshiftserverless/workgroup:Workgroup",
+		"redshift",
+	} {
+		t.Run(rt, func(t *testing.T) {
+			assert.Equal(t, serviceRedshift, detectService(normalizeResourceType(rt)))
+		})
+	}
+}
+
+// TestGetProjectedCost_RedshiftConfiguration verifies subnet groups, parameter
+// groups, snapshot schedules and serverless namespaces are not priced as clusters
+// or workgroups.
+func TestGetProjectedCost_RedshiftConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:redshift/subnetGroup:SubnetGroup",
+		"aws:redshift/parameterGroup:ParameterGroup",
+		"aws:redshift/snapshotSchedule:SnapshotSchedule",
+		"aws:redshiftserverless/namespace:Namespace",
+	)
+}
+
+// TestGetPricingSpec_Redshift verifies cluster and serverless pricing specs.
+func TestGetPricingSpec_Redshift(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+		wantUnit     string
+	}{
+		{"aws:redshift/cluster:Cluster", "ra3.4xlarge", 3.26, "hour"},
+		{"aws:redshiftserverless/workgroup:Workgroup", "serverless", 0.375, "rpu-hour"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
+
+// TestGetRecommendations_Redshift verifies DC2 clusters are steered to RA3, with
+// cost category when cheaper and performance category when RA3 costs more.
+func TestGetRecommendations_Redshift(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		sku          string
+		nodes        string
+		wantTarget   string
+		wantCategory pbc.RecommendationCategory
+		wantSavings  float64
+	}{
+		{
+			name:         "dc2.8xlarge to ra3.4xlarge saves money",
+			sku:          "dc2.8xlarge",
+			nodes:        "2",
+			wantTarget:   "ra3.4xlarge",
+			wantCategory: pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_COST,
+			wantSavings:  (4.80 - 3.26) * 2 * 730,
+		},
+		{
+			name:         "dc2.large to ra3.large costs more",
+			sku:          "dc2.large",
+			nodes:        "1",
+			wantTarget:   "ra3.large",
+			wantCategory: pbc.RecommendationCategory_RECOMMENDATION_CATEGORY_PERFORMANCE,
+			wantSavings:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{
+					{
+						Provider:     "aws",
+						ResourceType: "aws:redshift/cluster:Cluster",
+						Sku:          tt.sku,
+						Region:       "us-east-1",
+						Tags:         map[string]string{"number_of_nodes": tt.nodes},
+					},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.GetRecommendations(), 1)
+
+			rec := resp.GetRecommendations()[0]
+			assert.Equal(t, tt.wantCategory, rec.GetCategory())
+			assert.Equal(t, modTypeGenUpgrade, rec.GetModify().GetModificationType())
+			assert.Equal(t, tt.wantTarget, rec.GetModify().GetRecommendedConfig()["node_type"])
+			assert.Equal(t, tt.nodes, rec.GetModify().GetRecommendedConfig()["number_of_nodes"])
+			assert.InDelta(t, tt.wantSavings, rec.GetImpact().GetEstimatedSavings(), 1e-6)
+		})
+	}
+
+	t.Run("ra3 cluster gets no recommendation", func(t *testing.T) {
+		resp, err := plugin.GetRecommendations(context.Background(), &pbc.GetRecommendationsRequest{
+			TargetResources: []*pbc.ResourceDescriptor{
+				{
+					Provider:     "aws",
+					ResourceType: "aws:redshift/cluster:Cluster",
+					Sku:          "ra3.4xlarge",
+					Region:       "us-east-1",
+				},
+			},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetRecommendations())
+	})
+}
@@ -0,0 +1,588 @@
+package plugin
+
+import (
+	"slices"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+)
+
+// serviceEstimator is implemented by every service this plugin can price. Each RPC
+// resolves the canonical service type and dispatches through the registry, so
+// adding a service means adding one registration rather than editing every switch.
+type serviceEstimator interface {
+	// ProjectedCost estimates the monthly cost of the resource. settings is the
+	// snapshot loaded once for the request; req carries request-level options
+	// such as utilization and may wrap only the resource.
+	ProjectedCost(
+		p *AWSPublicPlugin,
+		settings *pluginSettings,
+		traceID string,
+		resource *pbc.ResourceDescriptor,
+		req *pbc.GetProjectedCostRequest,
+	) (*pbc.GetProjectedCostResponse, error)
+
+	// PricingSpec describes how the resource is billed without calculating cost.
+	PricingSpec(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor) *pbc.PricingSpec
+
+	// Recommendations returns cost optimization recommendations for the resource.
+	// The boolean is false when the service does not generate recommendations.
+	Recommendations(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) ([]*pbc.Recommendation, bool)
+
+	// SupportedMetrics lists the impact metrics reported by ProjectedCost.
+	SupportedMetrics() []pbc.MetricKind
+
+	// ServiceName is the AWS display name used for FOCUS ServiceName. Empty
+	// when the service has no AWS product name (zero-cost resources).
+	ServiceName() string
+
+	// Category is the FOCUS service category.
+	Category() pbc.FocusServiceCategory
+
+	// PricingUnit is the FOCUS pricing unit used when no specific unit is known.
+	PricingUnit() string
+
+	// OfferCodes are the AWS price list offers the service's rates come from,
+	// primary offer first. Empty for zero-cost resources.
+	OfferCodes() []string
+
+	// ResourcePatterns are lowercase substrings of legacy resource types that
+	// detectService maps to this service when normalization did not.
+	ResourcePatterns() []string
+}
+
+// projectedCostFunc is the signature shared by every registered estimator.
+type projectedCostFunc func(
+	p *AWSPublicPlugin,
+	settings *pluginSettings,
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
+) (*pbc.GetProjectedCostResponse, error)
+
+// recommendationsFunc generates recommendations for a resource in a region.
+type recommendationsFunc func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) []*pbc.Recommendation
+
+// funcEstimator implements serviceEstimator from plain functions, which lets the
+// existing estimateX and xPricingSpec methods register without wrapper types.
+type funcEstimator struct {
+	name            string
+	category        pbc.FocusServiceCategory
+	unit            string
+	offerCodes      []string
+	carbon          bool
+	patterns        []string
+	projected       projectedCostFunc
+	pricingSpec     func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor) *pbc.PricingSpec
+	recommendations recommendationsFunc
+	usage           []usagePercentiles // usage profile for cost ranges; nil for no range
+	growth          string             // storage size tag grown by cost forecasts; empty for no forecast
+}
+
+func (e *funcEstimator) ProjectedCost(
+	p *AWSPublicPlugin,
+	settings *pluginSettings,
+	traceID string,
+	resource *pbc.ResourceDescriptor,
+	req *pbc.GetProjectedCostRequest,
+) (*pbc.GetProjectedCostResponse, error) {
+	allocationTags := resource.GetTags()
+	resource, assumed := withUsageDefaults(resource, settings.usageDefaults[e])
+	req = withDefaultUtilization(req, settings.utilization)
+
+	resp, err := e.projected(p, settings, traceID, resource, req)
+	if err != nil {
+		return nil, err
+	}
+	ensureCostComponents(resp, e.unit, resource.GetSku())
+	if err := e.attachCostRange(p, settings, traceID, resource, req, resp); err != nil {
+		return nil, err
+	}
+	if err := e.attachForecast(p, settings, traceID, resource, req, resp); err != nil {
+		return nil, err
+	}
+	attachUsageAssumptions(resp, assumed)
+	p.attachPriceSources(resp, e.offerCodes)
+	p.attachPriceFreshness(settings, resp, e.offerCodes)
+	attachAllocationTags(settings, resp, allocationTags)
+	return resp, nil
+}
+
+func (e *funcEstimator) PricingSpec(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+	return e.pricingSpec(p, resource)
+}
+
+func (e *funcEstimator) Recommendations(
+	p *AWSPublicPlugin,
+	resource *pbc.ResourceDescriptor,
+	region string,
+) ([]*pbc.Recommendation, bool) {
+	if e.recommendations == nil {
+		return nil, false
+	}
+	return e.recommendations(p, resource, region), true
+}
+
+func (e *funcEstimator) SupportedMetrics() []pbc.MetricKind {
+	if !e.carbon {
+		return nil
+	}
+	return []pbc.MetricKind{pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT}
+}
+
+func (e *funcEstimator) ServiceName() string                { return e.name }
+func (e *funcEstimator) Category() pbc.FocusServiceCategory { return e.category }
+func (e *funcEstimator) PricingUnit() string                { return e.unit }
+func (e *funcEstimator) OfferCodes() []string               { return e.offerCodes }
+func (e *funcEstimator) ResourcePatterns() []string         { return e.patterns }
+
+// resourceOnly adapts estimators that need only the trace ID and resource.
+func resourceOnly(
+	estimate func(p *AWSPublicPlugin, traceID string, resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error),
+) projectedCostFunc {
+	return func(
+		p *AWSPublicPlugin,
+		_ *pluginSettings,
+		traceID string,
+		resource *pbc.ResourceDescriptor,
+		_ *pbc.GetProjectedCostRequest,
+	) (*pbc.GetProjectedCostResponse, error) {
+		return estimate(p, traceID, resource)
+	}
+}
+
+// skuRecommendations adapts recommendation generators keyed by SKU and tags.
+func skuRecommendations(
+	generate func(p *AWSPublicPlugin, sku string, tags map[string]string, region string) []*pbc.Recommendation,
+) recommendationsFunc {
+	return func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) []*pbc.Recommendation {
+		return generate(p, resource.GetSku(), resource.GetTags(), region)
+	}
+}
+
+// clusterDatabaseEstimator registers DocumentDB or Neptune, which share one
+// estimator parameterized by service type.
+func clusterDatabaseEstimator(service, name, offerCode string, patterns ...string) *funcEstimator {
+	return &funcEstimator{
+		name:       name,
+		category:   pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE,
+		unit:       "Hours",
+		offerCodes: []string{offerCode},
+		patterns:   patterns,
+		projected: func(
+			p *AWSPublicPlugin,
+			_ *pluginSettings,
+			traceID string,
+			resource *pbc.ResourceDescriptor,
+			_ *pbc.GetProjectedCostRequest,
+		) (*pbc.GetProjectedCostResponse, error) {
+			return p.estimateClusterDatabase(traceID, resource, service)
+		},
+		pricingSpec: func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+			return p.clusterDatabasePricingSpec(resource, service)
+		},
+		recommendations: clusterDatabaseRecommendations(service),
+	}
+}
+
+// clusterDatabaseRecommendations adapts the shared DocumentDB, Neptune and MemoryDB
+// recommendation generator for one service.
+func clusterDatabaseRecommendations(service string) recommendationsFunc {
+	return func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) []*pbc.Recommendation {
+		return p.generateClusterDatabaseRecommendations(
+			service, clusterDatabaseInstanceClass(resource), resource.GetTags(), region)
+	}
+}
+
+// zeroCostEstimator registers a networking, IAM or configuration-only resource
+// that has no direct AWS charges.
+func zeroCostEstimator(service string) *funcEstimator {
+	return &funcEstimator{
+		category: pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_OTHER,
+		unit:     "Units",
+		projected: func(
+			p *AWSPublicPlugin,
+			_ *pluginSettings,
+			traceID string,
+			resource *pbc.ResourceDescriptor,
+			_ *pbc.GetProjectedCostRequest,
+		) (*pbc.GetProjectedCostResponse, error) {
+			return p.estimateZeroCostResource(traceID, resource, service), nil
+		},
+		pricingSpec: func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor) *pbc.PricingSpec {
+			return p.zeroCostPricingSpec(resource, service)
+		},
+	}
+}
+
+// serviceRegistry maps canonical service types to their estimators.
+var serviceRegistry = map[string]serviceEstimator{
+	serviceEC2: &funcEstimator{
+		name:       "Amazon EC2",
+		category:   pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE,
+		unit:       "Hours",
+		offerCodes: []string{"AmazonEC2"},
+		carbon:     true, // CPU/GPU power × utilization × grid factor + optional embodied carbon
+		patterns:   []string{"ec2/instance"},
+		projected: func(
+			p *AWSPublicPlugin,
+			settings *pluginSettings,
+			traceID string,
+			resource *pbc.ResourceDescriptor,
+			req *pbc.GetProjectedCostRequest,
+		) (*pbc.GetProjectedCostResponse, error) {
+			return p.estimateEC2(settings, traceID, resource, req)
+		},
+		pricingSpec: (*AWSPublicPlugin).ec2PricingSpec,
+		recommendations: func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) []*pbc.Recommendation {
+			return p.generateEC2Recommendations(resource.GetSku(), region)
+		},
+	},
+	serviceEBS: &funcEstimator{
+		name:        "Amazon EBS",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE,
+		unit:        "GB-Mo",
+		offerCodes:  []string{"AmazonEC2"},
+		carbon:      true, // Storage energy × replication factor × grid factor
+		patterns:    []string{"ebs/volume", "ec2/volume"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateEBS),
+		pricingSpec: (*AWSPublicPlugin).ebsPricingSpec,
+		recommendations: skuRecommendations(func(
+			p *AWSPublicPlugin, sku string, tags map[string]string, region string,
+		) []*pbc.Recommendation {
+			return p.getEBSRecommendations(sku, region, tags)
+		}),
+	},
+	serviceS3: &funcEstimator{
+		name:        "Amazon S3",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE,
+		unit:        "GB-Mo",
+		offerCodes:  []string{"AmazonS3"},
+		carbon:      true, // Storage energy × replication factor × grid factor (by storage class)
+		patterns:    []string{"s3/bucket"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateS3),
+		pricingSpec: (*AWSPublicPlugin).s3PricingSpec,
+		growth:      "size",
+	},
+	serviceRDS: &funcEstimator{
+		name:        "Amazon RDS",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonRDS"},
+		carbon:      true, // Compute carbon + storage carbon (Multi-AZ 2× multiplier)
+		patterns:    []string{"rds/instance"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateRDS),
+		pricingSpec: (*AWSPublicPlugin).rdsPricingSpec,
+		recommendations: func(p *AWSPublicPlugin, resource *pbc.ResourceDescriptor, region string) []*pbc.Recommendation {
+			return p.generateRDSRecommendations(resource.GetSku(), extractRDSEngine(resource.GetTags()), region)
+		},
+	},
+	serviceEKS: &funcEstimator{
+		name:       "Amazon EKS",
+		category:   pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE,
+		unit:       "Hours",
+		offerCodes: []string{"AmazonEKS", "AmazonEC2"},
+		carbon:     true, // Control plane returns 0 (shared); node groups use EC2 carbon × desired_size
+		patterns:   []string{"eks/cluster", "eks/nodegroup", "eks/fargateprofile"},
+		projected: func(
+			p *AWSPublicPlugin,
+			_ *pluginSettings,
+			traceID string,
+			resource *pbc.ResourceDescriptor,
+			req *pbc.GetProjectedCostRequest,
+		) (*pbc.GetProjectedCostResponse, error) {
+			return p.estimateEKS(traceID, resource, req)
+		},
+		pricingSpec: (*AWSPublicPlugin).eksPricingSpec,
+	},
+	serviceLambda: &funcEstimator{
+		name:        "AWS Lambda",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_COMPUTE,
+		unit:        "GB-Seconds",
+		offerCodes:  []string{"AWSLambda"},
+		carbon:      true, // vCPU-equivalent × duration × grid factor (ARM64 efficiency adjusted)
+		patterns:    []string{"lambda/function"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateLambda),
+		pricingSpec: (*AWSPublicPlugin).lambdaPricingSpec,
+		usage:       lambdaUsageProfile,
+	},
+	serviceDynamoDB: &funcEstimator{
+		name:        "Amazon DynamoDB",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE,
+		unit:        "Requests", // Simplified; actual has RCU/WCU
+		offerCodes:  []string{"AmazonDynamoDB"},
+		carbon:      true, // Storage-based carbon (SSD × 3× replication factor)
+		patterns:    []string{"dynamodb/table"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateDynamoDB),
+		pricingSpec: (*AWSPublicPlugin).dynamoDBPricingSpec,
+		usage:       dynamoDBUsageProfile,
+		growth:      "storage_gb",
+	},
+	serviceELB: &funcEstimator{
+		name:        "Elastic Load Balancing",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_NETWORK,
+		unit:        "Hours",
+		offerCodes:  []string{"AWSELB"},
+		patterns:    []string{"lb/loadbalancer", "alb/loadbalancer", "nlb/loadbalancer"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateELB),
+		pricingSpec: (*AWSPublicPlugin).elbPricingSpec,
+		usage:       elbUsageProfile,
+	},
+	serviceNATGW: &funcEstimator{
+		name:        "Amazon VPC NAT Gateway",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_NETWORK,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonVPC"},
+		patterns:    []string{"ec2/natgateway"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateNATGateway),
+		pricingSpec: (*AWSPublicPlugin).natGatewayPricingSpec,
+		usage:       natGatewayUsageProfile,
+	},
+	serviceCloudWatch: &funcEstimator{
+		name:        "Amazon CloudWatch",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_MANAGEMENT,
+		unit:        "GB", // For log ingestion
+		offerCodes:  []string{"AmazonCloudWatch"},
+		patterns:    []string{"cloudwatch/loggroup", "cloudwatch/logstream", "cloudwatch/metricalarm"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateCloudWatch),
+		pricingSpec: (*AWSPublicPlugin).cloudWatchPricingSpec,
+		usage:       cloudWatchUsageProfile,
+	},
+	serviceElastiCache: &funcEstimator{
+		name:        "Amazon ElastiCache",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonElastiCache"},
+		carbon:      true, // EC2-equivalent node carbon × cluster size
+		patterns:    []string{"elasticache/"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateElastiCache),
+		pricingSpec: (*AWSPublicPlugin).elastiCachePricingSpec,
+	},
+	serviceSecrets: &funcEstimator{
+		name:        "AWS Secrets Manager",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_SECURITY,
+		unit:        "Months", // Per secret
+		offerCodes:  []string{"AWSSecretsManager"},
+		patterns:    []string{"secretsmanager/secret:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateSecretsManager),
+		pricingSpec: (*AWSPublicPlugin).secretsManagerPricingSpec,
+		usage:       apiCallsUsageProfile,
+	},
+	serviceKMS: &funcEstimator{
+		name:        "AWS Key Management Service",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_SECURITY,
+		unit:        "Months", // Per key
+		offerCodes:  []string{"awskms"},
+		patterns:    []string{"kms/key:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateKMS),
+		pricingSpec: (*AWSPublicPlugin).kmsPricingSpec,
+		usage:       apiCallsUsageProfile,
+	},
+	serviceOpenSearch: &funcEstimator{
+		name:       "Amazon OpenSearch Service",
+		category:   pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_ANALYTICS,
+		unit:       "Hours",
+		offerCodes: []string{"AmazonES"},
+		carbon:     true, // EC2-equivalent data and master node carbon × node count
+		patterns: []string{
+			"opensearch/domain:", "opensearch/serverlesscollection:", "opensearchserverless/collection",
+			"elasticsearch/domain:",
+		},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateOpenSearch),
+		pricingSpec: (*AWSPublicPlugin).openSearchPricingSpec,
+	},
+	serviceRedshift: &funcEstimator{
+		name:        "Amazon Redshift",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_ANALYTICS,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonRedshift"},
+		patterns:    []string{"redshift/cluster:", "redshiftserverless/workgroup:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateRedshift),
+		pricingSpec: (*AWSPublicPlugin).redshiftPricingSpec,
+		recommendations: skuRecommendations(func(
+			p *AWSPublicPlugin, sku string, tags map[string]string, region string,
+		) []*pbc.Recommendation {
+			return p.generateRedshiftRecommendations(sku, tags, region)
+		}),
+	},
+	serviceECR: &funcEstimator{
+		name:        "Amazon Elastic Container Registry",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE,
+		unit:        "GB-Mo",
+		offerCodes:  []string{"AmazonECR"},
+		patterns:    []string{"ecr/repository:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateECR),
+		pricingSpec: (*AWSPublicPlugin).ecrPricingSpec,
+	},
+	serviceBackup: &funcEstimator{
+		name:        "AWS Backup",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_STORAGE,
+		unit:        "GB-Mo",
+		offerCodes:  []string{"AWSBackup"},
+		patterns:    []string{"backup/vault:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateBackup),
+		pricingSpec: (*AWSPublicPlugin).backupPricingSpec,
+	},
+	serviceStepFuncs: &funcEstimator{
+		name:        "AWS Step Functions",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_OTHER, // No application integration category yet
+		unit:        "Transitions",                                         // Standard workflows; Express is per request
+		offerCodes:  []string{"AmazonStates"},
+		patterns:    []string{"sfn/statemachine:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateStepFunctions),
+		pricingSpec: (*AWSPublicPlugin).stepFunctionsPricingSpec,
+		usage:       stepFunctionsUsageProfile,
+	},
+	serviceEventBridge: &funcEstimator{
+		name:        "Amazon EventBridge",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_OTHER, // No application integration category yet
+		unit:        "Events",
+		offerCodes:  []string{"AWSEvents"},
+		patterns:    []string{"cloudwatch/eventbus", "pipes/pipe"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateEventBridge),
+		pricingSpec: (*AWSPublicPlugin).eventBridgePricingSpec,
+		usage:       eventBridgeUsageProfile,
+	},
+	serviceDocDB: clusterDatabaseEstimator(serviceDocDB, "Amazon DocumentDB", "AmazonDocDB",
+		"docdb/cluster:", "docdb/clusterinstance:"),
+	serviceNeptune: clusterDatabaseEstimator(serviceNeptune, "Amazon Neptune", "AmazonNeptune",
+		"neptune/cluster:", "neptune/clusterinstance:"),
+	serviceMemoryDB: &funcEstimator{
+		name:            "Amazon MemoryDB",
+		category:        pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_DATABASE,
+		unit:            "Hours",
+		offerCodes:      []string{"AmazonMemoryDB"},
+		patterns:        []string{"memorydb/cluster:"},
+		projected:       resourceOnly((*AWSPublicPlugin).estimateMemoryDB),
+		pricingSpec:     (*AWSPublicPlugin).memoryDBPricingSpec,
+		recommendations: clusterDatabaseRecommendations(serviceMemoryDB),
+	},
+	serviceMSK: &funcEstimator{
+		name:        "Amazon Managed Streaming for Apache Kafka",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_ANALYTICS,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonMSK"},
+		patterns:    []string{"msk/cluster:", "msk/serverlesscluster:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateMSK),
+		pricingSpec: (*AWSPublicPlugin).mskPricingSpec,
+		recommendations: skuRecommendations(func(
+			p *AWSPublicPlugin, sku string, tags map[string]string, region string,
+		) []*pbc.Recommendation {
+			return p.generateMSKRecommendations(sku, tags, region)
+		}),
+	},
+	serviceSageMaker: &funcEstimator{
+		name:        "Amazon SageMaker",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_MACHINE_LEARNING,
+		unit:        "Hours",
+		offerCodes:  []string{"AmazonSageMaker"},
+		carbon:      true, // EC2-equivalent CPU + GPU carbon × instance count
+		patterns:    []string{"sagemaker/endpoint:", "sagemaker/notebookinstance:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateSageMaker),
+		pricingSpec: (*AWSPublicPlugin).sageMakerPricingSpec,
+	},
+	serviceWAF: &funcEstimator{
+		name:        "AWS WAF",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_SECURITY,
+		unit:        "Months", // Per web ACL
+		offerCodes:  []string{"awswaf"},
+		patterns:    []string{"wafv2/webacl:", "wafregional/webacl:", "waf/webacl:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateWAF),
+		pricingSpec: (*AWSPublicPlugin).wafPricingSpec,
+	},
+	serviceShield: &funcEstimator{
+		name:        "AWS Shield",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_SECURITY,
+		unit:        "Months", // Per subscription
+		offerCodes:  []string{"AWSShield"},
+		patterns:    []string{"shield/subscription:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateShield),
+		pricingSpec: (*AWSPublicPlugin).shieldPricingSpec,
+	},
+	serviceGlobalAccel: &funcEstimator{
+		name:        "AWS Global Accelerator",
+		category:    pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_NETWORK,
+		unit:        "Hours",
+		offerCodes:  []string{"AWSGlobalAccelerator"},
+		patterns:    []string{"globalaccelerator/accelerator:", "globalaccelerator/customroutingaccelerator:"},
+		projected:   resourceOnly((*AWSPublicPlugin).estimateGlobalAccelerator),
+		pricingSpec: (*AWSPublicPlugin).globalAcceleratorPricingSpec,
+	},
+	serviceVPC:           zeroCostEstimator(serviceVPC),
+	serviceSecurityGroup: zeroCostEstimator(serviceSecurityGroup),
+	serviceSubnet:        zeroCostEstimator(serviceSubnet),
+	serviceIAM:           withPatterns(zeroCostEstimator(serviceIAM), "iam/"),
+	serviceLaunchTmpl:    zeroCostEstimator(serviceLaunchTmpl),
+	serviceLaunchConfig:  zeroCostEstimator(serviceLaunchConfig),
+	serviceConfigOnly:    zeroCostEstimator(serviceConfigOnly),
+}
+
+// serviceAliases maps alternative service names (Pulumi module names, legacy
+// identifiers and ELB variants) to their registered canonical service type.
+var serviceAliases = map[string]string{
+	"lb":                   serviceELB,
+	serviceALB:             serviceELB,
+	serviceNLB:             serviceELB,
+	"nat_gateway":          serviceNATGW,
+	"nat-gateway":          serviceNATGW,
+	"natgateway":           serviceNATGW,
+	"elasticsearch":        serviceOpenSearch, // Legacy aws:elasticsearch/domain:Domain resources
+	"opensearchserverless": serviceOpenSearch,
+	"redshiftserverless":   serviceRedshift,
+	"pipes":                serviceEventBridge,
+	"stepfunctions":        serviceStepFuncs,
+	"wafv2":                serviceWAF,
+	"wafregional":          serviceWAF,
+}
+
+// withPatterns sets the legacy resource type patterns of a registered estimator.
+func withPatterns(e *funcEstimator, patterns ...string) *funcEstimator {
+	e.patterns = patterns
+	return e
+}
+
+// lookupEstimator returns the estimator for a canonical service type or alias.
+func lookupEstimator(service string) (serviceEstimator, bool) {
+	if canonical, ok := serviceAliases[service]; ok {
+		service = canonical
+	}
+	estimator, ok := serviceRegistry[service]
+	return estimator, ok
+}
+
+// canonicalService resolves a canonical service type or alias to the registered
+// service type. Zero-cost services are excluded because they are detected
+// through ZeroCostServices and ZeroCostPulumiPatterns.
+func canonicalService(service string) (string, bool) {
+	if canonical, ok := serviceAliases[service]; ok {
+		return canonical, true
+	}
+	if _, ok := serviceRegistry[service]; ok && !IsZeroCostService(service) {
+		return service, true
+	}
+	return "", false
+}
+
+// registeredServices returns the registered service types in sorted order, so
+// pattern matching and conformance checks are deterministic.
+func registeredServices() []string {
+	services := make([]string, 0, len(serviceRegistry))
+	for service := range serviceRegistry {
+		services = append(services, service)
+	}
+	slices.Sort(services)
+	return services
+}
+
+// serviceForPattern returns the service whose legacy resource type patterns
+// match the lowercase resource type.
+func serviceForPattern(resourceTypeLower string) (string, bool) {
+	for _, service := range registeredServices() {
+		for _, pattern := range serviceRegistry[service].ResourcePatterns() {
+			if strings.Contains(resourceTypeLower, pattern) {
+				return service, true
+			}
+		}
+	}
+	return "", false
+}
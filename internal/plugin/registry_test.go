@@ -0,0 +1,208 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// conformanceSample is a representative resource for one registered service.
+type conformanceSample struct {
+	resourceType string
+	sku          string
+	tags         map[string]string
+}
+
+// conformanceSamples holds one sample per registered service. Registering a new
+// service without adding a sample here fails TestServiceRegistryConformance.
+var conformanceSamples = map[string]conformanceSample{
+	serviceEC2:         {"aws:ec2/instance:Instance", "t3.micro", nil},
+	serviceEBS:         {"aws:ebs/volume:Volume", "gp3", map[string]string{"size": "100"}},
+	serviceS3:          {"aws:s3/bucket:Bucket", "STANDARD", map[string]string{"size": "100"}},
+	serviceRDS:         {"aws:rds/instance:Instance", "db.t3.micro", nil},
+	serviceEKS:         {"aws:eks/cluster:Cluster", "cluster", nil},
+	serviceLambda:      {"aws:lambda/function:Function", "512", map[string]string{"requests_per_month": "1000000"}},
+	serviceDynamoDB:    {"aws:dynamodb/table:Table", "on-demand", map[string]string{"storage_gb": "10"}},
+	serviceELB:         {"aws:lb/loadBalancer:LoadBalancer", "alb", nil},
+	serviceNATGW:       {"natgw", "nat", nil},
+	serviceCloudWatch:  {"aws:cloudwatch/logGroup:LogGroup", "logs", map[string]string{"log_ingestion_gb": "10"}},
+	serviceElastiCache: {"aws:elasticache/cluster:Cluster", "cache.m5.large", nil},
+	serviceSecrets:     {"aws:secretsmanager/secret:Secret", "secret", nil},
+	serviceKMS:         {"aws:kms/key:Key", "key", nil},
+	serviceOpenSearch:  {"aws:opensearch/domain:Domain", "r6g.large.search", nil},
+	serviceRedshift:    {"aws:redshift/cluster:Cluster", "ra3.large", nil},
+	serviceECR:         {"aws:ecr/repository:Repository", "storage", map[string]string{"storage_gb": "10"}},
+	serviceBackup:      {"aws:backup/vault:Vault", "ebs", map[string]string{"warm_storage_gb": "100"}},
+	serviceStepFuncs: {
+		"aws:sfn/stateMachine:StateMachine", "standard",
+		map[string]string{"state_transitions_per_month": "1000000"},
+	},
+	serviceEventBridge: {"aws:cloudwatch/eventBus:EventBus", "bus", map[string]string{"events_per_month": "1000000"}},
+	serviceDocDB:       {"aws:docdb/cluster:Cluster", "db.r5.large", nil},
+	serviceNeptune:     {"aws:neptune/cluster:Cluster", "db.r5.large", nil},
+	serviceMemoryDB:    {"aws:memorydb/cluster:Cluster", "db.r6g.large", nil},
+	serviceMSK:         {"aws:msk/cluster:Cluster", "kafka.m5.large", nil},
+	serviceSageMaker:   {"aws:sagemaker/endpoint:Endpoint", "ml.m5.large", nil},
+	serviceWAF:         {"aws:wafv2/webAcl:WebAcl", "webacl", nil},
+	serviceShield:      {"aws:shield/subscription:Subscription", "subscription", nil},
+	serviceGlobalAccel: {"aws:globalaccelerator/accelerator:Accelerator", "accelerator", nil},
+	serviceVPC:         {"aws:ec2/vpc:Vpc", "vpc", nil},
+	serviceSecurityGroup: {
+		"aws:ec2/securityGroup:SecurityGroup", "sg", nil,
+	},
+	serviceSubnet:       {"aws:ec2/subnet:Subnet", "subnet", nil},
+	serviceIAM:          {"aws:iam/role:Role", "role", nil},
+	serviceLaunchTmpl:   {"aws:ec2/launchTemplate:LaunchTemplate", "lt", nil},
+	serviceLaunchConfig: {"aws:ec2/launchConfiguration:LaunchConfiguration", "lc", nil},
+	serviceConfigOnly:   {"aws:kms/alias:Alias", "alias", nil},
+}
+
+// TestServiceRegistryConformance exercises every registered service through each
+// RPC that dispatches via the registry: detection, Supports, GetProjectedCost,
+// GetPricingSpec, GetRecommendations and the FOCUS mappings.
+func TestServiceRegistryConformance(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	for _, service := range registeredServices() {
+		t.Run(service, func(t *testing.T) {
+			sample, ok := conformanceSamples[service]
+			require.True(t, ok, "registered service %q has no conformance sample", service)
+			estimator := serviceRegistry[service]
+			zeroCost := IsZeroCostService(service)
+
+			resource := &pbc.ResourceDescriptor{
+				Provider:     providerAWS,
+				ResourceType: sample.resourceType,
+				Sku:          sample.sku,
+				Region:       "us-east-1",
+				Tags:         sample.tags,
+			}
+
+			assert.Equal(t, service, detectService(normalizeResourceType(sample.resourceType)),
+				"sample resource type must route to its service")
+
+			supports, err := plugin.Supports(ctx, &pbc.SupportsRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.True(t, supports.GetSupported())
+			assert.Equal(t, estimator.SupportedMetrics(), supports.GetSupportedMetrics())
+
+			projected, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.Equal(t, "USD", projected.GetCurrency())
+			assert.NotEmpty(t, projected.GetBillingDetail())
+			if zeroCost {
+				assert.Zero(t, projected.GetCostPerMonth())
+			} else {
+				assert.Positive(t, projected.GetCostPerMonth(), projected.GetBillingDetail())
+			}
+
+			components := costComponentsFromMetadata(projected.GetMetadata())
+			if zeroCost {
+				assert.Empty(t, components)
+			} else {
+				require.NotEmpty(t, components, "priced services must report cost components")
+				var sum float64
+				for _, component := range components {
+					assert.NotEmpty(t, component.Dimension)
+					assert.NotEmpty(t, component.Unit)
+					sum += component.Subtotal
+				}
+				assert.InDelta(t, projected.GetCostPerMonth(), sum, 1e-9,
+					"component subtotals must sum to CostPerMonth")
+			}
+
+			hasCarbon := false
+			for _, metric := range projected.GetImpactMetrics() {
+				if metric.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+					hasCarbon = true
+				}
+			}
+			assert.Equal(t, len(estimator.SupportedMetrics()) > 0, hasCarbon,
+				"carbon metric must be reported exactly when advertised")
+
+			spec, err := plugin.GetPricingSpec(ctx, &pbc.GetPricingSpecRequest{Resource: resource})
+			require.NoError(t, err)
+			require.NotNil(t, spec.GetSpec())
+			assert.NotEqual(t, "unknown", spec.GetSpec().GetBillingMode())
+			assert.Equal(t, "USD", spec.GetSpec().GetCurrency())
+			assert.Equal(t, "aws-public", spec.GetSpec().GetSource())
+
+			_, err = plugin.GetRecommendations(ctx, &pbc.GetRecommendationsRequest{
+				TargetResources: []*pbc.ResourceDescriptor{resource},
+			})
+			require.NoError(t, err)
+
+			assert.NotEqual(t, pbc.FocusServiceCategory_FOCUS_SERVICE_CATEGORY_UNSPECIFIED, estimator.Category())
+			assert.NotEmpty(t, estimator.PricingUnit())
+			if !zeroCost {
+				assert.NotEmpty(t, estimator.ServiceName())
+				assert.Equal(t, estimator.ServiceName(), getServiceName(service))
+			}
+		})
+	}
+
+	t.Run("no stale samples", func(t *testing.T) {
+		for service := range conformanceSamples {
+			_, ok := serviceRegistry[service]
+			assert.True(t, ok, "conformance sample for unregistered service %q", service)
+		}
+	})
+}
+
+// TestServiceRegistryPatterns verifies every legacy resource type pattern resolves
+// to the service that declares it, so no two services claim the same pattern.
+func TestServiceRegistryPatterns(t *testing.T) {
+	for _, service := range registeredServices() {
+		for _, pattern := range serviceRegistry[service].ResourcePatterns() {
+			t.Run(service+"/"+pattern, func(t *testing.T) {
+				got, ok := serviceForPattern("aws:" + pattern + "x:x")
+				require.True(t, ok)
+				assert.Equal(t, service, got)
+			})
+		}
+	}
+}
+
+// TestServiceAliases verifies every alias points at a registered service.
+func TestServiceAliases(t *testing.T) {
+	for alias, service := range serviceAliases {
+		t.Run(alias, func(t *testing.T) {
+			_, ok := serviceRegistry[service]
+			assert.True(t, ok)
+			assert.Equal(t, service, detectService(alias))
+		})
+	}
+}
+
+// assertConfigurationOnly verifies resources that configure a billed resource of
+// their module are supported and estimated at zero cost, rather than priced as
+// the billed resource.
+func assertConfigurationOnly(t *testing.T, resourceTypes ...string) {
+	t.Helper()
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+
+	for _, rt := range resourceTypes {
+		t.Run(rt, func(t *testing.T) {
+			assert.Equal(t, serviceConfigOnly, detectService(normalizeResourceType(rt)))
+
+			resource := &pbc.ResourceDescriptor{
+				Provider:     providerAWS,
+				ResourceType: rt,
+				Sku:          "default",
+				Region:       "us-east-1",
+			}
+			supports, err := plugin.Supports(ctx, &pbc.SupportsRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.True(t, supports.GetSupported())
+
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.Zero(t, resp.GetCostPerMonth(), resp.GetBillingDetail())
+		})
+	}
+}
@@ -0,0 +1,234 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_SageMaker verifies instance-hours for real-time endpoints,
+// instance-hours plus storage for notebooks, and GB-second plus data pricing for
+// Serverless Inference.
+func TestGetProjectedCost_SageMaker(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "endpoint defaults",
+			resourceType: "aws:sagemaker/endpoint:Endpoint",
+			sku:          "ml.m5.large",
+			wantCost:     0.115 * 730,
+			wantDetail:   "SageMaker endpoint ml.m5.large, 1 instance(s), 730 hrs/month",
+			wantDefaults: "instance_count=1",
+		},
+		{
+			name:         "endpoint with instance count",
+			resourceType: "aws:sagemaker/endpoint:Endpoint",
+			sku:          "ML.G5.XLARGE",
+			tags:         map[string]string{"instance_count": "3"},
+			wantCost:     1.408 * 3 * 730,
+			wantDetail:   "SageMaker endpoint ml.g5.xlarge, 3 instance(s)",
+		},
+		{
+			name:         "notebook defaults",
+			resourceType: "aws:sagemaker/notebookInstance:NotebookInstance",
+			sku:          "ml.t3.medium",
+			wantCost:     0.05*730 + 5*0.14,
+			wantDetail:   "SageMaker notebook ml.t3.medium, 730 hrs/month + 5GB storage",
+			wantDefaults: "volume_size=5",
+		},
+		{
+			name:         "notebook with volume",
+			resourceType: "aws:sagemaker/notebookInstance:NotebookInstance",
+			sku:          "ml.t3.medium",
+			tags:         map[string]string{"volume_size": "50"},
+			wantCost:     0.05*730 + 50*0.14,
+			wantDetail:   "+ 50GB storage",
+		},
+		{
+			name:         "serverless defaults",
+			resourceType: "aws:sagemaker/endpoint:Endpoint",
+			sku:          "serverless",
+			wantCost:     0,
+			wantDetail:   "SageMaker Serverless Inference, 2048MB, 0 requests/month",
+			wantDefaults: "memory_mb=2048,avg_duration_ms=100,requests_per_month=0",
+		},
+		{
+			name:         "serverless with usage rounds memory up",
+			resourceType: "aws:sagemaker/endpoint:Endpoint",
+			sku:          "serverless",
+			tags: map[string]string{
+				"memory_mb":                   "3000",
+				"avg_duration_ms":             "200",
+				"requests_per_month":          "1000000",
+				"data_processed_gb_per_month": "10",
+			},
+			wantCost:   1000000*0.2*3*0.00002 + 10*0.016,
+			wantDetail: "3072MB, 1000000 requests/month × 200ms + 10GB data processed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+
+	t.Run("serverless memory above maximum is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:sagemaker/endpoint:Endpoint",
+				Sku:          "serverless",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"memory_mb": "8192"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("unknown instance type returns $0 with explanation", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:sagemaker/endpoint:Endpoint",
+				Sku:          "ml.x9.large",
+				Region:       "us-east-1",
+			},
+		})
+		require.NoError(t, err)
+		assert.Zero(t, resp.GetCostPerMonth())
+		assert.Contains(t, resp.GetBillingDetail(), `SageMaker hosting instance type "ml.x9.large" not found`)
+	})
+}
+
+// TestGetProjectedCost_SageMakerCarbon verifies carbon is reported for instance-backed
+// endpoints, includes GPU power for ml.g5, scales with instance count, and is omitted
+// for Serverless Inference.
+func TestGetProjectedCost_SageMakerCarbon(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	carbonFor := func(t *testing.T, sku string, tags map[string]string) (float64, bool) {
+		t.Helper()
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:sagemaker/endpoint:Endpoint",
+				Sku:          sku,
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		require.NoError(t, err)
+		for _, metric := range resp.GetImpactMetrics() {
+			if metric.GetKind() == pbc.MetricKind_METRIC_KIND_CARBON_FOOTPRINT {
+				assert.Equal(t, "gCO2e", metric.GetUnit())
+				return metric.GetValue(), true
+			}
+		}
+		return 0, false
+	}
+
+	cpuCarbon, ok := carbonFor(t, "ml.m5.large", nil)
+	require.True(t, ok)
+	assert.Positive(t, cpuCarbon)
+
+	gpuCarbon, ok := carbonFor(t, "ml.g5.xlarge", nil)
+	require.True(t, ok)
+	assert.Greater(t, gpuCarbon, cpuCarbon, "GPU instance should include GPU power")
+
+	scaled, ok := carbonFor(t, "ml.g5.xlarge", map[string]string{"instance_count": "2"})
+	require.True(t, ok)
+	assert.InDelta(t, gpuCarbon*2, scaled, 1e-6)
+
+	_, ok = carbonFor(t, "serverless", nil)
+	assert.False(t, ok)
+}
+
+// TestDetectService_SageMaker verifies Pulumi resource types route to the SageMaker
+// estimator.
+func TestDetectService_SageMaker(t *testing.T) {
+	tests := []string{
+		"aws:sagemaker/endpoint:Endpoint",
+		"aws:sagemaker/notebookInstance:NotebookInstance",
+		"sagemaker",
+	}
+
+	for _, resourceType := range tests {
+		t.Run(resourceType, func(t *testing.T) {
+			assert.Equal(t, serviceSageMaker, detectService(normalizeResourceType(resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_SageMakerConfiguration verifies models and endpoint
+// configurations are not priced as endpoints; their instances are billed on the
+// endpoint that uses them.
+func TestGetProjectedCost_SageMakerConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:sagemaker/model:Model",
+		"aws:sagemaker/endpointConfiguration:EndpointConfiguration",
+		"aws:sagemaker/notebookInstanceLifecycleConfiguration:NotebookInstanceLifecycleConfiguration",
+	)
+}
+
+// TestGetPricingSpec_SageMaker verifies hosting, notebook and Serverless Inference specs.
+func TestGetPricingSpec_SageMaker(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+		wantUnit     string
+		wantMode     string
+	}{
+		{"aws:sagemaker/endpoint:Endpoint", "ml.m5.large", 0.115, "hour", "per_hour"},
+		{"aws:sagemaker/notebookInstance:NotebookInstance", "ml.t3.medium", 0.05, "hour", "per_hour"},
+		{"aws:sagemaker/endpoint:Endpoint", "serverless", 0.00002, "GB-second", "per_gb_second"},
+		{"aws:sagemaker/endpoint:Endpoint", "ml.x9.large", 0, "hour", "per_hour"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.sku, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantMode, resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package plugin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/grpc/codes"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// Tags describing when a time-billed resource is running.
+const (
+	// tagHoursPerMonth sets the running hours per month directly (0-730).
+	tagHoursPerMonth = "hours_per_month"
+
+	// tagSchedule names a schedule ("business_hours", "weekdays", "always") or
+	// gives one or more on/off windows such as "mon-fri 07:00-19:00".
+	tagSchedule = "schedule"
+
+	// tagInstanceScheduler is the tag AWS Instance Scheduler reads its schedule
+	// name from; names such as "office-hours" match the named schedules.
+	tagInstanceScheduler = "Schedule"
+)
+
+// Metadata keys describing the usage schedule an estimate was built with.
+const (
+	// metadataKeyUsageSchedule is the schedule applied to the running hours.
+	metadataKeyUsageSchedule = "usage_schedule"
+
+	// metadataKeyHoursPerMonth is the running hours per month the schedule yields.
+	metadataKeyHoursPerMonth = "hours_per_month"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+	hoursPerWeek   = 7 * hoursPerDay
+)
+
+// namedSchedules maps schedule names to their window expressions.
+// An empty expression means the resource runs around the clock.
+var namedSchedules = map[string]string{
+	"always":         "",
+	"24x7":           "",
+	"business_hours": "mon-fri 09:00-17:00",
+	"office_hours":   "mon-fri 09:00-17:00",
+	"weekdays":       "mon-fri 00:00-24:00",
+}
+
+// scheduleDays maps day abbreviations to their index in the week.
+var scheduleDays = map[string]int{
+	"mon": 0, "tue": 1, "wed": 2, "thu": 3, "fri": 4, "sat": 5, "sun": 6,
+}
+
+// usageSchedule is how many hours per month a time-billed resource runs.
+// Only services classified as AffectedByDevMode honor schedule tags; storage
+// and other non-hourly charges are billed in full regardless of the schedule.
+type usageSchedule struct {
+	name  string  // schedule as given in the tags; empty when running 24x7
+	hours float64 // running hours per month
+}
+
+// applied reports whether the schedule reduces the running hours below 24x7.
+func (s usageSchedule) applied() bool {
+	return s.name != ""
+}
+
+// billingDetail describes the running hours for a billing detail message,
+// e.g. "730 hrs/month" or "173.8 hrs/month (business_hours schedule)".
+func (s usageSchedule) billingDetail() string {
+	if !s.applied() {
+		return fmt.Sprintf("%d hrs/month", int(carbon.HoursPerMonth))
+	}
+	return fmt.Sprintf("%s hrs/month (%s schedule)", strconv.FormatFloat(s.hours, 'f', 1, 64), s.name)
+}
+
+// annotate records the applied schedule and its running hours in the response metadata.
+func (s usageSchedule) annotate(resp *pbc.GetProjectedCostResponse) {
+	if resp == nil || !s.applied() {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 2)
+	}
+	resp.Metadata[metadataKeyUsageSchedule] = s.name
+	resp.Metadata[metadataKeyHoursPerMonth] = strconv.FormatFloat(math.Round(s.hours*100)/100, 'f', -1, 64)
+}
+
+// usageSchedule resolves the running hours per month of a resource from its
+// "hours_per_month" or "schedule" tag. Services not classified as
+// AffectedByDevMode, and resources without either tag, run 730 hours.
+// Invalid tag values are reported as InvalidArgument errors.
+func (p *AWSPublicPlugin) usageSchedule(
+	traceID, classificationKey string,
+	tags map[string]string,
+) (usageSchedule, error) {
+	schedule, err := resolveUsageSchedule(classificationKey, tags)
+	if err != nil {
+		return usageSchedule{}, p.newErrorWithID(traceID, codes.InvalidArgument, err.Error(),
+			pbc.ErrorCode_ERROR_CODE_INVALID_RESOURCE)
+	}
+	return schedule, nil
+}
+
+// resolveUsageSchedule implements usageSchedule. "hours_per_month" takes
+// precedence over "schedule".
+func resolveUsageSchedule(classificationKey string, tags map[string]string) (usageSchedule, error) {
+	fullTime := usageSchedule{hours: carbon.HoursPerMonth}
+	classification, ok := GetServiceClassification(classificationKey)
+	if !ok || !classification.AffectedByDevMode {
+		return fullTime, nil
+	}
+
+	if val := strings.TrimSpace(tags[tagHoursPerMonth]); val != "" {
+		hours, err := strconv.ParseFloat(val, 64)
+		if err != nil || math.IsNaN(hours) || hours < 0 || hours > carbon.HoursPerMonth {
+			return usageSchedule{}, fmt.Errorf("invalid value for '%s': %q must be a number between 0 and %d",
+				tagHoursPerMonth, val, int(carbon.HoursPerMonth))
+		}
+		if hours == carbon.HoursPerMonth {
+			return fullTime, nil
+		}
+		return usageSchedule{name: tagHoursPerMonth, hours: hours}, nil
+	}
+
+	val := strings.TrimSpace(tags[tagSchedule])
+	if val == "" {
+		// AWS Instance Scheduler tags resources with "Schedule=<name>". Its
+		// schedules are user-defined, so only names matching a named schedule
+		// are applied and anything else is priced as running 24x7.
+		name := strings.TrimSpace(tags[tagInstanceScheduler])
+		if _, named := namedSchedules[scheduleName(name)]; !named {
+			return fullTime, nil
+		}
+		val = name
+	}
+	if val == "" {
+		return fullTime, nil
+	}
+	expr, named := namedSchedules[scheduleName(val)]
+	if named && expr == "" {
+		return fullTime, nil
+	}
+	if !named {
+		expr = val
+	}
+	weeklyHours, err := scheduleHoursPerWeek(expr)
+	if err != nil {
+		return usageSchedule{}, fmt.Errorf("invalid value for '%s': %q: %w", tagSchedule, val, err)
+	}
+	if weeklyHours == hoursPerWeek {
+		return fullTime, nil
+	}
+	return usageSchedule{name: val, hours: weeklyHours * carbon.HoursPerMonth / hoursPerWeek}, nil
+}
+
+// scheduleName normalizes a schedule name for lookup in namedSchedules.
+func scheduleName(val string) string {
+	return strings.ReplaceAll(strings.ToLower(val), "-", "_")
+}
+
+// scheduleHoursPerWeek returns the hours per week covered by a window expression:
+// one or more "<days> <HH:MM>-<HH:MM>" windows separated by ";". Days are "daily"
+// or a comma-separated list of days and ranges ("mon-fri", "sat,sun"). A window
+// ending before it starts runs overnight into the next day. Overlapping windows
+// are counted once.
+func scheduleHoursPerWeek(expr string) (float64, error) {
+	var week [minutesPerWeek]bool
+	for window := range strings.SplitSeq(expr, ";") {
+		window = strings.TrimSpace(window)
+		if window == "" {
+			continue
+		}
+		daySpec, timeSpec, found := strings.Cut(window, " ")
+		if !found {
+			return 0, fmt.Errorf("window %q must be \"<days> <HH:MM>-<HH:MM>\"", window)
+		}
+		days, err := parseScheduleDays(daySpec)
+		if err != nil {
+			return 0, err
+		}
+		start, end, err := parseScheduleTimes(strings.TrimSpace(timeSpec))
+		if err != nil {
+			return 0, err
+		}
+		if end <= start {
+			end += minutesPerDay
+		}
+		for _, day := range days {
+			for minute := start; minute < end; minute++ {
+				week[(day*minutesPerDay+minute)%minutesPerWeek] = true
+			}
+		}
+	}
+
+	running := 0
+	for _, on := range week {
+		if on {
+			running++
+		}
+	}
+	if running == 0 {
+		return 0, errors.New("no running windows")
+	}
+	return float64(running) / 60, nil
+}
+
+// parseScheduleDays parses "daily" or a comma-separated list of days and day
+// ranges into day-of-week indexes (Monday is 0). Ranges may wrap ("fri-mon").
+func parseScheduleDays(spec string) ([]int, error) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	if spec == "daily" || spec == "*" {
+		return []int{0, 1, 2, 3, 4, 5, 6}, nil
+	}
+	var days []int
+	for part := range strings.SplitSeq(spec, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		first, ok := scheduleDays[from]
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q", from)
+		}
+		last := first
+		if isRange {
+			if last, ok = scheduleDays[to]; !ok {
+				return nil, fmt.Errorf("unknown day %q", to)
+			}
+		}
+		for day := first; ; day = (day + 1) % 7 {
+			days = append(days, day)
+			if day == last {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseScheduleTimes parses "HH:MM-HH:MM" into minutes after midnight.
+// The end time may be "24:00".
+func parseScheduleTimes(spec string) (int, int, error) {
+	from, to, found := strings.Cut(spec, "-")
+	if !found {
+		return 0, 0, fmt.Errorf("time range %q must be \"HH:MM-HH:MM\"", spec)
+	}
+	start, err := parseScheduleClock(from)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseScheduleClock(to)
+	if err != nil {
+		return 0, 0, err
+	}
+	if start == minutesPerDay {
+		return 0, 0, fmt.Errorf("start time %q must be before 24:00", from)
+	}
+	if start == end {
+		return 0, 0, fmt.Errorf("time range %q is empty", spec)
+	}
+	return start, end, nil
+}
+
+// parseScheduleClock parses "HH:MM" (00:00-24:00) into minutes after midnight.
+func parseScheduleClock(clock string) (int, error) {
+	hh, mm, found := strings.Cut(strings.TrimSpace(clock), ":")
+	hours, hoursErr := strconv.Atoi(hh)
+	minutes, minutesErr := strconv.Atoi(mm)
+	if !found || hoursErr != nil || minutesErr != nil ||
+		hours < 0 || minutes < 0 || minutes > 59 || hours*60+minutes > minutesPerDay {
+		return 0, fmt.Errorf("invalid time %q", clock)
+	}
+	return hours*60 + minutes, nil
+}
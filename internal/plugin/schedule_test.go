@@ -0,0 +1,231 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/carbon"
+)
+
+// weeksPerMonth converts weekly running hours to monthly ones.
+const weeksPerMonth = carbon.HoursPerMonth / hoursPerWeek
+
+// TestResolveUsageSchedule verifies schedule tags are turned into running hours
+// for services billed by running hours only.
+func TestResolveUsageSchedule(t *testing.T) {
+	tests := []struct {
+		name           string
+		classification string
+		tags           map[string]string
+		wantHours      float64
+		wantName       string
+		wantErr        string
+	}{
+		{"no tags", "aws:ec2:instance", nil, 730, "", ""},
+		{"business hours", "aws:ec2:instance", map[string]string{"schedule": "business_hours"}, 40 * weeksPerMonth, "business_hours", ""},
+		{"named schedule is case and dash insensitive", "aws:rds:instance", map[string]string{"schedule": "Office-Hours"}, 40 * weeksPerMonth, "Office-Hours", ""},
+		{"weekdays", "aws:ec2:instance", map[string]string{"schedule": "weekdays"}, 120 * weeksPerMonth, "weekdays", ""},
+		{"always", "aws:ec2:instance", map[string]string{"schedule": "always"}, 730, "", ""},
+		{"window", "aws:ec2:instance", map[string]string{"schedule": "mon-fri 07:00-19:00"}, 60 * weeksPerMonth, "mon-fri 07:00-19:00", ""},
+		{"overnight window", "aws:ec2:instance", map[string]string{"schedule": "daily 22:00-02:00"}, 28 * weeksPerMonth, "daily 22:00-02:00", ""},
+		{"overlapping windows count once", "aws:ec2:instance", map[string]string{"schedule": "mon 08:00-12:00; mon,sat 10:00-14:00"}, 10 * weeksPerMonth, "mon 08:00-12:00; mon,sat 10:00-14:00", ""},
+		{"wrapping day range", "aws:ec2:instance", map[string]string{"schedule": "sat-mon 00:00-24:00"}, 72 * weeksPerMonth, "sat-mon 00:00-24:00", ""},
+		{"full week window", "aws:ec2:instance", map[string]string{"schedule": "daily 00:00-24:00"}, 730, "", ""},
+		{"hours per month", "aws:ec2:instance", map[string]string{"hours_per_month": "200"}, 200, "hours_per_month", ""},
+		{"hours per month wins", "aws:ec2:instance", map[string]string{"hours_per_month": "100", "schedule": "weekdays"}, 100, "hours_per_month", ""},
+		{"instance scheduler name", "aws:ec2:instance", map[string]string{"Schedule": "office-hours"}, 40 * weeksPerMonth, "office-hours", ""},
+		{"custom instance scheduler name ignored", "aws:ec2:instance", map[string]string{"Schedule": "dev-nightly-stop"}, 730, "", ""},
+		{"storage is not scheduled", "aws:ebs:volume", map[string]string{"schedule": "business_hours"}, 730, "", ""},
+		{"brokers that cannot be stopped", "aws:msk:cluster", map[string]string{"schedule": "business_hours"}, 730, "", ""},
+		{"unknown classification", "aws:unknown:thing", map[string]string{"hours_per_month": "10"}, 730, "", ""},
+		{"hours per month out of range", "aws:ec2:instance", map[string]string{"hours_per_month": "800"}, 0, "", "between 0 and 730"},
+		{"hours per month not a number", "aws:ec2:instance", map[string]string{"hours_per_month": "lots"}, 0, "", "between 0 and 730"},
+		{"unknown schedule", "aws:ec2:instance", map[string]string{"schedule": "nightly"}, 0, "", "must be"},
+		{"unknown day", "aws:ec2:instance", map[string]string{"schedule": "mon-fry 08:00-18:00"}, 0, "", "unknown day"},
+		{"invalid time", "aws:ec2:instance", map[string]string{"schedule": "mon 08:00-25:00"}, 0, "", "invalid time"},
+		{"empty window", "aws:ec2:instance", map[string]string{"schedule": "mon 08:00-08:00"}, 0, "", "is empty"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schedule, err := resolveUsageSchedule(tt.classification, tt.tags)
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantHours, schedule.hours, 1e-9)
+			assert.Equal(t, tt.wantName, schedule.name)
+		})
+	}
+}
+
+// TestGetProjectedCost_UsageSchedule verifies scheduled resources are billed for
+// their running hours while storage keeps accruing in full.
+func TestGetProjectedCost_UsageSchedule(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	ctx := context.Background()
+	businessHours := 40 * weeksPerMonth
+
+	tests := []struct {
+		name         string
+		resourceType string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		unscheduled  bool
+	}{
+		{
+			name:         "EC2 business hours",
+			resourceType: "aws:ec2/instance:Instance",
+			sku:          "t3.micro",
+			wantCost:     0.0104 * businessHours,
+		},
+		{
+			name:         "RDS instance scheduled, storage in full",
+			resourceType: "aws:rds/instance:Instance",
+			sku:          "db.t3.micro",
+			tags:         map[string]string{"storage_size": "100"},
+			wantCost:     0.017*businessHours + 100*0.115,
+		},
+		{
+			name:         "EKS control plane",
+			resourceType: "aws:eks/cluster:Cluster",
+			sku:          "cluster",
+			wantCost:     0.10 * businessHours,
+		},
+		{
+			name:         "ALB hourly and LCU",
+			resourceType: "aws:lb/loadBalancer:LoadBalancer",
+			sku:          "alb",
+			tags:         map[string]string{"lcu_per_hour": "2"},
+			wantCost:     (0.0225 + 2*0.008) * businessHours,
+		},
+		{
+			name:         "NAT gateway hours, data processing in full",
+			resourceType: "aws:ec2/natGateway:NatGateway",
+			sku:          "nat",
+			tags:         map[string]string{"data_processed_gb": "100"},
+			wantCost:     0.045*businessHours + 100*0.045,
+		},
+		{
+			name:         "ElastiCache nodes",
+			resourceType: "aws:elasticache/cluster:Cluster",
+			sku:          "cache.m5.large",
+			tags:         map[string]string{"num_nodes": "2"},
+			wantCost:     2 * 0.156 * businessHours,
+		},
+		{
+			name:         "Redshift paused nodes, managed storage in full",
+			resourceType: "aws:redshift/cluster:Cluster",
+			sku:          "ra3.large",
+			tags:         map[string]string{"number_of_nodes": "2", "managed_storage_gb": "100"},
+			wantCost:     2*0.543*businessHours + 100*0.024,
+		},
+		{
+			name:         "DocumentDB stopped instances, storage in full",
+			resourceType: "aws:docdb/cluster:Cluster",
+			sku:          "db.r5.large",
+			tags:         map[string]string{"storage_gb": "100", "io_requests_per_month": "0"},
+			wantCost:     0.277*businessHours + 100*0.10,
+		},
+		{
+			name:         "Neptune cluster instance",
+			resourceType: "aws:neptune/clusterInstance:ClusterInstance",
+			sku:          "db.r5.large",
+			wantCost:     0.348 * businessHours,
+		},
+		{
+			name:         "SageMaker stopped notebook, storage in full",
+			resourceType: "aws:sagemaker/notebookInstance:NotebookInstance",
+			sku:          "ml.t3.medium",
+			tags:         map[string]string{"volume_size": "5"},
+			wantCost:     0.05*businessHours + 5*0.14,
+		},
+		{
+			name:         "OpenSearch domain cannot be stopped",
+			resourceType: "aws:opensearch/domain:Domain",
+			sku:          "r6g.large.search",
+			tags:         map[string]string{"instance_count": "1", "volume_size": "0"},
+			wantCost:     0.167 * 730,
+			unscheduled:  true,
+		},
+		{
+			name:         "SageMaker endpoint cannot be stopped",
+			resourceType: "aws:sagemaker/endpoint:Endpoint",
+			sku:          "ml.m5.large",
+			wantCost:     0.115 * 730,
+			unscheduled:  true,
+		},
+		{
+			name:         "Global Accelerator is billed while provisioned",
+			resourceType: "aws:globalaccelerator/accelerator:Accelerator",
+			sku:          "default",
+			tags:         map[string]string{"data_transfer_premium_gb_per_month": "0"},
+			wantCost:     0.025 * 730,
+			unscheduled:  true,
+		},
+		{
+			name:         "EBS volume is not scheduled",
+			resourceType: "aws:ebs/volume:Volume",
+			sku:          "gp3",
+			tags:         map[string]string{"size": "100"},
+			wantCost:     100 * 0.08,
+			unscheduled:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tags := map[string]string{"schedule": "business_hours"}
+			for k, v := range tt.tags {
+				tags[k] = v
+			}
+			resp, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     providerAWS,
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+
+			var sum float64
+			for _, component := range costComponentsFromMetadata(resp.GetMetadata()) {
+				sum += component.Subtotal
+			}
+			assert.InDelta(t, resp.GetCostPerMonth(), sum, 1e-9)
+
+			if tt.unscheduled {
+				assert.NotContains(t, resp.GetMetadata(), metadataKeyUsageSchedule)
+				return
+			}
+			assert.Equal(t, "business_hours", resp.GetMetadata()[metadataKeyUsageSchedule])
+			assert.Equal(t, "173.81", resp.GetMetadata()[metadataKeyHoursPerMonth])
+			assert.Contains(t, resp.GetBillingDetail(), "173.8 hrs/month (business_hours schedule)")
+		})
+	}
+
+	t.Run("invalid schedule", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     providerAWS,
+				ResourceType: "aws:ec2/instance:Instance",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"hours_per_month": "-1"},
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
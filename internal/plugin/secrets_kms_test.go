@@ -0,0 +1,245 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGetProjectedCost_SecretsManager verifies the per-secret monthly fee and
+// API call charges, including defaults tracking when the usage tag is absent.
+func TestGetProjectedCost_SecretsManager(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name        string
+		tags        map[string]string
+		wantCost    float64
+		wantQuality string
+	}{
+		{
+			name:        "secret fee only (no tag)",
+			tags:        nil,
+			wantCost:    0.40,
+			wantQuality: qualityLow,
+		},
+		{
+			name:        "secret fee plus 100k API calls",
+			tags:        map[string]string{"api_calls_per_month": "100000"},
+			wantCost:    0.40 + 100000*0.000005,
+			wantQuality: "",
+		},
+		{
+			name:        "invalid tag falls back to default",
+			tags:        map[string]string{"api_calls_per_month": "-5"},
+			wantCost:    0.40,
+			wantQuality: qualityLow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:secretsmanager/secret:Secret",
+					Sku:          "secret",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.InDelta(t, 0.40, resp.GetUnitPrice(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "Secrets Manager secret")
+			assert.Equal(t, tt.wantQuality, resp.GetMetadata()[metadataKeyEstimateQuality])
+		})
+	}
+}
+
+// TestGetProjectedCost_KMS verifies the per-key monthly fee and request charges.
+func TestGetProjectedCost_KMS(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name        string
+		tags        map[string]string
+		wantCost    float64
+		wantDefault string
+	}{
+		{
+			name:        "key fee only (no tag)",
+			tags:        nil,
+			wantCost:    1.00,
+			wantDefault: "api_calls_per_month=0",
+		},
+		{
+			name:        "key fee plus 1M requests",
+			tags:        map[string]string{"api_calls_per_month": "1000000"},
+			wantCost:    1.00 + 1000000*0.000003,
+			wantDefault: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:kms/key:Key",
+					Sku:          "symmetric",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), "KMS customer managed key")
+			assert.Equal(t, tt.wantDefault, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestGetProjectedCost_SecretsKMS_PricingUnavailable verifies a $0 response with an
+// explanatory billing detail when the region has no embedded pricing.
+func TestGetProjectedCost_SecretsKMS_PricingUnavailable(t *testing.T) {
+	mock := newMockPricingClient("us-east-1", "USD")
+	plugin := NewAWSPublicPlugin("us-east-1", "test-version", mock, zerolog.Nop())
+
+	for _, rt := range []string{"aws:secretsmanager/secret:Secret", "aws:kms/key:Key"} {
+		t.Run(rt, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: rt,
+					Sku:          "default",
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.Zero(t, resp.GetCostPerMonth())
+			assert.Contains(t, resp.GetBillingDetail(), "pricing data not available")
+		})
+	}
+}
+
+// TestDetectService_SecretsKMS verifies Pulumi resource types route to the new
+// services, and the versions, policies and aliases configuring a secret or key
+// route to the zero-cost configuration service.
+func TestDetectService_SecretsKMS(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"aws:secretsmanager/secret:Secret", serviceSecrets},
+		{"aws:secretsmanager/secretVersion:SecretVersion", serviceConfigOnly},
+		{"aws:secretsmanager/secretPolicy:SecretPolicy", serviceConfigOnly},
+		{"aws:secretsmanager/secretRotation:SecretRotation", serviceConfigOnly},
+		{"secretsmanager", serviceSecrets},
+		{"aws:kms/key:Key", serviceKMS},
+		{"aws:kms/replicaKey:ReplicaKey", serviceKMS},
+		{"aws:kms/alias:Alias", serviceConfigOnly},
+		{"aws:kms/grant:Grant", serviceConfigOnly},
+		{"aws:kms/keyPolicy:KeyPolicy", serviceConfigOnly},
+		{"kms", serviceKMS},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectService(normalizeResourceType(tt.resourceType)))
+		})
+	}
+}
+
+// TestSupports_SecretsKMS verifies both services are supported without carbon metrics.
+func TestSupports_SecretsKMS(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	for _, rt := range []string{"aws:secretsmanager/secret:Secret", "aws:kms/key:Key"} {
+		t.Run(rt, func(t *testing.T) {
+			resp, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: rt,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.True(t, resp.GetSupported())
+			assert.Nil(t, resp.GetSupportedMetrics())
+		})
+	}
+}
+
+// TestGetProjectedCost_SecretsKMSConfiguration verifies a stack with a secret
+// and its version is billed for one secret, and a key alias adds no key fee.
+func TestGetProjectedCost_SecretsKMSConfiguration(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		want         float64
+	}{
+		{"aws:secretsmanager/secret:Secret", 0.40},
+		{"aws:secretsmanager/secretVersion:SecretVersion", 0},
+		{"aws:kms/key:Key", 1.00},
+		{"aws:kms/alias:Alias", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resource := &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: tt.resourceType,
+				Sku:          "default",
+				Region:       "us-east-1",
+				Tags:         map[string]string{"api_calls_per_month": "0"},
+			}
+			supports, err := plugin.Supports(context.Background(), &pbc.SupportsRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.True(t, supports.GetSupported())
+
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{Resource: resource})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.want, resp.GetCostPerMonth(), 1e-9, resp.GetBillingDetail())
+		})
+	}
+}
+
+// TestGetPricingSpec_SecretsKMS verifies pricing specs expose the monthly rate and request pricing.
+func TestGetPricingSpec_SecretsKMS(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		wantRate     float64
+		wantUnit     string
+		wantAssume   string
+	}{
+		{"aws:secretsmanager/secret:Secret", 0.40, "secret-month", "API calls: $0.0500 per 10,000 requests"},
+		{"aws:kms/key:Key", 1.00, "key-month", "Symmetric requests: $0.0300 per 10,000 requests"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          "default",
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			require.NotNil(t, resp.GetSpec())
+			assert.Equal(t, "per_month_plus_requests", resp.GetSpec().GetBillingMode())
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-9)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+			assert.Contains(t, resp.GetSpec().GetAssumptions(), tt.wantAssume)
+		})
+	}
+}
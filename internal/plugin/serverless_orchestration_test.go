@@ -0,0 +1,305 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TestGetProjectedCost_LambdaAddOns verifies provisioned concurrency, ephemeral storage
+// and SnapStart are added on top of request and duration charges.
+func TestGetProjectedCost_LambdaAddOns(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	const (
+		reqPrice   = 0.0000002
+		gbSecPrice = 0.0000166667
+		seconds    = 730 * 3600.0
+	)
+	// 1024MB, 1M requests of 1s = 1,000,000 GB-seconds
+	baseTags := map[string]string{"requests_per_month": "1000000", "avg_duration_ms": "1000"}
+	baseCost := 1000000*reqPrice + 1000000*gbSecPrice
+
+	withTags := func(extra map[string]string) map[string]string {
+		tags := map[string]string{}
+		for k, v := range baseTags {
+			tags[k] = v
+		}
+		for k, v := range extra {
+			tags[k] = v
+		}
+		return tags
+	}
+
+	allocated := 1 * 1.0 * seconds // 1 concurrency × 1 GB × month
+
+	tests := []struct {
+		name       string
+		tags       map[string]string
+		wantCost   float64
+		wantDetail string
+	}{
+		{
+			name:     "no add-ons",
+			tags:     withTags(nil),
+			wantCost: baseCost,
+		},
+		{
+			name: "provisioned concurrency re-prices covered duration",
+			tags: withTags(map[string]string{"provisioned_concurrency": "1"}),
+			wantCost: 1000000*reqPrice + allocated*0.0000041667 +
+				1000000*0.0000097222,
+			wantDetail: "provisioned concurrency 1",
+		},
+		{
+			name:       "ephemeral storage above 512MB",
+			tags:       withTags(map[string]string{"ephemeral_storage_mb": "2560"}),
+			wantCost:   baseCost + 2.0*1.0*1000000*0.0000000309,
+			wantDetail: "2560MB ephemeral storage",
+		},
+		{
+			name:       "SnapStart cache and restores",
+			tags:       withTags(map[string]string{"snapstart": "true", "snapstart_restores_per_month": "1000"}),
+			wantCost:   baseCost + 1.0*seconds*0.0000015046 + 1000*1.0*0.0001397998,
+			wantDetail: "SnapStart",
+		},
+		{
+			name:     "SnapStart on Java is free",
+			tags:     withTags(map[string]string{"snapstart": "true", "runtime": "java21"}),
+			wantCost: baseCost,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:lambda/function:Function",
+					Sku:          "1024",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-6)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+		})
+	}
+
+	t.Run("SnapStart without restores is low quality", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:lambda/function:Function",
+				Sku:          "1024",
+				Region:       "us-east-1",
+				Tags:         withTags(map[string]string{"snapstart": "true", "arch": "x86_64"}),
+			},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "snapstart_restores_per_month=0", resp.GetMetadata()[metadataKeyDefaultsApplied])
+		assert.Equal(t, qualityLow, resp.GetMetadata()[metadataKeyEstimateQuality])
+	})
+
+	t.Run("ephemeral storage out of range is rejected", func(t *testing.T) {
+		_, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "aws:lambda/function:Function",
+				Sku:          "1024",
+				Region:       "us-east-1",
+				Tags:         withTags(map[string]string{"ephemeral_storage_mb": "20480"}),
+			},
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetProjectedCost_StepFunctions verifies Standard transition pricing and Express
+// request plus rounded GB-second pricing.
+func TestGetProjectedCost_StepFunctions(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		sku          string
+		tags         map[string]string
+		wantCost     float64
+		wantDetail   string
+		wantDefaults string
+	}{
+		{
+			name:         "standard defaults",
+			sku:          "default",
+			tags:         nil,
+			wantCost:     0,
+			wantDetail:   "Standard workflow",
+			wantDefaults: "type=STANDARD,state_transitions_per_month=0",
+		},
+		{
+			name:       "standard transitions",
+			sku:        "STANDARD",
+			tags:       map[string]string{"state_transitions_per_month": "1000000"},
+			wantCost:   1000000 * 0.000025,
+			wantDetail: "1000000 state transitions/month",
+		},
+		{
+			// 150ms → 200ms, 100MB → 128MB: 1M × 0.125GB × 0.2s = 25,000 GB-s
+			name: "express with rounding",
+			sku:  "express-workflow",
+			tags: map[string]string{
+				"type":               "EXPRESS",
+				"requests_per_month": "1000000",
+				"avg_duration_ms":    "150",
+				"memory_mb":          "100",
+			},
+			wantCost:   1000000*0.000001 + 25000*0.00001667,
+			wantDetail: "Express workflow",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: "aws:sfn/stateMachine:StateMachine",
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.Contains(t, resp.GetBillingDetail(), tt.wantDetail)
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestGetProjectedCost_EventBridge verifies custom bus and Pipes pricing with 64 KB chunking.
+func TestGetProjectedCost_EventBridge(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		name         string
+		resourceType string
+		tags         map[string]string
+		wantCost     float64
+		wantUnit     float64
+		wantDefaults string
+	}{
+		{
+			name:         "event bus without usage",
+			resourceType: "aws:cloudwatch/eventBus:EventBus",
+			tags:         nil,
+			wantCost:     0,
+			wantUnit:     0.000001,
+			wantDefaults: "events_per_month=0",
+		},
+		{
+			name:         "event bus with 100KB events",
+			resourceType: "aws:cloudwatch/eventBus:EventBus",
+			tags:         map[string]string{"events_per_month": "5000000", "avg_event_size_kb": "100"},
+			wantCost:     5000000 * 2 * 0.000001,
+			wantUnit:     0.000001,
+		},
+		{
+			name:         "pipe requests",
+			resourceType: "aws:pipes/pipe:Pipe",
+			tags:         map[string]string{"requests_per_month": "10000000"},
+			wantCost:     10000000 * 0.0000004,
+			wantUnit:     0.0000004,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          "default",
+					Region:       "us-east-1",
+					Tags:         tt.tags,
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantCost, resp.GetCostPerMonth(), 1e-9)
+			assert.InDelta(t, tt.wantUnit, resp.GetUnitPrice(), 1e-12)
+			assert.Contains(t, resp.GetBillingDetail(), "EventBridge")
+			assert.Equal(t, tt.wantDefaults, resp.GetMetadata()[metadataKeyDefaultsApplied])
+		})
+	}
+}
+
+// TestDetectService_Orchestration verifies Step Functions and EventBridge resource types
+// route correctly, including event buses under Pulumi's cloudwatch module.
+func TestDetectService_Orchestration(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		want         string
+	}{
+		{"aws:sfn/stateMachine:StateMachine", serviceStepFuncs},
+		{"sfn", serviceStepFuncs},
+		{"aws:cloudwatch/eventBus:EventBus", serviceEventBridge},
+		{"aws:pipes/pipe:Pipe", serviceEventBridge},
+		{"eventbridge", serviceEventBridge},
+		{"aws:cloudwatch/logGroup:LogGroup", serviceCloudWatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType, func(t *testing.T) {
+			assert.Equal(t, tt.want, detectService(normalizeResourceType(tt.resourceType)))
+		})
+	}
+}
+
+// TestGetProjectedCost_StepFunctionsConfiguration verifies activities and aliases
+// are not priced as state machines.
+func TestGetProjectedCost_StepFunctionsConfiguration(t *testing.T) {
+	assertConfigurationOnly(t,
+		"aws:sfn/activity:Activity",
+		"aws:sfn/alias:Alias",
+	)
+}
+
+// TestGetPricingSpec_Orchestration verifies Step Functions and EventBridge pricing specs.
+func TestGetPricingSpec_Orchestration(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+
+	tests := []struct {
+		resourceType string
+		sku          string
+		wantRate     float64
+		wantUnit     string
+	}{
+		{"aws:sfn/stateMachine:StateMachine", "STANDARD", 0.000025, "state-transition"},
+		{"aws:sfn/stateMachine:StateMachine", "EXPRESS", 0.000001, "request"},
+		{"aws:cloudwatch/eventBus:EventBus", "default", 0.000001, "event"},
+		{"aws:pipes/pipe:Pipe", "default", 0.0000004, "request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.sku, func(t *testing.T) {
+			resp, err := plugin.GetPricingSpec(context.Background(), &pbc.GetPricingSpecRequest{
+				Resource: &pbc.ResourceDescriptor{
+					Provider:     "aws",
+					ResourceType: tt.resourceType,
+					Sku:          tt.sku,
+					Region:       "us-east-1",
+				},
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.wantRate, resp.GetSpec().GetRatePerUnit(), 1e-12)
+			assert.Equal(t, tt.wantUnit, resp.GetSpec().GetUnit())
+		})
+	}
+}
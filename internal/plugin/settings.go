@@ -0,0 +1,254 @@
+package plugin
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+)
+
+// metadataKeyUsageAssumptions lists the usage tags filled in from the usage
+// defaults of the configuration file, as sorted comma-separated key=value
+// pairs, e.g. "requests_per_month=1000000".
+const metadataKeyUsageAssumptions = "usage_assumptions"
+
+// metadataKeyEmbodiedCarbonGrams is the embodied carbon included in an EC2
+// carbon footprint when embodied carbon is enabled in the configuration file.
+const metadataKeyEmbodiedCarbonGrams = "embodied_carbon_grams"
+
+// pluginSettings are the plugin settings read from the environment and the
+// configuration file. They are replaced as a whole when the configuration file
+// is reloaded, so each setting read sees a complete, validated configuration.
+type pluginSettings struct {
+	testMode         bool             // verbose request logging
+	maxBatchSize     int              // max target resources of a recommendations request
+	strictValidation bool             // fail-fast on invalid resources in recommendations
+	freshness        freshnessPolicy  // price data age thresholds
+	accountFreeTier  bool             // allocate the AWS Free Tier across BatchCost projections
+	budgetFiles      budget.Files     // locally declared budgets for GetBudgets
+	allocation       allocationPolicy // tag keys propagated for cost allocation
+	utilization      float64          // CPU utilization assumed when a request sets none; 0 for the carbon default
+	embodiedCarbon   bool             // add embodied carbon to EC2 carbon footprints
+
+	// usageDefaults are the usage tags assumed for resources missing them, by estimator.
+	usageDefaults map[serviceEstimator]map[string]string
+}
+
+// newSettings returns the settings of a configuration file, nil for none.
+// Environment variables that are set take precedence over the file, so a
+// configuration file never changes the behavior of an existing deployment.
+// Returns an error when the usage defaults name an unknown service.
+func newSettings(logger zerolog.Logger, cfg *config.Config) (*pluginSettings, error) {
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+
+	testMode := IsTestMode()
+	if !envSet(testModeEnvVar, testModeEnvVarDeprecated, testModeEnvVarLegacy) && cfg.TestMode != nil {
+		testMode = *cfg.TestMode
+	}
+
+	strictValidation := false
+	if val, _, found := getEnvWithDeprecation(
+		logger,
+		EnvStrictValidation,
+		EnvStrictValidationDeprecated,
+		EnvStrictValidationLegacy,
+	); found {
+		strictValidation = parseBoolVal(val)
+	} else if cfg.Recommendations.StrictValidation != nil {
+		strictValidation = *cfg.Recommendations.StrictValidation
+	}
+
+	accountFreeTier := false
+	if val := os.Getenv(EnvAccountFreeTier); val != "" {
+		accountFreeTier = parseBoolVal(val)
+	} else if cfg.Pricing.AccountFreeTier != nil {
+		accountFreeTier = *cfg.Pricing.AccountFreeTier
+	}
+
+	usageDefaults, err := resolveUsageDefaults(cfg.UsageDefaults)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := &pluginSettings{
+		testMode:         testMode,
+		maxBatchSize:     parseMaxBatchSize(logger, cfg.Recommendations.MaxBatchSize),
+		strictValidation: strictValidation,
+		freshness:        parseFreshnessPolicy(logger, cfg.Pricing),
+		accountFreeTier:  accountFreeTier,
+		budgetFiles:      cfg.Budgets.Files(),
+		allocation:       parseAllocationPolicy(logger, cfg.Allocation.Tags),
+		usageDefaults:    usageDefaults,
+	}
+	if cfg.Carbon.Utilization != nil {
+		settings.utilization = *cfg.Carbon.Utilization
+	}
+	if cfg.Carbon.Embodied != nil {
+		settings.embodiedCarbon = *cfg.Carbon.Embodied
+	}
+	return settings, nil
+}
+
+// parseMaxBatchSize reads the recommendations batch size from the environment,
+// falling back to the configuration file and then to defaultMaxBatchSize.
+// Sizes above maxMaxBatchSize are capped.
+func parseMaxBatchSize(logger zerolog.Logger, fromFile *int) int {
+	maxBatchSize := defaultMaxBatchSize
+	if fromFile != nil {
+		maxBatchSize = *fromFile
+	}
+
+	// Check for batch size (new variable takes precedence over deprecated)
+	val, varName, found := getEnvWithDeprecation(
+		logger,
+		EnvMaxBatchSize,
+		EnvMaxBatchSizeDeprecated,
+		EnvMaxBatchSizeLegacy,
+	)
+	if !found {
+		return maxBatchSize
+	}
+	n, err := strconv.Atoi(val)
+	if err != nil || n <= 0 {
+		logger.Warn().
+			Str("variable", varName).
+			Str("value", val).
+			Msg("invalid batch size value, using default")
+		return maxBatchSize
+	}
+	if n > maxMaxBatchSize {
+		logger.Warn().
+			Str("variable", varName).
+			Int("requested", n).
+			Int("max_allowed", maxMaxBatchSize).
+			Msg("requested batch size exceeds maximum, capping")
+		return maxMaxBatchSize
+	}
+	return n
+}
+
+// envSet reports whether any of the named environment variables is set.
+func envSet(names ...string) bool {
+	return slices.ContainsFunc(names, func(name string) bool { return os.Getenv(name) != "" })
+}
+
+// resolveUsageDefaults keys the usage defaults of the configuration file by
+// the estimator of their service, so service aliases share their defaults.
+func resolveUsageDefaults(defaults map[string]map[string]float64) (map[serviceEstimator]map[string]string, error) {
+	resolved := make(map[serviceEstimator]map[string]string, len(defaults))
+	for _, service := range slices.Sorted(maps.Keys(defaults)) {
+		canonical, ok := canonicalService(strings.ToLower(strings.TrimSpace(service)))
+		if !ok {
+			return nil, fmt.Errorf("usage_defaults: unknown service %q", service)
+		}
+		estimator, _ := lookupEstimator(canonical)
+		tags := resolved[estimator]
+		if tags == nil {
+			tags = make(map[string]string, len(defaults[service]))
+			resolved[estimator] = tags
+		}
+		for tag, value := range defaults[service] {
+			tags[tag] = strconv.FormatFloat(value, 'f', -1, 64)
+		}
+	}
+	return resolved, nil
+}
+
+// ValidateConfig checks a configuration file applies to this plugin, beyond
+// the checks of config.Validate: its usage defaults must name known services.
+func ValidateConfig(cfg *config.Config) error {
+	_, err := resolveUsageDefaults(cfg.UsageDefaults)
+	return err
+}
+
+// config returns the current settings of the plugin.
+func (p *AWSPublicPlugin) config() *pluginSettings {
+	return p.settings.Load()
+}
+
+// ApplyConfig replaces the plugin settings with those of a validated
+// configuration file, nil for none. In-flight requests finish with the
+// previous settings. The settings are unchanged when the file is invalid.
+func (p *AWSPublicPlugin) ApplyConfig(cfg *config.Config) error {
+	settings, err := newSettings(p.logger, cfg)
+	if err != nil {
+		return err
+	}
+	p.settings.Store(settings)
+	p.logger.Info().
+		Int("max_batch_size", settings.maxBatchSize).
+		Int("pricing_stale_after_days", settings.freshness.staleAfterDays).
+		Bool("account_free_tier", settings.accountFreeTier).
+		Bool("embodied_carbon", settings.embodiedCarbon).
+		Msg("configuration applied")
+	return nil
+}
+
+// withUsageDefaults fills in the usage tags a resource is missing from the
+// configured usage defaults, returning the tags it assumed as sorted key=value
+// pairs. The resource is cloned, never modified.
+func withUsageDefaults(
+	resource *pbc.ResourceDescriptor,
+	defaults map[string]string,
+) (*pbc.ResourceDescriptor, []string) {
+	var assumed []string
+	var tags map[string]string
+	for _, key := range slices.Sorted(maps.Keys(defaults)) {
+		if _, ok := resource.GetTags()[key]; ok {
+			continue
+		}
+		if tags == nil {
+			tags = make(map[string]string, len(resource.GetTags())+len(defaults))
+			maps.Copy(tags, resource.GetTags())
+		}
+		tags[key] = defaults[key]
+		assumed = append(assumed, key+"="+defaults[key])
+	}
+	if assumed == nil {
+		return resource, nil
+	}
+	clone, _ := proto.Clone(resource).(*pbc.ResourceDescriptor)
+	clone.Tags = tags
+	return clone, assumed
+}
+
+// withDefaultUtilization returns the request with the configured utilization
+// when it sets none. The request is cloned, never modified.
+func withDefaultUtilization(req *pbc.GetProjectedCostRequest, utilization float64) *pbc.GetProjectedCostRequest {
+	if utilization <= 0 || req.GetUtilizationPercentage() > 0 {
+		return req
+	}
+	clone, _ := proto.Clone(req).(*pbc.GetProjectedCostRequest)
+	if clone == nil {
+		clone = &pbc.GetProjectedCostRequest{}
+	}
+	clone.UtilizationPercentage = utilization
+	return clone
+}
+
+// attachUsageAssumptions records the usage tags assumed from the usage
+// defaults. Assumed usage is not measured, so the estimate quality is at most
+// medium.
+func attachUsageAssumptions(resp *pbc.GetProjectedCostResponse, assumed []string) {
+	if resp == nil || len(assumed) == 0 {
+		return
+	}
+	if resp.Metadata == nil {
+		resp.Metadata = make(map[string]string, 2)
+	}
+	resp.Metadata[metadataKeyUsageAssumptions] = strings.Join(assumed, ",")
+	if quality := resp.Metadata[metadataKeyEstimateQuality]; quality == "" || quality == qualityHigh {
+		resp.Metadata[metadataKeyEstimateQuality] = qualityMedium
+	}
+}
@@ -0,0 +1,179 @@
+package plugin
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
+)
+
+// updateSettings replaces the plugin settings with a modified copy.
+func updateSettings(plugin *AWSPublicPlugin, update func(s *pluginSettings)) {
+	settings := *plugin.config()
+	update(&settings)
+	plugin.settings.Store(&settings)
+}
+
+// parseConfig parses a configuration file, failing the test when it is invalid.
+func parseConfig(t *testing.T, data string) *config.Config {
+	t.Helper()
+	cfg, err := config.Parse([]byte(data))
+	require.NoError(t, err)
+	return cfg
+}
+
+// TestNewSettings_ConfigFile verifies configuration file settings apply when
+// their environment variables are unset, and environment variables win otherwise.
+func TestNewSettings_ConfigFile(t *testing.T) {
+	for _, name := range []string{
+		EnvMaxBatchSize, EnvMaxBatchSizeDeprecated, EnvMaxBatchSizeLegacy,
+		EnvStrictValidation, EnvStrictValidationDeprecated, EnvStrictValidationLegacy,
+		EnvPricingStaleAfterDays, EnvPricingMaxAgeDays, EnvAccountFreeTier, EnvAllocationTags,
+		budget.EnvBudgetsFile, budget.EnvResourcesFile,
+		testModeEnvVar, testModeEnvVarDeprecated, testModeEnvVarLegacy,
+	} {
+		t.Setenv(name, "")
+	}
+	cfg := parseConfig(t, `
+test_mode: true
+recommendations: {max_batch_size: 25, strict_validation: true}
+pricing: {stale_after_days: 30, max_age_days: 180, account_free_tier: true}
+allocation: {tags: [Owner]}
+budgets: {file: budgets.yaml, resources_file: resources.json}
+carbon: {utilization: 0.3, embodied: true}
+`)
+
+	settings, err := newSettings(zerolog.Nop(), cfg)
+	require.NoError(t, err)
+	assert.True(t, settings.testMode)
+	assert.Equal(t, 25, settings.maxBatchSize)
+	assert.True(t, settings.strictValidation)
+	assert.Equal(t, freshnessPolicy{staleAfterDays: 30, maxAgeDays: 180}, settings.freshness)
+	assert.True(t, settings.accountFreeTier)
+	assert.Equal(t, []string{"owner"}, settings.allocation.keys)
+	assert.Equal(t, budget.Files{Budgets: "budgets.yaml", Resources: "resources.json"}, settings.budgetFiles)
+	assert.InDelta(t, 0.3, settings.utilization, 1e-9)
+	assert.True(t, settings.embodiedCarbon)
+
+	t.Setenv(testModeEnvVar, "false")
+	t.Setenv(EnvMaxBatchSize, "50")
+	t.Setenv(EnvStrictValidation, "false")
+	t.Setenv(EnvPricingStaleAfterDays, "60")
+	t.Setenv(EnvAccountFreeTier, "false")
+	t.Setenv(EnvAllocationTags, "team")
+	t.Setenv(budget.EnvBudgetsFile, "env-budgets.yaml")
+
+	settings, err = newSettings(zerolog.Nop(), cfg)
+	require.NoError(t, err)
+	assert.False(t, settings.testMode)
+	assert.Equal(t, 50, settings.maxBatchSize)
+	assert.False(t, settings.strictValidation)
+	assert.Equal(t, freshnessPolicy{staleAfterDays: 60, maxAgeDays: 180}, settings.freshness)
+	assert.False(t, settings.accountFreeTier)
+	assert.Equal(t, []string{"team"}, settings.allocation.keys)
+	assert.Equal(t, budget.Files{Budgets: "env-budgets.yaml", Resources: "resources.json"}, settings.budgetFiles)
+}
+
+// TestApplyConfig verifies a configuration replaces the plugin settings, and
+// an invalid one leaves them unchanged.
+func TestApplyConfig(t *testing.T) {
+	t.Setenv(EnvMaxBatchSize, "")
+	plugin := createConformanceMockPlugin("us-east-1")
+	require.Equal(t, defaultMaxBatchSize, plugin.config().maxBatchSize)
+
+	require.NoError(t, plugin.ApplyConfig(parseConfig(t, `recommendations: {max_batch_size: 10}`)))
+	assert.Equal(t, 10, plugin.config().maxBatchSize)
+
+	err := plugin.ApplyConfig(parseConfig(t, `usage_defaults: {mainframe: {mips: 100}}`))
+	require.ErrorContains(t, err, `unknown service "mainframe"`)
+	assert.Equal(t, 10, plugin.config().maxBatchSize)
+
+	require.NoError(t, plugin.ApplyConfig(nil))
+	assert.Equal(t, defaultMaxBatchSize, plugin.config().maxBatchSize)
+}
+
+// TestUsageDefaults verifies configured usage fills in missing usage tags,
+// is reported as assumed, and never overrides a resource's own tags.
+func TestUsageDefaults(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	require.NoError(t, plugin.ApplyConfig(parseConfig(t, `
+usage_defaults:
+  Lambda: {requests_per_month: 1000000, avg_duration_ms: 100}
+`)))
+
+	project := func(tags map[string]string) *pbc.GetProjectedCostResponse {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "lambda",
+				Sku:          "128",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	assumed := project(nil)
+	assert.InDelta(t, 0.41, assumed.GetCostPerMonth(), 0.01)
+	assert.Equal(t, "avg_duration_ms=100,requests_per_month=1000000", assumed.GetMetadata()[metadataKeyUsageAssumptions])
+	assert.Equal(t, qualityMedium, assumed.GetMetadata()[metadataKeyEstimateQuality])
+
+	partial := project(map[string]string{"requests_per_month": "2000000"})
+	assert.Equal(t, "avg_duration_ms=100", partial.GetMetadata()[metadataKeyUsageAssumptions])
+	assert.Greater(t, partial.GetCostPerMonth(), assumed.GetCostPerMonth())
+
+	explicit := project(map[string]string{"requests_per_month": "1000000", "avg_duration_ms": "100"})
+	assert.NotContains(t, explicit.GetMetadata(), metadataKeyUsageAssumptions)
+	assert.InDelta(t, assumed.GetCostPerMonth(), explicit.GetCostPerMonth(), 1e-9)
+}
+
+// TestCarbonSettings verifies the configured utilization applies to requests
+// setting none, and embodied carbon is added to EC2 footprints when enabled
+// regardless of the instance's usage schedule.
+func TestCarbonSettings(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	carbonOfTagged := func(utilization float64, tags map[string]string) (float64, map[string]string) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: &pbc.ResourceDescriptor{
+				Provider:     "aws",
+				ResourceType: "ec2",
+				Sku:          "t3.micro",
+				Region:       "us-east-1",
+				Tags:         tags,
+			},
+			UtilizationPercentage: utilization,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetImpactMetrics(), 1)
+		return resp.GetImpactMetrics()[0].GetValue(), resp.GetMetadata()
+	}
+	carbonOf := func(utilization float64) (float64, map[string]string) {
+		return carbonOfTagged(utilization, nil)
+	}
+
+	base, md := carbonOf(0)
+	assert.NotContains(t, md, metadataKeyEmbodiedCarbonGrams)
+	atTenPercent, _ := carbonOf(0.1)
+
+	require.NoError(t, plugin.ApplyConfig(parseConfig(t, `carbon: {utilization: 0.1}`)))
+	configured, _ := carbonOf(0)
+	assert.InDelta(t, atTenPercent, configured, 1e-9)
+	requested, _ := carbonOf(0.5)
+	assert.InDelta(t, base, requested, 1e-9)
+
+	require.NoError(t, plugin.ApplyConfig(parseConfig(t, `carbon: {embodied: true}`)))
+	withEmbodied, md := carbonOf(0)
+	require.Contains(t, md, metadataKeyEmbodiedCarbonGrams)
+	assert.Greater(t, withEmbodied, base)
+
+	_, scheduledMD := carbonOfTagged(0, map[string]string{"schedule": "business_hours"})
+	assert.Equal(t, md[metadataKeyEmbodiedCarbonGrams], scheduledMD[metadataKeyEmbodiedCarbonGrams])
+}
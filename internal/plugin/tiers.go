@@ -0,0 +1,132 @@
+package plugin
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// metadataKeyAggregateTiers lists the tiered components of a projected cost
+// that were re-priced on batch totals, as comma-separated
+// "dimension=total_quantity@blended_rate" entries.
+const metadataKeyAggregateTiers = "aggregate_tiers"
+
+// aggregateTier is a tiered charge whose tiers apply to the account-wide
+// monthly total of a service in a region rather than to each resource.
+type aggregateTier struct {
+	service   string
+	dimension string // cost component dimension priced with the tiers
+	perSKU    bool   // totals are kept per component SKU, each with its own tiers
+
+	// tiers returns the tiers of a component SKU; SKU-independent tiers
+	// ignore it.
+	tiers func(client pricing.PricingClient, sku string) ([]pricing.TierRate, bool)
+}
+
+// anySKU adapts the tiers of a charge that does not depend on the SKU.
+func anySKU(
+	tiers func(pricing.PricingClient) ([]pricing.TierRate, bool),
+) func(pricing.PricingClient, string) ([]pricing.TierRate, bool) {
+	return func(client pricing.PricingClient, _ string) ([]pricing.TierRate, bool) {
+		return tiers(client)
+	}
+}
+
+// aggregateTiers lists the tiered charges priced by the estimators. Each
+// estimator prices its own quantity from the first tier, as if the resource
+// were the only one in the account.
+var aggregateTiers = []aggregateTier{
+	{serviceCloudWatch, "log_ingestion", false, anySKU(pricing.PricingClient.CloudWatchLogsIngestionTiers)},
+	{serviceCloudWatch, "custom_metrics", false, anySKU(pricing.PricingClient.CloudWatchMetricsTiers)},
+	{serviceStepFuncs, "duration", false, anySKU(pricing.PricingClient.StepFunctionsExpressDurationTiers)},
+	// S3 storage is tiered per storage class; the component SKU is the class.
+	{serviceS3, "storage", true, pricing.PricingClient.S3StorageTiers},
+}
+
+// tierGroup identifies the resources whose quantities share a tiered total.
+type tierGroup struct {
+	region string
+	sku    string
+}
+
+// tierConsumer is one resource's cost component priced with a tiered rate.
+type tierConsumer struct {
+	result    int // index of the batch result
+	component int // index of the component in the result's cost components
+}
+
+// applyAggregateTiers re-prices the tiered charges of a batch's projected costs
+// on the batch totals. For each tiered dimension, the quantities of all
+// resources of the service in the same region are summed and priced through
+// the tiers; each resource is then charged its quantity at the resulting
+// blended rate, so the subtotals add up to the cost of the total. Per-SKU
+// charges are summed separately for each SKU, such as each S3 storage class.
+func (p *AWSPublicPlugin) applyAggregateTiers(traceID string, results []*pbc.ResourceCostResult) {
+	responses, services := batchProjections(results)
+	components := make([][]CostComponent, len(results))
+	for i, resp := range responses {
+		if resp != nil {
+			components[i] = costComponentsFromMetadata(resp.GetMetadata())
+		}
+	}
+
+	repriced := make(map[int][]string)
+	for _, tier := range aggregateTiers {
+		byGroup := make(map[tierGroup][]tierConsumer)
+		totals := make(map[tierGroup]float64)
+		for i := range responses {
+			if services[i] != tier.service {
+				continue
+			}
+			region := results[i].GetResource().GetRegion()
+			for j, component := range components[i] {
+				if component.Dimension == tier.dimension && component.Quantity > 0 {
+					group := tierGroup{region: region}
+					if tier.perSKU {
+						group.sku = component.SKU
+					}
+					byGroup[group] = append(byGroup[group], tierConsumer{result: i, component: j})
+					totals[group] += component.Quantity
+				}
+			}
+		}
+
+		for group, consumers := range byGroup {
+			if len(consumers) < 2 {
+				continue // a single consumer is already priced on the total
+			}
+			tiers, found := tier.tiers(p.pricing, group.sku)
+			if !found {
+				continue
+			}
+			total := totals[group]
+			blended := calculateTieredCost(total, tiers) / total
+			for _, consumer := range consumers {
+				component := &components[consumer.result][consumer.component]
+				resp := responses[consumer.result]
+				subtotal := component.Quantity * blended
+				resp.CostPerMonth += subtotal - component.Subtotal
+				component.Rate = blended
+				component.Subtotal = subtotal
+				repriced[consumer.result] = append(repriced[consumer.result], fmt.Sprintf("%s=%s@%s",
+					tier.dimension, strconv.FormatFloat(total, 'f', -1, 64), strconv.FormatFloat(blended, 'g', 6, 64)))
+			}
+		}
+	}
+
+	for i, entries := range repriced {
+		resp := responses[i]
+		setCostComponents(resp, components[i])
+		resp.Metadata[metadataKeyAggregateTiers] = strings.Join(entries, ",")
+		resp.BillingDetail += " (tiered rates from batch totals)"
+	}
+
+	p.traceLogger(traceID, "BatchCost").Debug().
+		Int("resources", len(results)).
+		Int("repriced_resources", len(repriced)).
+		Msg("aggregate tiers applied")
+}
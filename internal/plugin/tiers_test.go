@@ -0,0 +1,118 @@
+package plugin
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	pbc "github.com/rshade/finfocus-spec/sdk/go/proto/finfocus/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/pricing"
+)
+
+// TestBatchCost_AggregateTiers verifies tiered charges are priced on the batch
+// totals and allocated back to each resource at the blended rate.
+func TestBatchCost_AggregateTiers(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	plugin.pricing.(*mockPricingClient).cwMetricsTiers = []pricing.TierRate{
+		{UpTo: 10_000, Rate: 0.30},
+		{UpTo: math.MaxFloat64, Rate: 0.10},
+	}
+	metrics := func(id, count string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Id:           id,
+			Provider:     providerAWS,
+			ResourceType: "aws:cloudwatch/metricAlarm:MetricAlarm",
+			Sku:          "metrics",
+			Region:       "us-east-1",
+			Tags:         map[string]string{"custom_metrics": count},
+		}
+	}
+	batch := &pbc.BatchCostRequest{
+		Resources: []*pbc.ResourceDescriptor{metrics("a", "8000"), metrics("b", "2000")},
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	}
+
+	t.Run("batch total within the first tier", func(t *testing.T) {
+		resp, err := plugin.BatchCost(context.Background(), batch)
+		require.NoError(t, err)
+		for _, result := range resp.GetResults() {
+			assert.Contains(t, result.GetCostData().GetProjectedCost().GetMetadata(), metadataKeyAggregateTiers)
+		}
+		assert.InDelta(t, 8000*0.30, resp.GetResults()[0].GetCostData().GetProjectedCost().GetCostPerMonth(), 1e-6)
+	})
+
+	t.Run("batch total crosses a tier boundary", func(t *testing.T) {
+		batch.Resources[1] = metrics("b", "8000")
+		resp, err := plugin.BatchCost(context.Background(), batch)
+		require.NoError(t, err)
+
+		// 16,000 metrics: 10,000 @ $0.30 + 6,000 @ $0.10 = $3,600, or $0.225 each.
+		for _, result := range resp.GetResults() {
+			projected := result.GetCostData().GetProjectedCost()
+			assert.InDelta(t, 8000*0.225, projected.GetCostPerMonth(), 1e-6)
+			assert.Equal(t, "custom_metrics=16000@0.225", projected.GetMetadata()[metadataKeyAggregateTiers])
+
+			components := costComponentsFromMetadata(projected.GetMetadata())
+			require.Len(t, components, 1)
+			assert.InDelta(t, 0.225, components[0].Rate, 1e-12)
+			assert.InDelta(t, projected.GetCostPerMonth(), components[0].Subtotal, 1e-9)
+		}
+	})
+
+	t.Run("single resources keep their own tiers", func(t *testing.T) {
+		resp, err := plugin.GetProjectedCost(context.Background(), &pbc.GetProjectedCostRequest{
+			Resource: metrics("a", "8000"),
+		})
+		require.NoError(t, err)
+		assert.InDelta(t, 8000*0.30, resp.GetCostPerMonth(), 1e-6)
+		assert.NotContains(t, resp.GetMetadata(), metadataKeyAggregateTiers)
+	})
+}
+
+// TestBatchCost_S3StorageTiers verifies S3 storage is priced on the batch total
+// of each storage class, so buckets that are each under 50 TB reach the next
+// tier together.
+func TestBatchCost_S3StorageTiers(t *testing.T) {
+	plugin := createConformanceMockPlugin("us-east-1")
+	mock := plugin.pricing.(*mockPricingClient)
+	mock.s3Prices["STANDARD_IA"] = 0.0125
+	mock.s3Tiers = map[string][]pricing.TierRate{"STANDARD": s3StandardTiers}
+	bucket := func(id, storageClass, size string) *pbc.ResourceDescriptor {
+		return &pbc.ResourceDescriptor{
+			Id:           id,
+			Provider:     providerAWS,
+			ResourceType: "aws:s3/bucket:Bucket",
+			Sku:          storageClass,
+			Region:       "us-east-1",
+			Tags:         map[string]string{"size": size},
+		}
+	}
+
+	resp, err := plugin.BatchCost(context.Background(), &pbc.BatchCostRequest{
+		Resources: []*pbc.ResourceDescriptor{
+			bucket("a", "STANDARD", "30720"),
+			bucket("b", "STANDARD", "30720"),
+			bucket("c", "STANDARD_IA", "30720"),
+		},
+		QueryType: pbc.CostQueryType_COST_QUERY_TYPE_PROJECTED,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.GetResults(), 3)
+
+	// 60 TB of S3 Standard: 51,200 GB @ $0.023 + 10,240 GB @ $0.022, split evenly.
+	want := (51_200*0.023 + 10_240*0.022) / 2
+	for _, result := range resp.GetResults()[:2] {
+		projected := result.GetCostData().GetProjectedCost()
+		assert.InDelta(t, want, projected.GetCostPerMonth(), 1e-6)
+		assert.Contains(t, projected.GetMetadata()[metadataKeyAggregateTiers], "storage=61440@")
+		assert.Contains(t, projected.GetBillingDetail(), "tiered rates from batch totals")
+	}
+
+	// Other storage classes are not added to the S3 Standard total.
+	infrequent := resp.GetResults()[2].GetCostData().GetProjectedCost()
+	assert.InDelta(t, 30_720*0.0125, infrequent.GetCostPerMonth(), 1e-6)
+	assert.NotContains(t, infrequent.GetMetadata(), metadataKeyAggregateTiers)
+}
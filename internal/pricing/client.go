@@ -46,6 +46,16 @@ var elasticacheEngineNormalization = map[string]string{
 	"valkey":    "Valkey",
 }
 
+// openSearchStorageMediaNormalization maps AWS OpenSearch storageMedia attribute
+// values to the EBS volume type names users specify in ebsOptions.
+var openSearchStorageMediaNormalization = map[string]string{
+	"GP2":           "gp2",
+	"GP3":           "gp3",
+	"PIOPS":         "io1",
+	"PIOPS-Storage": "io1",
+	"Magnetic":      "standard",
+}
+
 // PricingClient provides pricing data lookups.
 type PricingClient interface {
 	// Region returns the AWS region for this pricing data.
@@ -66,6 +76,10 @@ type PricingClient interface {
 	// Returns (price, true) if found, (0, false) if not found.
 	S3PricePerGBMonth(storageClass string) (float64, bool)
 
+	// S3StorageTiers returns the volume tiers of an S3 storage class, by GB
+	// stored per month. Returns (nil, false) when the class has a single rate.
+	S3StorageTiers(storageClass string) ([]TierRate, bool)
+
 	// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
 	// instanceType: e.g., "db.t3.medium"
 	// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
@@ -91,6 +105,11 @@ type PricingClient interface {
 	// Returns (price, true) if found, (0, false) if not found.
 	LambdaPricePerGBSecond(arch string) (float64, bool)
 
+	// LambdaAddOnPrices returns provisioned concurrency, ephemeral storage and SnapStart rates.
+	// arch: "x86_64" or "arm64" (ARM falls back to x86 rates where no ARM rate is published)
+	// Returns (price, true) if any add-on rate is found, (nil, false) otherwise.
+	LambdaAddOnPrices(arch string) (*LambdaAddOnPrice, bool)
+
 	// DynamoDBOnDemandReadPrice returns the cost per read request unit.
 	// Returns (price, true) if found, (0, false) if not found.
 	DynamoDBOnDemandReadPrice() (float64, bool)
@@ -148,6 +167,226 @@ type PricingClient interface {
 	// engine: "redis", "memcached", or "valkey" (case-insensitive)
 	// Returns (price, true) if found, (0, false) if not found.
 	ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool)
+
+	// SecretsManagerPricePerSecretMonth returns the monthly rate for a single stored secret.
+	// Returns (price, true) if found, (0, false) if not found.
+	SecretsManagerPricePerSecretMonth() (float64, bool)
+
+	// SecretsManagerPricePerAPIRequest returns the cost per Secrets Manager API request.
+	// Returns (price, true) if found, (0, false) if not found.
+	SecretsManagerPricePerAPIRequest() (float64, bool)
+
+	// KMSPricePerKeyMonth returns the monthly rate for a customer managed KMS key.
+	// Returns (price, true) if found, (0, false) if not found.
+	KMSPricePerKeyMonth() (float64, bool)
+
+	// KMSPricePerRequest returns the cost per KMS cryptographic request (paid tier).
+	// Returns (price, true) if found, (0, false) if not found.
+	KMSPricePerRequest() (float64, bool)
+
+	// OpenSearchInstancePricePerHour returns the hourly rate for an OpenSearch Service node.
+	// instanceType: e.g., "r6g.large.search", "ultrawarm1.medium.search"
+	// Returns (price, true) if found, (0, false) if not found.
+	OpenSearchInstancePricePerHour(instanceType string) (float64, bool)
+
+	// OpenSearchStoragePricePerGBMonth returns the EBS storage rate for OpenSearch data nodes.
+	// volumeType: "gp2", "gp3", "io1", or "standard"
+	// Returns (price, true) if found, (0, false) if not found.
+	OpenSearchStoragePricePerGBMonth(volumeType string) (float64, bool)
+
+	// OpenSearchServerlessOCUPricePerHour returns the rate per OpenSearch Compute Unit hour.
+	// Returns (price, true) if found, (0, false) if not found.
+	OpenSearchServerlessOCUPricePerHour() (float64, bool)
+
+	// RedshiftNodePricePerHour returns the hourly rate for a provisioned Redshift node.
+	// nodeType: e.g., "ra3.xlplus", "dc2.large"
+	// Returns (price, true) if found, (0, false) if not found.
+	RedshiftNodePricePerHour(nodeType string) (float64, bool)
+
+	// RedshiftManagedStoragePricePerGBMonth returns the Redshift Managed Storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	RedshiftManagedStoragePricePerGBMonth() (float64, bool)
+
+	// RedshiftServerlessPricePerRPUHour returns the Redshift Serverless compute rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	RedshiftServerlessPricePerRPUHour() (float64, bool)
+
+	// ECRStoragePricePerGBMonth returns the ECR private repository storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	ECRStoragePricePerGBMonth() (float64, bool)
+
+	// BackupStoragePricePerGBMonth returns the AWS Backup storage rate for a protected
+	// resource type and storage tier.
+	// resourceType: "EBS", "EFS", "RDS", "DynamoDB", "S3" (case-insensitive)
+	// tier: "warm" or "cold"
+	// Returns (price, true) if found, (0, false) if not found.
+	BackupStoragePricePerGBMonth(resourceType, tier string) (float64, bool)
+
+	// StepFunctionsPricePerStateTransition returns the Standard workflow rate per state transition.
+	// Returns (price, true) if found, (0, false) if not found.
+	StepFunctionsPricePerStateTransition() (float64, bool)
+
+	// StepFunctionsExpressPricePerRequest returns the Express workflow rate per request.
+	// Returns (price, true) if found, (0, false) if not found.
+	StepFunctionsExpressPricePerRequest() (float64, bool)
+
+	// StepFunctionsExpressDurationTiers returns the tiered Express workflow rate per GB-second.
+	// Returns (tiers, true) if found, (nil, false) if not found.
+	StepFunctionsExpressDurationTiers() ([]TierRate, bool)
+
+	// EventBridgePricePerCustomEvent returns the rate per custom event published to an event bus.
+	// Returns (price, true) if found, (0, false) if not found.
+	EventBridgePricePerCustomEvent() (float64, bool)
+
+	// EventBridgePipesPricePerRequest returns the rate per EventBridge Pipes request.
+	// Returns (price, true) if found, (0, false) if not found.
+	EventBridgePipesPricePerRequest() (float64, bool)
+
+	// DocumentDBInstancePricePerHour returns the hourly rate for an Amazon DocumentDB instance.
+	// instanceType: e.g., "db.r6g.large", "db.t4g.medium"
+	// Returns (price, true) if found, (0, false) if not found.
+	DocumentDBInstancePricePerHour(instanceType string) (float64, bool)
+
+	// DocumentDBStoragePricePerGBMonth returns the DocumentDB cluster storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	DocumentDBStoragePricePerGBMonth() (float64, bool)
+
+	// DocumentDBPricePerIORequest returns the DocumentDB rate per storage I/O request.
+	// Returns (price, true) if found, (0, false) if not found.
+	DocumentDBPricePerIORequest() (float64, bool)
+
+	// DocumentDBBackupPricePerGBMonth returns the DocumentDB backup storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	DocumentDBBackupPricePerGBMonth() (float64, bool)
+
+	// NeptuneInstancePricePerHour returns the hourly rate for an Amazon Neptune instance.
+	// instanceType: e.g., "db.r6g.large", "db.t4g.medium"
+	// Returns (price, true) if found, (0, false) if not found.
+	NeptuneInstancePricePerHour(instanceType string) (float64, bool)
+
+	// NeptuneStoragePricePerGBMonth returns the Neptune cluster storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	NeptuneStoragePricePerGBMonth() (float64, bool)
+
+	// NeptunePricePerIORequest returns the Neptune rate per storage I/O request.
+	// Returns (price, true) if found, (0, false) if not found.
+	NeptunePricePerIORequest() (float64, bool)
+
+	// NeptuneBackupPricePerGBMonth returns the Neptune backup storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	NeptuneBackupPricePerGBMonth() (float64, bool)
+
+	// MemoryDBNodePricePerHour returns the hourly rate for an Amazon MemoryDB node.
+	// nodeType: e.g., "db.r6g.large", "db.t4g.small"
+	// Returns (price, true) if found, (0, false) if not found.
+	MemoryDBNodePricePerHour(nodeType string) (float64, bool)
+
+	// MemoryDBDataWrittenPricePerGB returns the MemoryDB rate per GB of data written.
+	// Returns (price, true) if found, (0, false) if not found.
+	MemoryDBDataWrittenPricePerGB() (float64, bool)
+
+	// MemoryDBSnapshotPricePerGBMonth returns the MemoryDB snapshot storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	MemoryDBSnapshotPricePerGBMonth() (float64, bool)
+
+	// MSKBrokerPricePerHour returns the hourly rate for an Amazon MSK broker.
+	// instanceType: e.g., "kafka.m5.large", "kafka.m7g.large"
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKBrokerPricePerHour(instanceType string) (float64, bool)
+
+	// MSKStoragePricePerGBMonth returns the MSK broker storage rate per GB-month.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKStoragePricePerGBMonth() (float64, bool)
+
+	// MSKThroughputPricePerMBpsMonth returns the MSK provisioned storage throughput
+	// rate per MB/s-month.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKThroughputPricePerMBpsMonth() (float64, bool)
+
+	// MSKServerlessClusterPricePerHour returns the MSK Serverless cluster-hour rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKServerlessClusterPricePerHour() (float64, bool)
+
+	// MSKServerlessPartitionPricePerHour returns the MSK Serverless partition-hour rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKServerlessPartitionPricePerHour() (float64, bool)
+
+	// MSKServerlessDataInPricePerGB returns the MSK Serverless rate per GB written.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKServerlessDataInPricePerGB() (float64, bool)
+
+	// MSKServerlessDataOutPricePerGB returns the MSK Serverless rate per GB read.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKServerlessDataOutPricePerGB() (float64, bool)
+
+	// MSKServerlessStoragePricePerGBMonth returns the MSK Serverless storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	MSKServerlessStoragePricePerGBMonth() (float64, bool)
+
+	// SageMakerHostingPricePerHour returns the hourly rate for a SageMaker real-time
+	// inference (endpoint) instance.
+	// instanceType: e.g., "ml.m5.large", "ml.g5.xlarge"
+	// Returns (price, true) if found, (0, false) if not found.
+	SageMakerHostingPricePerHour(instanceType string) (float64, bool)
+
+	// SageMakerNotebookPricePerHour returns the hourly rate for a SageMaker notebook instance.
+	// instanceType: e.g., "ml.t3.medium"
+	// Returns (price, true) if found, (0, false) if not found.
+	SageMakerNotebookPricePerHour(instanceType string) (float64, bool)
+
+	// SageMakerNotebookStoragePricePerGBMonth returns the notebook ML storage rate.
+	// Returns (price, true) if found, (0, false) if not found.
+	SageMakerNotebookStoragePricePerGBMonth() (float64, bool)
+
+	// SageMakerServerlessPricePerGBSecond returns the Serverless Inference compute
+	// rate per GB-second of configured memory.
+	// Returns (price, true) if found, (0, false) if not found.
+	SageMakerServerlessPricePerGBSecond() (float64, bool)
+
+	// SageMakerServerlessDataPricePerGB returns the Serverless Inference rate per GB
+	// of data processed in and out.
+	// Returns (price, true) if found, (0, false) if not found.
+	SageMakerServerlessDataPricePerGB() (float64, bool)
+
+	// WAFPricePerWebACLMonth returns the monthly fee per AWS WAF web ACL.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerWebACLMonth() (float64, bool)
+
+	// WAFPricePerRuleMonth returns the monthly fee per rule in a web ACL.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerRuleMonth() (float64, bool)
+
+	// WAFPricePerRequest returns the cost per web request inspected.
+	// Returns (price, true) if found, (0, false) if not found.
+	WAFPricePerRequest() (float64, bool)
+
+	// ShieldAdvancedPricePerMonth returns the Shield Advanced subscription fee.
+	// Returns (price, true) if found, (0, false) if not found.
+	ShieldAdvancedPricePerMonth() (float64, bool)
+
+	// GlobalAcceleratorPricePerHour returns the fixed fee per accelerator-hour.
+	// Returns (price, true) if found, (0, false) if not found.
+	GlobalAcceleratorPricePerHour() (float64, bool)
+
+	// GlobalAcceleratorDTPremiumPricePerGB returns the Data Transfer-Premium rate
+	// between two geographies.
+	// source, destination: e.g., "North America", "Europe", "Asia Pacific"
+	// Returns (price, true) if found, (0, false) if not found.
+	GlobalAcceleratorDTPremiumPricePerGB(source, destination string) (float64, bool)
+
+	// PriceVintages returns the offer code, version and publication date of every
+	// parsed price list, sorted by offer code.
+	PriceVintages() []PriceVintage
+
+	// RateSource returns the product SKU, rate code and price list vintage behind
+	// the rate looked up by key (a cost component SKU such as "t3.micro/Linux/Shared")
+	// in the given offer. Returns the vintage alone and false if the key is unknown.
+	RateSource(offerCode, key string) (RateSource, bool)
+
+	// PriceListAges returns how old every parsed price list is at now, based on
+	// its publication date, sorted by offer code. Price lists without a
+	// parseable publication date are omitted.
+	PriceListAges(now time.Time) []PriceListAge
 }
 
 // Client implements PricingClient with embedded JSON data.
@@ -175,6 +414,9 @@ type Client struct {
 	// Lambda pricing (single rate per region)
 	lambdaPricing *lambdaPrice
 
+	// Lambda add-on rates (key: price list group, e.g., "AWS-Lambda-Provisioned-Concurrency-ARM")
+	lambdaAddOnRates map[string]float64
+
 	// DynamoDB pricing (single rate per region)
 	dynamoDBPricing *dynamoDBPrice
 
@@ -189,6 +431,62 @@ type Client struct {
 
 	// ElastiCache pricing index (key: "instanceType:engine", e.g., "cache.m5.large:Redis")
 	elasticacheIndex map[string]elasticacheInstancePrice
+
+	// Secrets Manager pricing (single rate per region)
+	secretsManagerPricing *secretsManagerPrice
+
+	// KMS pricing (single rate per region)
+	kmsPricing *kmsPrice
+
+	// OpenSearch pricing indexes (key: instanceType for nodes, volumeType for storage)
+	openSearchInstanceIndex map[string]openSearchInstancePrice
+	openSearchStorageIndex  map[string]openSearchStoragePrice
+
+	// OpenSearch Serverless pricing (single OCU rate per region)
+	openSearchServerlessPricing *openSearchServerlessPrice
+
+	// Redshift pricing (key: node type for provisioned clusters; storage and RPU rates are single per region)
+	redshiftNodeIndex map[string]redshiftNodePrice
+	redshiftPricing   *redshiftPrice
+
+	// ECR pricing (single storage rate per region)
+	ecrPricing *ecrPrice
+
+	// AWS Backup pricing (key: "<resourceType>/<tier>", lowercase, e.g., "efs/cold")
+	backupStorageIndex map[string]backupStoragePrice
+
+	// Step Functions pricing (single rate set per region)
+	stepFunctionsPricing *stepFunctionsPrice
+
+	// EventBridge pricing (single rate set per region)
+	eventBridgePricing *eventBridgePrice
+
+	// DocumentDB and Neptune pricing (instance rates keyed by instance class)
+	docDBPricing   *clusterDatabasePrice
+	neptunePricing *clusterDatabasePrice
+
+	// MemoryDB pricing (node rates keyed by node type)
+	memoryDBPricing *memoryDBPrice
+
+	// MSK pricing (broker rates keyed by instance type, plus Serverless rates)
+	mskPricing *mskPrice
+
+	// SageMaker pricing (hosting and notebook rates keyed by ML instance type)
+	sageMakerPricing *sageMakerPrice
+
+	// AWS WAF pricing (web ACL, rule and request rates)
+	wafPricing *wafPrice
+
+	// Shield Advanced pricing (subscription fee)
+	shieldPricing *shieldPrice
+
+	// Global Accelerator pricing (fixed fee plus DT-Premium keyed by geography pair)
+	globalAcceleratorPricing *globalAcceleratorPrice
+
+	// Price list provenance (key: offer code; rate sources keyed by lowercase lookup key)
+	provenanceMu sync.RWMutex
+	vintages     map[string]PriceVintage
+	rateSources  map[string]map[string]rateRef
 }
 
 // NewClient creates a Client from embedded rawPricingJSON.
@@ -217,12 +515,16 @@ func (c *Client) init() error { //nolint:gocognit,funlen
 		// Pre-allocate map capacities based on typical AWS pricing data volumes.
 		// Capacity estimates derived from us-east-1 (largest region) with ~20-30% buffer for growth.
 		// See GitHub issue #176 for sizing rationale.
-		c.ec2Index = make(map[string]ec2Price, 100000)                       // ~90k EC2 products
-		c.ebsIndex = make(map[string]ebsPrice, 50)                           // ~20-30 volume types
-		c.s3Index = make(map[string]s3Price, 100)                            // ~50-100 storage classes
-		c.rdsInstanceIndex = make(map[string]rdsInstancePrice, 5000)         // instance×engine combos
-		c.rdsStorageIndex = make(map[string]rdsStoragePrice, 100)            // storage types
-		c.elasticacheIndex = make(map[string]elasticacheInstancePrice, 1000) // node×engine combos
+		c.ec2Index = make(map[string]ec2Price, 100000)                            // ~90k EC2 products
+		c.ebsIndex = make(map[string]ebsPrice, 50)                                // ~20-30 volume types
+		c.s3Index = make(map[string]s3Price, 100)                                 // ~50-100 storage classes
+		c.rdsInstanceIndex = make(map[string]rdsInstancePrice, 5000)              // instance×engine combos
+		c.rdsStorageIndex = make(map[string]rdsStoragePrice, 100)                 // storage types
+		c.elasticacheIndex = make(map[string]elasticacheInstancePrice, 1000)      // node×engine combos
+		c.openSearchInstanceIndex = make(map[string]openSearchInstancePrice, 200) // ~150 node types
+		c.openSearchStorageIndex = make(map[string]openSearchStoragePrice, 10)    // volume types
+		c.redshiftNodeIndex = make(map[string]redshiftNodePrice, 20)              // dc2/ra3 node types
+		c.backupStorageIndex = make(map[string]backupStoragePrice, 30)            // resource type×tier combos
 
 		// Parse each service file in parallel for faster initialization.
 		// Each parser writes to its own dedicated index(es), so no locking needed.
@@ -246,7 +548,7 @@ func (c *Client) init() error { //nolint:gocognit,funlen
 		//   - Failure Policy: Initialization FAILS if pricing data cannot be loaded.
 		//   - Reasoning: Without EC2/EBS pricing, the plugin is functionally useless for most users.
 		//
-		// NON-CRITICAL services (S3, RDS, EKS, Lambda, DynamoDB, ELB, CloudWatch):
+		// NON-CRITICAL services (S3, RDS, EKS, Lambda, DynamoDB, ELB, CloudWatch, Secrets Manager, KMS):
 		//   - Definition: Specialized services, stubbed implementations, or secondary cost drivers.
 		//   - Failure Policy: Initialization CONTINUES with a warning log.
 		//   - Reasoning: A failure in a niche service should not prevent the plugin from estimating core resources.
@@ -335,6 +637,118 @@ func (c *Client) init() error { //nolint:gocognit,funlen
 			}
 		})
 
+		// 11. Parse Secrets Manager pricing
+		wg.Go(func() {
+			if _, err := c.parseSecretsManagerPricing(rawSecretsManagerJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Secrets Manager pricing")
+			}
+		})
+
+		// 12. Parse KMS pricing
+		wg.Go(func() {
+			if _, err := c.parseKMSPricing(rawKMSJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse KMS pricing")
+			}
+		})
+
+		// 13. Parse OpenSearch pricing
+		wg.Go(func() {
+			if _, err := c.parseOpenSearchPricing(rawOpenSearchJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse OpenSearch pricing")
+			}
+		})
+
+		// 14. Parse Redshift pricing
+		wg.Go(func() {
+			if _, err := c.parseRedshiftPricing(rawRedshiftJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Redshift pricing")
+			}
+		})
+
+		// 15. Parse ECR pricing
+		wg.Go(func() {
+			if _, err := c.parseECRPricing(rawECRJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse ECR pricing")
+			}
+		})
+
+		// 16. Parse AWS Backup pricing
+		wg.Go(func() {
+			if _, err := c.parseBackupPricing(rawBackupJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse AWS Backup pricing")
+			}
+		})
+
+		// 17. Parse Step Functions pricing
+		wg.Go(func() {
+			if _, err := c.parseStepFunctionsPricing(rawStepFunctionsJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Step Functions pricing")
+			}
+		})
+
+		// 18. Parse EventBridge pricing
+		wg.Go(func() {
+			if _, err := c.parseEventBridgePricing(rawEventBridgeJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse EventBridge pricing")
+			}
+		})
+
+		// 19. Parse DocumentDB pricing
+		wg.Go(func() {
+			if _, err := c.parseDocumentDBPricing(rawDocumentDBJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse DocumentDB pricing")
+			}
+		})
+
+		// 20. Parse Neptune pricing
+		wg.Go(func() {
+			if _, err := c.parseNeptunePricing(rawNeptuneJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Neptune pricing")
+			}
+		})
+
+		// 21. Parse MemoryDB pricing
+		wg.Go(func() {
+			if _, err := c.parseMemoryDBPricing(rawMemoryDBJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse MemoryDB pricing")
+			}
+		})
+
+		// 22. Parse MSK pricing
+		wg.Go(func() {
+			if _, err := c.parseMSKPricing(rawMSKJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse MSK pricing")
+			}
+		})
+
+		// 23. Parse SageMaker pricing
+		wg.Go(func() {
+			if _, err := c.parseSageMakerPricing(rawSageMakerJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse SageMaker pricing")
+			}
+		})
+
+		// 24. Parse AWS WAF pricing
+		wg.Go(func() {
+			if _, err := c.parseWAFPricing(rawWAFJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse WAF pricing")
+			}
+		})
+
+		// 25. Parse Shield pricing
+		wg.Go(func() {
+			if _, err := c.parseShieldPricing(rawShieldJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Shield pricing")
+			}
+		})
+
+		// 26. Parse Global Accelerator pricing
+		wg.Go(func() {
+			if _, err := c.parseGlobalAcceleratorPricing(rawGlobalAcceleratorJSON); err != nil {
+				c.logger.Error().Err(err).Msg("failed to parse Global Accelerator pricing")
+			}
+		})
+
 		// Wait for all parsing to complete
 		wg.Wait()
 
@@ -468,6 +882,128 @@ func (c *Client) init() error { //nolint:gocognit,funlen
 		if len(c.elasticacheIndex) == 0 {
 			c.logger.Warn().Str("region", c.region).Msg("ElastiCache pricing not loaded")
 		}
+
+		// Secrets Manager pricing validation
+		if c.secretsManagerPricing != nil {
+			warnMissing("SecretsManager", "SecretMonthlyRate", c.secretsManagerPricing.SecretMonthlyRate)
+			warnMissing("SecretsManager", "APIRequestRate", c.secretsManagerPricing.APIRequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Secrets Manager pricing not loaded")
+		}
+
+		// KMS pricing validation
+		if c.kmsPricing != nil {
+			warnMissing("KMS", "KeyMonthlyRate", c.kmsPricing.KeyMonthlyRate)
+			warnMissing("KMS", "RequestRate", c.kmsPricing.RequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("KMS pricing not loaded")
+		}
+
+		// OpenSearch pricing validation
+		if len(c.openSearchInstanceIndex) == 0 {
+			c.logger.Warn().Str("region", c.region).Msg("OpenSearch pricing not loaded")
+		}
+
+		// Redshift pricing validation
+		if len(c.redshiftNodeIndex) == 0 {
+			c.logger.Warn().Str("region", c.region).Msg("Redshift pricing not loaded")
+		}
+		if c.redshiftPricing != nil {
+			warnMissing("Redshift", "ManagedStorageRate", c.redshiftPricing.ManagedStorageRate)
+			warnMissing("Redshift", "ServerlessRPURate", c.redshiftPricing.ServerlessRPURate)
+		}
+
+		// ECR pricing validation
+		if c.ecrPricing == nil {
+			c.logger.Warn().Str("region", c.region).Msg("ECR pricing not loaded")
+		}
+
+		// AWS Backup pricing validation
+		if len(c.backupStorageIndex) == 0 {
+			c.logger.Warn().Str("region", c.region).Msg("AWS Backup pricing not loaded")
+		}
+
+		// Step Functions pricing validation
+		if c.stepFunctionsPricing != nil {
+			warnMissing("StepFunctions", "StandardTransitionRate", c.stepFunctionsPricing.StandardTransitionRate)
+			warnMissing("StepFunctions", "ExpressRequestRate", c.stepFunctionsPricing.ExpressRequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Step Functions pricing not loaded")
+		}
+
+		// EventBridge pricing validation
+		if c.eventBridgePricing != nil {
+			warnMissing("EventBridge", "CustomEventRate", c.eventBridgePricing.CustomEventRate)
+			warnMissing("EventBridge", "PipesRequestRate", c.eventBridgePricing.PipesRequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("EventBridge pricing not loaded")
+		}
+
+		// DocumentDB and Neptune pricing validation
+		for label, prices := range map[string]*clusterDatabasePrice{
+			"DocumentDB": c.docDBPricing,
+			"Neptune":    c.neptunePricing,
+		} {
+			if prices == nil || len(prices.InstanceRates) == 0 {
+				c.logger.Warn().Str("region", c.region).Msgf("%s pricing not loaded", label)
+				continue
+			}
+			warnMissing(label, "StorageRate", prices.StorageRate)
+			warnMissing(label, "IORate", prices.IORate)
+			warnMissing(label, "BackupRate", prices.BackupRate)
+		}
+
+		// MemoryDB pricing validation
+		if c.memoryDBPricing != nil && len(c.memoryDBPricing.NodeRates) > 0 {
+			warnMissing("MemoryDB", "DataWrittenRate", c.memoryDBPricing.DataWrittenRate)
+			warnMissing("MemoryDB", "SnapshotRate", c.memoryDBPricing.SnapshotRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("MemoryDB pricing not loaded")
+		}
+
+		// MSK pricing validation
+		if c.mskPricing != nil && len(c.mskPricing.BrokerRates) > 0 {
+			warnMissing("MSK", "StorageRate", c.mskPricing.StorageRate)
+			warnMissing("MSK", "ThroughputRate", c.mskPricing.ThroughputRate)
+			warnMissing("MSK", "ServerlessClusterRate", c.mskPricing.ServerlessClusterRate)
+			warnMissing("MSK", "ServerlessPartitionRate", c.mskPricing.ServerlessPartitionRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("MSK pricing not loaded")
+		}
+
+		// SageMaker pricing validation
+		if c.sageMakerPricing != nil && len(c.sageMakerPricing.HostingRates) > 0 {
+			if len(c.sageMakerPricing.NotebookRates) == 0 {
+				c.logger.Warn().Str("region", c.region).Msg("SageMaker notebook pricing not loaded")
+			}
+			warnMissing("SageMaker", "NotebookStorageRate", c.sageMakerPricing.NotebookStorageRate)
+			warnMissing("SageMaker", "ServerlessGBSecondRate", c.sageMakerPricing.ServerlessGBSecondRate)
+			warnMissing("SageMaker", "ServerlessDataRate", c.sageMakerPricing.ServerlessDataRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("SageMaker pricing not loaded")
+		}
+
+		// WAF pricing validation
+		if c.wafPricing != nil && c.wafPricing.WebACLRate > 0 {
+			warnMissing("WAF", "RuleRate", c.wafPricing.RuleRate)
+			warnMissing("WAF", "RequestRate", c.wafPricing.RequestRate)
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("WAF pricing not loaded")
+		}
+
+		// Shield pricing validation
+		if c.shieldPricing == nil || c.shieldPricing.MonthlyFee == 0 {
+			c.logger.Warn().Str("region", c.region).Msg("Shield pricing not loaded")
+		}
+
+		// Global Accelerator pricing validation
+		if c.globalAcceleratorPricing != nil && c.globalAcceleratorPricing.HourlyRate > 0 {
+			if len(c.globalAcceleratorPricing.DTPremiumRates) == 0 {
+				c.logger.Warn().Str("region", c.region).Msg("Global Accelerator DT-Premium pricing not loaded")
+			}
+		} else {
+			c.logger.Warn().Str("region", c.region).Msg("Global Accelerator pricing not loaded")
+		}
 	})
 	return c.err
 }
@@ -530,6 +1066,8 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 			Msg("EC2 pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	// Capture metadata for debugging (T034)
 	meta := &pricingMetadata{
 		Version:         pricing.Version,
@@ -566,6 +1104,7 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 						HourlyRate: rate,
 						Currency:   "USD",
 					}
+					sources.add(key, sku)
 				}
 			}
 		}
@@ -583,9 +1122,11 @@ func (c *Client) parseEC2Pricing(data []byte) (string, *pricingMetadata, error)
 					RatePerGBMonth: rate,
 					Currency:       "USD",
 				}
+				sources.add(volType, sku)
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, meta, nil
 }
 
@@ -605,6 +1146,8 @@ func (c *Client) parseS3Pricing(data []byte) (string, error) {
 			Msg("S3 pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -620,14 +1163,23 @@ func (c *Client) parseS3Pricing(data []byte) (string, error) {
 			}
 			rate, unit, found := getOnDemandPrice(&pricing, sku)
 			if found && unit == unitGBMonth {
-				c.s3Index[storageClass] = s3Price{
+				price := s3Price{
 					Unit:           unit,
 					RatePerGBMonth: rate,
 					Currency:       "USD",
 				}
+				// S3 Standard is tiered by volume (first 50 TB, next 450 TB,
+				// over 500 TB); the first tier is the rate of a single bucket.
+				if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 1 {
+					price.RatePerGBMonth = tiers[0].Rate
+					price.Tiers = tiers
+				}
+				c.s3Index[storageClass] = price
+				sources.add(storageClass, sku)
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -647,6 +1199,8 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) { //nolint:gocogni
 			Msg("RDS pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -670,6 +1224,7 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) { //nolint:gocogni
 						HourlyRate: rate,
 						Currency:   "USD",
 					}
+					sources.add(key, sku)
 				}
 			}
 		}
@@ -709,10 +1264,12 @@ func (c *Client) parseRDSPricing(data []byte) (string, error) { //nolint:gocogni
 						RatePerGBMonth: rate,
 						Currency:       "USD",
 					}
+					sources.add(apiVolType, sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -732,6 +1289,8 @@ func (c *Client) parseEKSPricing(data []byte) (string, error) { //nolint:gocogni
 			Msg("EKS pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -756,16 +1315,53 @@ func (c *Client) parseEKSPricing(data []byte) (string, error) { //nolint:gocogni
 			if found && isHourlyUnit(unit) && rate > 0 {
 				if operation == "ExtendedSupport" || strings.Contains(usageType, "extendedSupport") {
 					c.eksPricing.ExtendedHourlyRate = rate
+					sources.add("extended support", sku)
 				} else if operation == "CreateOperation" || strings.Contains(usageType, "perCluster") {
 					// Standard cluster pricing: operation=CreateOperation, usageType contains "perCluster"
 					c.eksPricing.StandardHourlyRate = rate
+					sources.add("standard support", sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
+// Lambda add-on price list groups. Each also has an "-ARM" variant for arm64.
+const (
+	lambdaGroupProvisionedConcurrency = "AWS-Lambda-Provisioned-Concurrency"
+	lambdaGroupProvisionedDuration    = "AWS-Lambda-Duration-Provisioned"
+	lambdaGroupEphemeralStorage       = "AWS-Lambda-Storage-Duration"
+	lambdaGroupSnapStartCache         = "AWS-Lambda-SnapStart-Cache"
+	lambdaGroupSnapStartRestore       = "AWS-Lambda-SnapStart-Restore"
+)
+
+// lambdaAddOnGroups is the set of Lambda add-on groups captured by parseLambdaPricing.
+var lambdaAddOnGroups = map[string]bool{
+	lambdaGroupProvisionedConcurrency: true,
+	lambdaGroupProvisionedDuration:    true,
+	lambdaGroupEphemeralStorage:       true,
+	lambdaGroupSnapStartCache:         true,
+	lambdaGroupSnapStartRestore:       true,
+}
+
+// Lambda architectures used as rate source keys for duration and add-on rates.
+const (
+	lambdaArchX86 = "x86_64"
+	lambdaArchARM = "arm64"
+)
+
+// lambdaAddOnKey returns the rate source key of a Lambda add-on price list group,
+// e.g., "provisioned-concurrency/arm64" for "AWS-Lambda-Provisioned-Concurrency-ARM".
+func lambdaAddOnKey(group string) string {
+	arch := lambdaArchX86
+	if base, isARM := strings.CutSuffix(group, "-ARM"); isARM {
+		group, arch = base, lambdaArchARM
+	}
+	return strings.ToLower(strings.TrimPrefix(group, "AWS-Lambda-")) + "/" + arch
+}
+
 // parseLambdaPricing parses Lambda pricing data.
 // Returns the detected region and any parsing error.
 func (c *Client) parseLambdaPricing(data []byte) (string, error) { //nolint:gocognit
@@ -782,6 +1378,8 @@ func (c *Client) parseLambdaPricing(data []byte) (string, error) { //nolint:goco
 			Msg("Lambda pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -804,14 +1402,24 @@ func (c *Client) parseLambdaPricing(data []byte) (string, error) { //nolint:goco
 				switch {
 				case group == "AWS-Lambda-Requests" && unit == "Requests":
 					c.lambdaPricing.RequestPrice = rate
+					sources.add("request", sku)
 				case group == "AWS-Lambda-Duration" && (unit == "Second" || unit == "Lambda-GB-Second"):
 					c.lambdaPricing.X86GBSecondPrice = rate
+					sources.add(lambdaArchX86, sku)
 				case group == "AWS-Lambda-Duration-ARM" && (unit == "Second" || unit == "Lambda-GB-Second"):
 					c.lambdaPricing.ARMGBSecondPrice = rate
+					sources.add(lambdaArchARM, sku)
+				case lambdaAddOnGroups[strings.TrimSuffix(group, "-ARM")] && rate > 0:
+					if c.lambdaAddOnRates == nil {
+						c.lambdaAddOnRates = make(map[string]float64, 2*len(lambdaAddOnGroups))
+					}
+					c.lambdaAddOnRates[group] = rate
+					sources.add(lambdaAddOnKey(group), sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -831,6 +1439,8 @@ func (c *Client) parseDynamoDBPricing(data []byte) (string, error) { //nolint:go
 			Msg("DynamoDB pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -854,25 +1464,31 @@ func (c *Client) parseDynamoDBPricing(data []byte) (string, error) { //nolint:go
 					switch group {
 					case "DDB-ReadUnits":
 						c.dynamoDBPricing.OnDemandReadPrice = rate
+						sources.add("on-demand-read", sku)
 					case "DDB-WriteUnits":
 						c.dynamoDBPricing.OnDemandWritePrice = rate
+						sources.add("on-demand-write", sku)
 					}
 				case prod.ProductFamily == "Provisioned IOPS" || strings.Contains(prod.ProductFamily, "Throughput"):
 					usageType := attrs["usagetype"]
 					if strings.Contains(usageType, "ReadCapacityUnit") && isHourlyUnit(unit) {
 						c.dynamoDBPricing.ProvisionedRCUPrice = rate
+						sources.add("provisioned-rcu", sku)
 					} else if strings.Contains(usageType, "WriteCapacityUnit") && isHourlyUnit(unit) {
 						c.dynamoDBPricing.ProvisionedWCUPrice = rate
+						sources.add("provisioned-wcu", sku)
 					}
 				case prod.ProductFamily == "Database Storage":
 					usageType := attrs["usagetype"]
 					if strings.Contains(usageType, "TimedStorage-ByteHrs") && unit == unitGBMonth {
 						c.dynamoDBPricing.StoragePrice = rate
+						sources.add("storage", sku)
 					}
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -892,6 +1508,8 @@ func (c *Client) parseELBPricing(data []byte) (string, error) { //nolint:gocogni
 			Msg("ELB pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -916,24 +1534,29 @@ func (c *Client) parseELBPricing(data []byte) (string, error) { //nolint:gocogni
 					if strings.HasSuffix(usageType, "LoadBalancerUsage") && !strings.Contains(usageType, "TS-") &&
 						isHourlyUnit(unit) {
 						c.elbPricing.ALBHourlyRate = rate
+						sources.add("alb", sku)
 					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Outposts-") && !strings.Contains(usageType, "Reserved") &&
 						unit == "LCU-Hrs" {
 						c.elbPricing.ALBLCURate = rate
+						sources.add("alb-lcu", sku)
 					}
 				case "Load Balancer-Network":
 					if strings.HasSuffix(usageType, "LoadBalancerUsage") && !strings.Contains(usageType, "TS-") &&
 						isHourlyUnit(unit) {
 						c.elbPricing.NLBHourlyRate = rate
+						sources.add("nlb", sku)
 					} else if strings.HasSuffix(usageType, "LCUUsage") && !strings.Contains(usageType, "Outposts-") && !strings.Contains(usageType, "Reserved") &&
 						unit == "LCU-Hrs" {
 						// AWS uses "LCUUsage" with "LCU-Hrs" for NLB capacity units too
 						// The description differentiates: "Network load balancer capacity unit-hour"
 						c.elbPricing.NLBNLCURate = rate
+						sources.add("nlb-nlcu", sku)
 					}
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -953,6 +1576,8 @@ func (c *Client) parseNATGatewayPricing(data []byte) (string, error) { //nolint:
 			Msg("VPC pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -974,15 +1599,18 @@ func (c *Client) parseNATGatewayPricing(data []byte) (string, error) { //nolint:
 			if found {
 				if strings.Contains(usageType, "NatGateway-Hours") && isHourlyUnit(unit) {
 					c.natGatewayPricing.HourlyRate = rate
+					sources.add("nat-gateway-hours", sku)
 				} else if strings.Contains(usageType, "NatGateway-Bytes") && (unit == "Quantity" || unit == "GB") {
 					// AWS Pricing API returns "Quantity" as the unit for NatGateway-Bytes,
 					// but the rate is actually per-GB (not per-byte). No conversion needed.
 					// See: specs/001-nat-gateway-cost/research.md for verification.
 					c.natGatewayPricing.DataProcessingRate = rate
+					sources.add("nat-gateway-bytes", sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -1008,6 +1636,8 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) { //nolint:
 			Msg("CloudWatch pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	c.cloudWatchPricing = &cloudWatchPrice{
 		Currency: "USD",
 	}
@@ -1035,6 +1665,7 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) { //nolint:
 				tiers := c.extractTieredPricing(&pricing, sku)
 				if len(tiers) > 0 {
 					c.cloudWatchPricing.LogsIngestionTiers = tiers
+					sources.add("logs-ingestion", sku)
 				}
 			}
 		}
@@ -1047,6 +1678,7 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) { //nolint:
 				rate, unit, found := getOnDemandPrice(&pricing, sku)
 				if found && unit == unitGBMonth && rate > 0 {
 					c.cloudWatchPricing.LogsStorageRate = rate
+					sources.add("logs-storage", sku)
 				}
 			}
 		}
@@ -1062,10 +1694,12 @@ func (c *Client) parseCloudWatchPricing(data []byte) (string, error) { //nolint:
 				tiers := c.extractTieredPricing(&pricing, sku)
 				if len(tiers) > 0 {
 					c.cloudWatchPricing.MetricsTiers = tiers
+					sources.add("custom-metrics", sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
@@ -1092,6 +1726,8 @@ func (c *Client) parseElastiCachePricing(data []byte) (string, error) {
 			Msg("ElastiCache pricing data has unexpected offerCode")
 	}
 
+	sources := newRateSourceIndex(&pricing)
+
 	var region string
 	for sku, prod := range pricing.Products {
 		attrs := prod.Attributes
@@ -1116,83 +1752,2277 @@ func (c *Client) parseElastiCachePricing(data []byte) (string, error) {
 						HourlyRate: rate,
 						Currency:   "USD",
 					}
+					sources.add(instanceType+"/"+engine, sku)
 				}
 			}
 		}
 	}
+	c.recordProvenance(&pricing, sources)
 	return region, nil
 }
 
-// extractTieredPricing extracts tiered pricing from a SKU's price dimensions.
-// AWS CloudWatch uses beginRange/endRange to define pricing tiers.
-// Returns sorted tiers from lowest to highest upper bound.
-func (c *Client) extractTieredPricing(data *awsPricing, sku string) []TierRate {
-	termMap, ok := data.Terms["OnDemand"][sku]
-	if !ok {
+// parseSecretsManagerPricing parses Secrets Manager pricing data.
+// Returns the detected region and any parsing error.
+//
+// Secrets Manager pricing structure:
+//   - Secret storage: productFamily="Secret", usagetype contains "AWSSecretsManager-Secrets"
+//   - API calls: productFamily="API Request", usagetype contains "AWSSecretsManager-APIRequest"
+func (c *Client) parseSecretsManagerPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Secrets Manager JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "AWSSecretsManager" {
+		c.logger.Warn().
+			Str("expected", "AWSSecretsManager").
+			Str("actual", pricing.OfferCode).
+			Msg("Secrets Manager pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		if prod.ProductFamily != "Secret" && prod.ProductFamily != "API Request" {
+			continue
+		}
+
+		if c.secretsManagerPricing == nil {
+			c.secretsManagerPricing = &secretsManagerPrice{
+				Currency: "USD",
+			}
+		}
+
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found {
+			continue
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case prod.ProductFamily == "Secret" && strings.Contains(usageType, "AWSSecretsManager-Secrets"):
+			c.secretsManagerPricing.SecretMonthlyRate = rate
+			sources.add("secret", sku)
+		case prod.ProductFamily == "API Request" && strings.Contains(usageType, "AWSSecretsManager-APIRequest"):
+			c.secretsManagerPricing.APIRequestRate = rate
+			sources.add("api-request", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseKMSPricing parses KMS pricing data for customer managed keys and requests.
+// Returns the detected region and any parsing error.
+//
+// KMS pricing structure:
+//   - Keys: productFamily="Encryption Key", usagetype contains "KMS-Keys"
+//   - Requests: productFamily="KMS Requests", usagetype ends with "KMS-Requests"
+//   - Requests carry a $0 free-tier dimension; only the paid tier rate is kept
+func (c *Client) parseKMSPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse KMS JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service (T031)
+	if pricing.OfferCode != "awskms" {
+		c.logger.Warn().
+			Str("expected", "awskms").
+			Str("actual", pricing.OfferCode).
+			Msg("KMS pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		if prod.ProductFamily != "Encryption Key" && prod.ProductFamily != "KMS Requests" {
+			continue
+		}
+
+		if c.kmsPricing == nil {
+			c.kmsPricing = &kmsPrice{
+				Currency: "USD",
+			}
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case prod.ProductFamily == "Encryption Key" && strings.Contains(usageType, "KMS-Keys"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found {
+				c.kmsPricing.KeyMonthlyRate = rate
+				sources.add("customer-managed-key", sku)
+			}
+		case prod.ProductFamily == "KMS Requests" && strings.HasSuffix(usageType, "KMS-Requests"):
+			// Skip the free tier dimension; the lowest non-zero tier is the paid rate.
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.kmsPricing.RequestRate = tiers[0].Rate
+				sources.add("request", sku)
+			}
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseOpenSearchPricing parses OpenSearch Service pricing data.
+// Returns the detected region and any parsing error.
+//
+// OpenSearch pricing structure:
+//   - Nodes: productFamily="Amazon OpenSearch Service Instance", instanceType="r6g.large.search"
+//     (data, dedicated master and UltraWarm nodes all use this family)
+//   - Storage: productFamily="Amazon OpenSearch Service Volume", storageMedia="GP3"|"GP2"|"PIOPS"|"Magnetic"
+//   - Serverless: productFamily="Amazon OpenSearch Service Serverless", usagetype contains "OCU"
+func (c *Client) parseOpenSearchPricing(data []byte) (string, error) { //nolint:gocognit
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse OpenSearch JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonES" {
+		c.logger.Warn().
+			Str("expected", "AmazonES").
+			Str("actual", pricing.OfferCode).
+			Msg("OpenSearch pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		switch prod.ProductFamily {
+		case "Amazon OpenSearch Service Instance":
+			instanceType := attrs["instanceType"]
+			if instanceType == "" {
+				continue
+			}
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && isHourlyUnit(unit) && rate > 0 {
+				c.openSearchInstanceIndex[instanceType] = openSearchInstancePrice{
+					Unit:       unit,
+					HourlyRate: rate,
+					Currency:   "USD",
+				}
+				sources.add(instanceType, sku)
+			}
+
+		case "Amazon OpenSearch Service Volume":
+			volumeType, ok := openSearchStorageMediaNormalization[attrs["storageMedia"]]
+			if !ok {
+				continue
+			}
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && rate > 0 {
+				c.openSearchStorageIndex[volumeType] = openSearchStoragePrice{
+					Unit:           unit,
+					RatePerGBMonth: rate,
+					Currency:       "USD",
+				}
+				sources.add(volumeType, sku)
+			}
+
+		case "Amazon OpenSearch Service Serverless":
+			if !strings.Contains(attrs["usagetype"], "OCU") {
+				continue
+			}
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				if c.openSearchServerlessPricing == nil {
+					c.openSearchServerlessPricing = &openSearchServerlessPrice{
+						Currency: "USD",
+					}
+				}
+				c.openSearchServerlessPricing.OCURate = rate
+				sources.add("ocu", sku)
+			}
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseRedshiftPricing parses Amazon Redshift pricing data.
+// Returns the detected region and any parsing error.
+//
+// Redshift pricing structure:
+//   - Nodes: productFamily="Compute Instance", instanceType="ra3.xlplus"|"dc2.large"|...
+//   - Managed storage: productFamily="Redshift Managed Storage" (GB-Mo)
+//   - Serverless: productFamily="Redshift Serverless", usagetype contains "ServerlessUsage" (RPU-Hr)
+func (c *Client) parseRedshiftPricing(data []byte) (string, error) { //nolint:gocognit
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Redshift JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonRedshift" {
+		c.logger.Warn().
+			Str("expected", "AmazonRedshift").
+			Str("actual", pricing.OfferCode).
+			Msg("Redshift pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		switch prod.ProductFamily {
+		case productFamilyComputeInstance:
+			nodeType := attrs["instanceType"]
+			if nodeType == "" {
+				continue
+			}
+			rate, unit, found := getOnDemandPrice(&pricing, sku)
+			if found && isHourlyUnit(unit) && rate > 0 {
+				c.redshiftNodeIndex[nodeType] = redshiftNodePrice{
+					Unit:       unit,
+					HourlyRate: rate,
+					Currency:   "USD",
+				}
+				sources.add(nodeType, sku)
+			}
+
+		case "Redshift Managed Storage", "Redshift Serverless":
+			rate, _, found := getOnDemandPrice(&pricing, sku)
+			if !found || rate == 0 {
+				continue
+			}
+			if c.redshiftPricing == nil {
+				c.redshiftPricing = &redshiftPrice{
+					Currency: "USD",
+				}
+			}
+			if prod.ProductFamily == "Redshift Managed Storage" {
+				c.redshiftPricing.ManagedStorageRate = rate
+				sources.add("managed-storage", sku)
+			} else if strings.Contains(attrs["usagetype"], "ServerlessUsage") {
+				c.redshiftPricing.ServerlessRPURate = rate
+				sources.add("rpu", sku)
+			}
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseECRPricing parses Amazon ECR pricing data.
+// Returns the detected region and any parsing error.
+//
+// ECR pricing structure:
+//   - Storage: productFamily="EC2 Container Registry", usagetype contains "TimedStorage-ByteHrs" (GB-Mo)
+//
+// Data transfer SKUs in the same file are ignored.
+func (c *Client) parseECRPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse ECR JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonECR" {
+		c.logger.Warn().
+			Str("expected", "AmazonECR").
+			Str("actual", pricing.OfferCode).
+			Msg("ECR pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		if prod.ProductFamily != "EC2 Container Registry" ||
+			!strings.Contains(attrs["usagetype"], "TimedStorage-ByteHrs") {
+			continue
+		}
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if found && unit == unitGBMonth && rate > 0 {
+			c.ecrPricing = &ecrPrice{
+				StorageRate: rate,
+				Currency:    "USD",
+			}
+			sources.add("storage", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseBackupPricing parses AWS Backup pricing data.
+// Returns the detected region and any parsing error.
+//
+// AWS Backup pricing structure:
+//   - Storage: usagetype "[REGION-]<Tier>Storage-ByteHrs-<ResourceType>" (GB-Mo),
+//     e.g., "USE1-WarmStorage-ByteHrs-EFS", "ColdStorage-ByteHrs-DynamoDB"
+//
+// Restore, item-level restore and cross-region transfer SKUs are ignored.
+func (c *Client) parseBackupPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse AWS Backup JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AWSBackup" {
+		c.logger.Warn().
+			Str("expected", "AWSBackup").
+			Str("actual", pricing.OfferCode).
+			Msg("AWS Backup pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		usageType := attrs["usagetype"]
+		var tier string
+		switch {
+		case strings.Contains(usageType, "WarmStorage-ByteHrs-"):
+			tier = "warm"
+		case strings.Contains(usageType, "ColdStorage-ByteHrs-"):
+			tier = "cold"
+		default:
+			continue
+		}
+		resourceType := strings.ToLower(usageType[strings.LastIndex(usageType, "-")+1:])
+		if resourceType == "" {
+			continue
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if found && unit == unitGBMonth && rate > 0 {
+			c.backupStorageIndex[resourceType+"/"+tier] = backupStoragePrice{
+				Unit:           unit,
+				RatePerGBMonth: rate,
+				Currency:       "USD",
+			}
+			sources.add(resourceType+"/"+tier, sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseStepFunctionsPricing parses AWS Step Functions pricing data.
+// Returns the detected region and any parsing error.
+//
+// Step Functions pricing structure:
+//   - Standard workflows: usagetype contains "StateTransition" (per transition)
+//   - Express workflows: usagetype contains "ExpressWorkflows-Requests" (per request)
+//     and "ExpressWorkflows-Duration" (tiered, per GB-second)
+func (c *Client) parseStepFunctionsPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Step Functions JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonStates" {
+		c.logger.Warn().
+			Str("expected", "AmazonStates").
+			Str("actual", pricing.OfferCode).
+			Msg("Step Functions pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		usageType := attrs["usagetype"]
+		if c.stepFunctionsPricing == nil {
+			c.stepFunctionsPricing = &stepFunctionsPrice{
+				Currency: "USD",
+			}
+		}
+
+		switch {
+		case strings.Contains(usageType, "ExpressWorkflows-Duration"):
+			if tiers := c.extractTieredPricing(&pricing, sku); len(tiers) > 0 {
+				c.stepFunctionsPricing.ExpressDurationTiers = tiers
+				sources.add("express-duration", sku)
+			}
+		case strings.Contains(usageType, "ExpressWorkflows-Requests"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				c.stepFunctionsPricing.ExpressRequestRate = rate
+				sources.add("express-request", sku)
+			}
+		case strings.Contains(usageType, "StateTransition"):
+			if rate, _, found := getOnDemandPrice(&pricing, sku); found && rate > 0 {
+				c.stepFunctionsPricing.StandardTransitionRate = rate
+				sources.add("state-transition", sku)
+			}
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseEventBridgePricing parses Amazon EventBridge pricing data.
+// Returns the detected region and any parsing error.
+//
+// EventBridge pricing structure:
+//   - Custom event buses: eventType="Custom Events" (per 64 KB event chunk)
+//   - Pipes: usagetype contains "Pipes" (per 64 KB request chunk)
+//
+// Partner, cross-account and archive/replay SKUs are ignored.
+func (c *Client) parseEventBridgePricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse EventBridge JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AWSEvents" {
+		c.logger.Warn().
+			Str("expected", "AWSEvents").
+			Str("actual", pricing.OfferCode).
+			Msg("EventBridge pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		isCustom := attrs["eventType"] == "Custom Events"
+		isPipes := strings.Contains(attrs["usagetype"], "Pipes")
+		if !isCustom && !isPipes {
+			continue
+		}
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.eventBridgePricing == nil {
+			c.eventBridgePricing = &eventBridgePrice{
+				Currency: "USD",
+			}
+		}
+		if isPipes {
+			c.eventBridgePricing.PipesRequestRate = rate
+			sources.add("pipes-request", sku)
+		} else {
+			c.eventBridgePricing.CustomEventRate = rate
+			sources.add("custom-event", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseDocumentDBPricing parses Amazon DocumentDB pricing data.
+// Returns the detected region and any parsing error.
+func (c *Client) parseDocumentDBPricing(data []byte) (string, error) {
+	prices, region, err := c.parseClusterDatabasePricing(data, "DocumentDB", "AmazonDocDB")
+	if err != nil {
+		return "", err
+	}
+	c.docDBPricing = prices
+	return region, nil
+}
+
+// parseNeptunePricing parses Amazon Neptune pricing data.
+// Returns the detected region and any parsing error.
+func (c *Client) parseNeptunePricing(data []byte) (string, error) {
+	prices, region, err := c.parseClusterDatabasePricing(data, "Neptune", "AmazonNeptune")
+	if err != nil {
+		return "", err
+	}
+	c.neptunePricing = prices
+	return region, nil
+}
+
+// parseClusterDatabasePricing parses the price list shared by DocumentDB and Neptune.
+// Returns the parsed rates, the detected region and any parsing error.
+//
+// Cluster database pricing structure:
+//   - Instances: productFamily="Database Instance", instanceType="db.r6g.large"|... (Hrs)
+//   - Storage: usagetype ends with "StorageUsage" (GB-Mo)
+//   - I/O: usagetype ends with "StorageIOUsage" (IOs)
+//   - Backup: usagetype ends with "BackupUsage" (GB-Mo)
+//
+// I/O-Optimized SKUs (usagetype contains "IOOptimized") are skipped so they do not
+// overwrite the Standard storage configuration rates. Serverless SKUs have no
+// instanceType and are ignored.
+func (c *Client) parseClusterDatabasePricing( //nolint:gocognit
+	data []byte,
+	label, offerCode string,
+) (*clusterDatabasePrice, string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return nil, "", fmt.Errorf("failed to parse %s JSON: %w", label, err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != offerCode {
+		c.logger.Warn().
+			Str("expected", offerCode).
+			Str("actual", pricing.OfferCode).
+			Msgf("%s pricing data has unexpected offerCode", label)
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	prices := &clusterDatabasePrice{
+		InstanceRates: make(map[string]float64, 50),
+		Currency:      "USD",
+	}
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		usageType := attrs["usagetype"]
+		if strings.Contains(usageType, "IOOptimized") {
+			continue
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+
+		switch {
+		case prod.ProductFamily == "Database Instance":
+			if instanceType := attrs["instanceType"]; instanceType != "" && isHourlyUnit(unit) {
+				prices.InstanceRates[instanceType] = rate
+				sources.add(instanceType, sku)
+			}
+		case strings.HasSuffix(usageType, "StorageIOUsage"):
+			prices.IORate = rate
+			sources.add("io_requests", sku)
+		case strings.HasSuffix(usageType, "StorageUsage"):
+			prices.StorageRate = rate
+			sources.add("storage", sku)
+		case strings.HasSuffix(usageType, "BackupUsage"):
+			prices.BackupRate = rate
+			sources.add("backup_storage", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return prices, region, nil
+}
+
+// parseMemoryDBPricing parses Amazon MemoryDB pricing data.
+// Returns the detected region and any parsing error.
+//
+// MemoryDB pricing structure:
+//   - Nodes: usagetype contains "NodeUsage", instanceType="db.r6g.large"|... (Hrs)
+//   - Data written: usagetype contains "DataWritten" (GB)
+//   - Snapshots: usagetype contains "SnapshotStorage" (GB-Mo)
+func (c *Client) parseMemoryDBPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse MemoryDB JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonMemoryDB" {
+		c.logger.Warn().
+			Str("expected", "AmazonMemoryDB").
+			Str("actual", pricing.OfferCode).
+			Msg("MemoryDB pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.memoryDBPricing == nil {
+			c.memoryDBPricing = &memoryDBPrice{
+				NodeRates: make(map[string]float64, 50),
+				Currency:  "USD",
+			}
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case strings.Contains(usageType, "NodeUsage"):
+			if nodeType := attrs["instanceType"]; nodeType != "" && isHourlyUnit(unit) {
+				c.memoryDBPricing.NodeRates[nodeType] = rate
+				sources.add(nodeType, sku)
+			}
+		case strings.Contains(usageType, "DataWritten"):
+			c.memoryDBPricing.DataWrittenRate = rate
+			sources.add("data-written", sku)
+		case strings.Contains(usageType, "SnapshotStorage"):
+			c.memoryDBPricing.SnapshotRate = rate
+			sources.add("snapshot-storage", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseMSKPricing parses Amazon MSK pricing data.
+// Returns the detected region and any parsing error.
+//
+// MSK pricing structure:
+//   - Brokers: instanceType="kafka.m5.large"|"kafka.m7g.large"|... (Hrs)
+//   - Storage: usagetype contains "Kafka.Storage" (GB-Mo)
+//   - Provisioned throughput: usagetype contains "ProvisionedThroughput" (MBps-Mo)
+//   - Serverless: usagetype contains "Serverless.ClusterHours", "Serverless.PartitionHours",
+//     "Serverless.DataIn", "Serverless.DataOut" or "Serverless.Storage"
+func (c *Client) parseMSKPricing(data []byte) (string, error) { //nolint:gocognit
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse MSK JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonMSK" {
+		c.logger.Warn().
+			Str("expected", "AmazonMSK").
+			Str("actual", pricing.OfferCode).
+			Msg("MSK pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.mskPricing == nil {
+			c.mskPricing = &mskPrice{
+				BrokerRates: make(map[string]float64, 30),
+				Currency:    "USD",
+			}
+		}
+
+		usageType := attrs["usagetype"]
+		instanceType := strings.ToLower(attrs["instanceType"])
+		switch {
+		case strings.HasPrefix(instanceType, "kafka."):
+			if isHourlyUnit(unit) {
+				c.mskPricing.BrokerRates[instanceType] = rate
+				sources.add(instanceType, sku)
+			}
+		case strings.Contains(usageType, "Serverless.ClusterHours"):
+			c.mskPricing.ServerlessClusterRate = rate
+			sources.add("serverless-cluster", sku)
+		case strings.Contains(usageType, "Serverless.PartitionHours"):
+			c.mskPricing.ServerlessPartitionRate = rate
+			sources.add("serverless-partitions", sku)
+		case strings.Contains(usageType, "Serverless.DataIn"):
+			c.mskPricing.ServerlessDataInRate = rate
+			sources.add("serverless-data_in", sku)
+		case strings.Contains(usageType, "Serverless.DataOut"):
+			c.mskPricing.ServerlessDataOutRate = rate
+			sources.add("serverless-data_out", sku)
+		case strings.Contains(usageType, "Serverless.Storage"):
+			c.mskPricing.ServerlessStorageRate = rate
+			sources.add("serverless-storage", sku)
+		case strings.Contains(usageType, "ProvisionedThroughput"):
+			c.mskPricing.ThroughputRate = rate
+			sources.add("provisioned-throughput", sku)
+		case strings.Contains(usageType, "Kafka.Storage"):
+			c.mskPricing.StorageRate = rate
+			sources.add("broker-storage", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseSageMakerPricing parses Amazon SageMaker pricing data.
+// Returns the detected region and any parsing error.
+//
+// SageMaker pricing structure:
+//   - Real-time inference: usagetype contains "Host:", instanceName="ml.m5.large"|... (Hrs)
+//   - Notebook instances: usagetype contains "Notebk:", instanceName="ml.t3.medium"|... (Hrs)
+//   - Notebook storage: usagetype contains "Notebk:VolumeUsage" (GB-Mo)
+//   - Serverless Inference: usagetype "ServerlessInf:Mem-<N>GB" (per second) and
+//     "ServerlessInf:Data-Bytes" (GB)
+//
+// Training, processing and other components are ignored.
+func (c *Client) parseSageMakerPricing(data []byte) (string, error) { //nolint:gocognit
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse SageMaker JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AmazonSageMaker" {
+		c.logger.Warn().
+			Str("expected", "AmazonSageMaker").
+			Str("actual", pricing.OfferCode).
+			Msg("SageMaker pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.sageMakerPricing == nil {
+			c.sageMakerPricing = &sageMakerPrice{
+				HostingRates:  make(map[string]float64, 100),
+				NotebookRates: make(map[string]float64, 50),
+				Currency:      "USD",
+			}
+		}
+
+		usageType := attrs["usagetype"]
+		instanceName := strings.ToLower(attrs["instanceName"])
+		isInstance := strings.HasPrefix(instanceName, "ml.") && isHourlyUnit(unit)
+		switch {
+		case strings.Contains(usageType, "ServerlessInf:Mem-"):
+			// Rates scale linearly with memory; normalize to a per-GB-second rate
+			_, memSize, _ := strings.Cut(usageType, "ServerlessInf:Mem-")
+			memGB, err := strconv.ParseFloat(strings.TrimSuffix(memSize, "GB"), 64)
+			if err == nil && memGB > 0 && c.sageMakerPricing.ServerlessGBSecondRate == 0 {
+				c.sageMakerPricing.ServerlessGBSecondRate = rate / memGB
+				sources.add("serverless-compute", sku)
+			}
+		case strings.Contains(usageType, "ServerlessInf:Data"):
+			c.sageMakerPricing.ServerlessDataRate = rate
+			sources.add("serverless-data_processed", sku)
+		case strings.Contains(usageType, "Notebk:VolumeUsage"):
+			c.sageMakerPricing.NotebookStorageRate = rate
+			sources.add("notebook-storage", sku)
+		case strings.Contains(usageType, "Host:") && isInstance:
+			c.sageMakerPricing.HostingRates[instanceName] = rate
+			sources.add(instanceName, sku)
+		case strings.Contains(usageType, "Notebk:") && isInstance:
+			c.sageMakerPricing.NotebookRates[instanceName] = rate
+			sources.add("notebook/"+instanceName, sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseWAFPricing parses AWS WAF pricing data.
+// Returns the detected region and any parsing error.
+//
+// AWS WAF pricing structure:
+//   - Web ACL: usagetype "[REGION-]WebACL" (monthly, prorated hourly)
+//   - Rule: usagetype "[REGION-]Rule" (monthly per rule, prorated hourly)
+//   - Request: usagetype "[REGION-]Request" (per request)
+//
+// Managed rule group subscriptions, Bot Control and Fraud Control SKUs are ignored.
+func (c *Client) parseWAFPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse WAF JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "awswaf" {
+		c.logger.Warn().
+			Str("expected", "awswaf").
+			Str("actual", pricing.OfferCode).
+			Msg("WAF pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.wafPricing == nil {
+			c.wafPricing = &wafPrice{
+				Currency: "USD",
+			}
+		}
+
+		usageType := attrs["usagetype"]
+		switch {
+		case usageTypeIs(usageType, "WebACL"):
+			c.wafPricing.WebACLRate = rate
+			sources.add("web-acl", sku)
+		case usageTypeIs(usageType, "Rule"):
+			c.wafPricing.RuleRate = rate
+			sources.add("rules", sku)
+		case usageTypeIs(usageType, "Request"):
+			c.wafPricing.RequestRate = rate
+			sources.add("requests", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// usageTypeIs reports whether usageType is name, optionally prefixed with a
+// region code (e.g., "USE1-Rule" or "Rule"). Unlike a suffix match, it does not
+// accept longer names such as "ManagedRule".
+func usageTypeIs(usageType, name string) bool {
+	return usageType == name || strings.HasSuffix(usageType, "-"+name)
+}
+
+// parseShieldPricing parses AWS Shield pricing data.
+// Returns the detected region and any parsing error.
+//
+// Only the Shield Advanced subscription fee (usagetype containing "MonthlyFee") is
+// captured; Shield Advanced data transfer out charges are ignored.
+func (c *Client) parseShieldPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Shield JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AWSShield" {
+		c.logger.Warn().
+			Str("expected", "AWSShield").
+			Str("actual", pricing.OfferCode).
+			Msg("Shield pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		if !strings.Contains(attrs["usagetype"], "MonthlyFee") {
+			continue
+		}
+		rate, _, found := getOnDemandPrice(&pricing, sku)
+		if found && rate > 0 {
+			c.shieldPricing = &shieldPrice{
+				MonthlyFee: rate,
+				Currency:   "USD",
+			}
+			sources.add("shield-advanced", sku)
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// parseGlobalAcceleratorPricing parses AWS Global Accelerator pricing data.
+// Returns the detected region and any parsing error.
+//
+// Global Accelerator pricing structure:
+//   - Fixed fee: Product Family "Accelerator" (Hrs)
+//   - Data Transfer-Premium: Product Family "Data Transfer Premium" (GB), keyed by
+//     the fromLocation and toLocation geographies
+//
+// The offer is global, so the detected region is usually empty.
+func (c *Client) parseGlobalAcceleratorPricing(data []byte) (string, error) {
+	var pricing awsPricing
+	if err := json.Unmarshal(data, &pricing); err != nil {
+		return "", fmt.Errorf("failed to parse Global Accelerator JSON: %w", err)
+	}
+
+	// Validate offerCode matches expected service
+	if pricing.OfferCode != "AWSGlobalAccelerator" {
+		c.logger.Warn().
+			Str("expected", "AWSGlobalAccelerator").
+			Str("actual", pricing.OfferCode).
+			Msg("Global Accelerator pricing data has unexpected offerCode")
+	}
+
+	sources := newRateSourceIndex(&pricing)
+
+	var region string
+	for sku, prod := range pricing.Products {
+		attrs := prod.Attributes
+
+		if region == "" && attrs["regionCode"] != "" {
+			region = attrs["regionCode"]
+		}
+
+		rate, unit, found := getOnDemandPrice(&pricing, sku)
+		if !found || rate == 0 {
+			continue
+		}
+		if c.globalAcceleratorPricing == nil {
+			c.globalAcceleratorPricing = &globalAcceleratorPrice{
+				DTPremiumRates: make(map[string]float64, 50),
+				Currency:       "USD",
+			}
+		}
+
+		switch prod.ProductFamily {
+		case "Accelerator":
+			if isHourlyUnit(unit) {
+				c.globalAcceleratorPricing.HourlyRate = rate
+				sources.add("accelerator", sku)
+			}
+		case "Data Transfer Premium":
+			from, to := attrs["fromLocation"], attrs["toLocation"]
+			if from != "" && to != "" {
+				c.globalAcceleratorPricing.DTPremiumRates[geographyPairKey(from, to)] = rate
+				sources.add(from+"/"+to, sku)
+			}
+		}
+	}
+	c.recordProvenance(&pricing, sources)
+	return region, nil
+}
+
+// geographyPairKey builds the DTPremiumRates key for a source/destination pair.
+func geographyPairKey(source, destination string) string {
+	return strings.ToLower(source) + "|" + strings.ToLower(destination)
+}
+
+// extractTieredPricing extracts tiered pricing from a SKU's price dimensions.
+// AWS CloudWatch uses beginRange/endRange to define pricing tiers.
+// Returns sorted tiers from lowest to highest upper bound.
+func (c *Client) extractTieredPricing(data *awsPricing, sku string) []TierRate {
+	termMap, ok := data.Terms["OnDemand"][sku]
+	if !ok {
 		return nil
 	}
 
-	var tiers []TierRate
-	for _, term := range termMap {
-		for _, dim := range term.PriceDimensions {
-			amountStr, hasUSD := dim.PricePerUnit["USD"]
-			if !hasUSD {
-				continue
-			}
-			rate, err := strconv.ParseFloat(amountStr, 64)
-			if err != nil || rate == 0 {
-				continue
-			}
+	var tiers []TierRate
+	for _, term := range termMap {
+		for _, dim := range term.PriceDimensions {
+			amountStr, hasUSD := dim.PricePerUnit["USD"]
+			if !hasUSD {
+				continue
+			}
+			rate, err := strconv.ParseFloat(amountStr, 64)
+			if err != nil || rate == 0 {
+				continue
+			}
+
+			// Parse endRange - "Inf" means unlimited
+			var upperBound float64
+			if dim.EndRange == "Inf" || dim.EndRange == "" {
+				upperBound = math.MaxFloat64
+			} else {
+				upperBound, err = strconv.ParseFloat(dim.EndRange, 64)
+				if err != nil {
+					continue
+				}
+			}
+
+			tiers = append(tiers, TierRate{
+				UpTo: upperBound,
+				Rate: rate,
+			})
+		}
+	}
+
+	// Sort by upper bound (ascending)
+	sort.Slice(tiers, func(i, j int) bool {
+		return tiers[i].UpTo < tiers[j].UpTo
+	})
+
+	return tiers
+}
+
+// Region returns the AWS region for this pricing data.
+func (c *Client) Region() string {
+	_ = c.init() // Ensure initialization
+	return c.region
+}
+
+// Currency returns the currency code.
+func (c *Client) Currency() string {
+	_ = c.init() // Ensure initialization
+	return c.currency
+}
+
+// EC2OnDemandPricePerHour returns hourly rate for an EC2 instance.
+func (c *Client) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "EC2").
+				Str("instance_type", instanceType).
+				Str("os", os).
+				Str("tenancy", tenancy).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := fmt.Sprintf("%s/%s/%s", instanceType, os, tenancy)
+	price, found := c.ec2Index[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// EBSPricePerGBMonth returns monthly rate per GB for an EBS volume.
+func (c *Client) EBSPricePerGBMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "EBS").
+				Str("volume_type", volumeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.ebsIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// S3PricePerGBMonth returns monthly rate per GB for S3 storage.
+func (c *Client) S3PricePerGBMonth(storageClass string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "S3").
+				Str("storage_class", storageClass).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.s3Index[storageClass]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// S3StorageTiers returns the volume tiers of an S3 storage class.
+// Returns (tiers, true) if the class is tiered, (nil, false) otherwise.
+func (c *Client) S3StorageTiers(storageClass string) ([]TierRate, bool) {
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+
+	price, found := c.s3Index[storageClass]
+	if !found || len(price.Tiers) == 0 {
+		return nil, false
+	}
+	// Return a copy to prevent callers from modifying shared pricing data
+	result := make([]TierRate, len(price.Tiers))
+	copy(result, price.Tiers)
+	return result, true
+}
+
+// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
+// instanceType: e.g., "db.t3.medium"
+// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
+func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "RDS").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := fmt.Sprintf("%s/%s", instanceType, engine)
+	price, found := c.rdsInstanceIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// RDSStoragePricePerGBMonth returns monthly rate per GB for RDS storage.
+// volumeType: e.g., "gp2", "gp3", "io1", "standard".
+func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "RDS_Storage").
+				Str("volume_type", volumeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.rdsStorageIndex[volumeType]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// EKSClusterPricePerHour returns hourly rate for EKS cluster control plane.
+// extendedSupport: true for extended support pricing, false for standard support.
+func (c *Client) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "EKS").
+				Bool("extended_support", extendedSupport).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.eksPricing == nil {
+		return 0, false
+	}
+
+	if extendedSupport {
+		if c.eksPricing.ExtendedHourlyRate > 0 {
+			return c.eksPricing.ExtendedHourlyRate, true
+		}
+		return 0, false
+	}
+
+	if c.eksPricing.StandardHourlyRate > 0 {
+		return c.eksPricing.StandardHourlyRate, true
+	}
+	return 0, false
+}
+
+// LambdaPricePerRequest returns the cost per request for AWS Lambda invocations.
+// The rate is sourced from AWS Price List API product family "AWS Lambda" with
+// group "AWS-Lambda-Requests". Standard pricing is $0.20 per 1 million requests
+// ($0.0000002 per request) as of December 2025.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) LambdaPricePerRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Str("metric", "Requests").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.lambdaPricing == nil || c.lambdaPricing.RequestPrice == 0 {
+		return 0, false
+	}
+	return c.lambdaPricing.RequestPrice, true
+}
+
+// LambdaPricePerGBSecond returns the cost per GB-second of compute duration.
+// The rate is sourced from AWS Price List API product family "Serverless" with
+// group "AWS-Lambda-Duration" (x86) or "AWS-Lambda-Duration-ARM" (arm64).
+// This represents the compute cost based on allocated memory and execution time.
+//
+// Architecture pricing (as of December 2025):
+//   - x86_64: ~$0.0000166667 per GB-second
+//   - arm64:  ~$0.0000133334 per GB-second (~20% cheaper)
+//
+// arch parameter accepts: "x86_64", "arm64", "x86", "arm" (defaults to x86_64)
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Str("metric", "GB-Second").
+				Str("architecture", arch).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	if c.lambdaPricing == nil {
+		return 0, false
+	}
+
+	// Normalize architecture string and select appropriate price
+	switch strings.ToLower(arch) {
+	case "arm64", "arm":
+		if c.lambdaPricing.ARMGBSecondPrice > 0 {
+			return c.lambdaPricing.ARMGBSecondPrice, true
+		}
+		// Fall back to x86 if ARM pricing not available
+		if c.lambdaPricing.X86GBSecondPrice > 0 {
+			return c.lambdaPricing.X86GBSecondPrice, true
+		}
+		return 0, false
+	default:
+		// x86_64, x86, or any unrecognized value defaults to x86
+		if c.lambdaPricing.X86GBSecondPrice > 0 {
+			return c.lambdaPricing.X86GBSecondPrice, true
+		}
+		return 0, false
+	}
+}
+
+// DynamoDBOnDemandReadPrice returns the cost per read request unit.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "OnDemandRead").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandReadPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.OnDemandReadPrice, true
+}
+
+// DynamoDBOnDemandWritePrice returns the cost per write request unit.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "OnDemandWrite").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandWritePrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.OnDemandWritePrice, true
+}
+
+// DynamoDBStoragePricePerGBMonth returns the monthly rate per GB for table storage.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "Storage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.StoragePrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.StoragePrice, true
+}
+
+// DynamoDBProvisionedRCUPrice returns the cost per RCU-hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "ProvisionedRCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedRCUPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.ProvisionedRCUPrice, true
+}
+
+// DynamoDBProvisionedWCUPrice returns the cost per WCU-hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DynamoDB").
+				Str("metric", "ProvisionedWCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedWCUPrice == 0 {
+		return 0, false
+	}
+	return c.dynamoDBPricing.ProvisionedWCUPrice, true
+}
+
+// ALBPricePerHour returns the fixed hourly rate for an Application Load Balancer.
+func (c *Client) ALBPricePerHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "ALB").
+				Str("metric", "FixedHourly").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.ALBHourlyRate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.ALBHourlyRate, true
+}
+
+// ALBPricePerLCU returns the cost per LCU-hour for an Application Load Balancer.
+func (c *Client) ALBPricePerLCU() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "ALB").
+				Str("metric", "LCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.ALBLCURate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.ALBLCURate, true
+}
+
+// NLBPricePerHour returns the fixed hourly rate for a Network Load Balancer.
+func (c *Client) NLBPricePerHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "NLB").
+				Str("metric", "FixedHourly").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.NLBHourlyRate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.NLBHourlyRate, true
+}
+
+// NLBPricePerNLCU returns the cost per NLCU-hour for a Network Load Balancer.
+func (c *Client) NLBPricePerNLCU() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ELB").
+				Str("lb_type", "NLB").
+				Str("metric", "NLCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.elbPricing == nil || c.elbPricing.NLBNLCURate == 0 {
+		return 0, false
+	}
+	return c.elbPricing.NLBNLCURate, true
+}
+
+// NATGatewayPrice returns the pricing for a NAT Gateway.
+func (c *Client) NATGatewayPrice() (*NATGatewayPrice, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "NATGateway").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.natGatewayPricing == nil || c.natGatewayPricing.HourlyRate == 0 {
+		return nil, false
+	}
+	return c.natGatewayPricing, true
+}
+
+// CloudWatchLogsIngestionTiers returns the tiered pricing for CloudWatch log ingestion.
+// Returns (tiers, true) if found, (nil, false) if not found.
+func (c *Client) CloudWatchLogsIngestionTiers() ([]TierRate, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "CloudWatch").
+				Str("metric", "LogsIngestionTiers").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.LogsIngestionTiers) == 0 {
+		return nil, false
+	}
+	// Return a copy to prevent callers from modifying shared pricing data
+	result := make([]TierRate, len(c.cloudWatchPricing.LogsIngestionTiers))
+	copy(result, c.cloudWatchPricing.LogsIngestionTiers)
+	return result, true
+}
+
+// CloudWatchLogsStoragePrice returns the per-GB-month rate for CloudWatch log storage.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "CloudWatch").
+				Str("metric", "LogsStorage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.cloudWatchPricing == nil || c.cloudWatchPricing.LogsStorageRate == 0 {
+		return 0, false
+	}
+	return c.cloudWatchPricing.LogsStorageRate, true
+}
+
+// CloudWatchMetricsTiers returns the tiered pricing for CloudWatch custom metrics.
+// Returns (tiers, true) if found, (nil, false) if not found.
+func (c *Client) CloudWatchMetricsTiers() ([]TierRate, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "CloudWatch").
+				Str("metric", "MetricsTiers").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.MetricsTiers) == 0 {
+		return nil, false
+	}
+	// Return a copy to prevent callers from modifying shared pricing data
+	result := make([]TierRate, len(c.cloudWatchPricing.MetricsTiers))
+	copy(result, c.cloudWatchPricing.MetricsTiers)
+	return result, true
+}
+
+// ElastiCacheOnDemandPricePerHour returns the hourly rate for an ElastiCache cache node.
+//
+// Parameters:
+//   - instanceType: The cache node type (e.g., "cache.m5.large", "cache.t3.micro")
+//   - engine: The cache engine, case-insensitive ("redis", "memcached", "valkey")
+//
+// Engine names are normalized to AWS canonical format:
+//   - "redis", "Redis", "REDIS" -> "Redis"
+//   - "memcached", "Memcached" -> "Memcached"
+//   - "valkey", "Valkey" -> "Valkey"
+//
+// Returns (price, true) if found, (0, false) if not found. or engine unknown.
+func (c *Client) ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ElastiCache").
+				Str("instance_type", instanceType).
+				Str("engine", engine).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	// Normalize engine name to AWS canonical format
+	normalizedEngine, ok := elasticacheEngineNormalization[strings.ToLower(engine)]
+	if !ok {
+		// Unknown engine - return not found
+		return 0, false
+	}
+
+	// Build index key: "instanceType:engine"
+	key := fmt.Sprintf("%s:%s", instanceType, normalizedEngine)
+	price, found := c.elasticacheIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// SecretsManagerPricePerSecretMonth returns the monthly rate for a single stored secret.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) SecretsManagerPricePerSecretMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "SecretsManager").
+				Str("metric", "Secret").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.secretsManagerPricing == nil || c.secretsManagerPricing.SecretMonthlyRate == 0 {
+		return 0, false
+	}
+	return c.secretsManagerPricing.SecretMonthlyRate, true
+}
+
+// SecretsManagerPricePerAPIRequest returns the cost per Secrets Manager API request.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) SecretsManagerPricePerAPIRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "SecretsManager").
+				Str("metric", "APIRequest").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.secretsManagerPricing == nil || c.secretsManagerPricing.APIRequestRate == 0 {
+		return 0, false
+	}
+	return c.secretsManagerPricing.APIRequestRate, true
+}
+
+// KMSPricePerKeyMonth returns the monthly rate for a customer managed KMS key.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) KMSPricePerKeyMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "KMS").
+				Str("metric", "Key").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.kmsPricing == nil || c.kmsPricing.KeyMonthlyRate == 0 {
+		return 0, false
+	}
+	return c.kmsPricing.KeyMonthlyRate, true
+}
+
+// KMSPricePerRequest returns the cost per KMS cryptographic request (paid tier).
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) KMSPricePerRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "KMS").
+				Str("metric", "Request").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.kmsPricing == nil || c.kmsPricing.RequestRate == 0 {
+		return 0, false
+	}
+	return c.kmsPricing.RequestRate, true
+}
+
+// OpenSearchInstancePricePerHour returns the hourly rate for an OpenSearch Service node.
+//
+// Parameters:
+//   - instanceType: The node type including the ".search" suffix (e.g., "r6g.large.search").
+//     Legacy ".elasticsearch" suffixes are accepted and mapped to ".search".
+//
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) OpenSearchInstancePricePerHour(instanceType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "OpenSearch").
+				Str("instance_type", instanceType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	key := strings.TrimSuffix(instanceType, ".elasticsearch")
+	if !strings.HasSuffix(key, ".search") {
+		key += ".search"
+	}
+	price, found := c.openSearchInstanceIndex[key]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// OpenSearchStoragePricePerGBMonth returns the EBS storage rate for OpenSearch data nodes.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) OpenSearchStoragePricePerGBMonth(volumeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "OpenSearch").
+				Str("volume_type", volumeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.openSearchStorageIndex[strings.ToLower(volumeType)]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// OpenSearchServerlessOCUPricePerHour returns the rate per OpenSearch Compute Unit hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) OpenSearchServerlessOCUPricePerHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "OpenSearch").
+				Str("metric", "OCU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.openSearchServerlessPricing == nil || c.openSearchServerlessPricing.OCURate == 0 {
+		return 0, false
+	}
+	return c.openSearchServerlessPricing.OCURate, true
+}
+
+// RedshiftNodePricePerHour returns the hourly rate for a provisioned Redshift node.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) RedshiftNodePricePerHour(nodeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Redshift").
+				Str("node_type", nodeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.redshiftNodeIndex[strings.ToLower(nodeType)]
+	if !found {
+		return 0, false
+	}
+	return price.HourlyRate, true
+}
+
+// RedshiftManagedStoragePricePerGBMonth returns the Redshift Managed Storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) RedshiftManagedStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Redshift").
+				Str("metric", "ManagedStorage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.redshiftPricing == nil || c.redshiftPricing.ManagedStorageRate == 0 {
+		return 0, false
+	}
+	return c.redshiftPricing.ManagedStorageRate, true
+}
+
+// RedshiftServerlessPricePerRPUHour returns the Redshift Serverless compute rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) RedshiftServerlessPricePerRPUHour() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Redshift").
+				Str("metric", "ServerlessRPU").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.redshiftPricing == nil || c.redshiftPricing.ServerlessRPURate == 0 {
+		return 0, false
+	}
+	return c.redshiftPricing.ServerlessRPURate, true
+}
+
+// ECRStoragePricePerGBMonth returns the ECR private repository storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) ECRStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "ECR").
+				Str("metric", "Storage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.ecrPricing == nil || c.ecrPricing.StorageRate == 0 {
+		return 0, false
+	}
+	return c.ecrPricing.StorageRate, true
+}
+
+// BackupStoragePricePerGBMonth returns the AWS Backup storage rate for a protected
+// resource type ("EBS", "EFS", "RDS", "DynamoDB", "S3") and tier ("warm" or "cold").
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) BackupStoragePricePerGBMonth(resourceType, tier string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Backup").
+				Str("backup_resource_type", resourceType).
+				Str("tier", tier).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+
+	price, found := c.backupStorageIndex[strings.ToLower(resourceType)+"/"+strings.ToLower(tier)]
+	if !found {
+		return 0, false
+	}
+	return price.RatePerGBMonth, true
+}
+
+// LambdaAddOnPrices returns the provisioned concurrency, ephemeral storage and SnapStart
+// rates for the given architecture. For "arm64"/"arm" the "-ARM" group is preferred and
+// the x86 rate is used where no ARM rate is published.
+// Returns (price, true) if any add-on rate is found, (nil, false) otherwise.
+func (c *Client) LambdaAddOnPrices(arch string) (*LambdaAddOnPrice, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Lambda").
+				Str("metric", "AddOns").
+				Str("architecture", arch).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if len(c.lambdaAddOnRates) == 0 {
+		return nil, false
+	}
+
+	isARM := strings.EqualFold(arch, "arm64") || strings.EqualFold(arch, "arm")
+	rate := func(group string) float64 {
+		if isARM {
+			if r, ok := c.lambdaAddOnRates[group+"-ARM"]; ok {
+				return r
+			}
+		}
+		return c.lambdaAddOnRates[group]
+	}
+
+	return &LambdaAddOnPrice{
+		ProvisionedConcurrencyRate: rate(lambdaGroupProvisionedConcurrency),
+		ProvisionedDurationRate:    rate(lambdaGroupProvisionedDuration),
+		EphemeralStorageRate:       rate(lambdaGroupEphemeralStorage),
+		SnapStartCacheRate:         rate(lambdaGroupSnapStartCache),
+		SnapStartRestoreRate:       rate(lambdaGroupSnapStartRestore),
+		Currency:                   "USD",
+	}, true
+}
+
+// StepFunctionsPricePerStateTransition returns the Standard workflow rate per state transition.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) StepFunctionsPricePerStateTransition() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "StepFunctions").
+				Str("metric", "StateTransition").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.stepFunctionsPricing == nil || c.stepFunctionsPricing.StandardTransitionRate == 0 {
+		return 0, false
+	}
+	return c.stepFunctionsPricing.StandardTransitionRate, true
+}
+
+// StepFunctionsExpressPricePerRequest returns the Express workflow rate per request.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) StepFunctionsExpressPricePerRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "StepFunctions").
+				Str("metric", "ExpressRequest").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.stepFunctionsPricing == nil || c.stepFunctionsPricing.ExpressRequestRate == 0 {
+		return 0, false
+	}
+	return c.stepFunctionsPricing.ExpressRequestRate, true
+}
+
+// StepFunctionsExpressDurationTiers returns the tiered Express workflow rate per GB-second.
+// Returns (tiers, true) if found, (nil, false) if not found.
+func (c *Client) StepFunctionsExpressDurationTiers() ([]TierRate, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "StepFunctions").
+				Str("metric", "ExpressDurationTiers").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
 
-			// Parse endRange - "Inf" means unlimited
-			var upperBound float64
-			if dim.EndRange == "Inf" || dim.EndRange == "" {
-				upperBound = math.MaxFloat64
-			} else {
-				upperBound, err = strconv.ParseFloat(dim.EndRange, 64)
-				if err != nil {
-					continue
-				}
-			}
+	if err := c.init(); err != nil {
+		return nil, false
+	}
+	if c.stepFunctionsPricing == nil || len(c.stepFunctionsPricing.ExpressDurationTiers) == 0 {
+		return nil, false
+	}
+	return c.stepFunctionsPricing.ExpressDurationTiers, true
+}
 
-			tiers = append(tiers, TierRate{
-				UpTo: upperBound,
-				Rate: rate,
-			})
+// EventBridgePricePerCustomEvent returns the rate per custom event published to an event bus.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) EventBridgePricePerCustomEvent() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "EventBridge").
+				Str("metric", "CustomEvent").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
 		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.eventBridgePricing == nil || c.eventBridgePricing.CustomEventRate == 0 {
+		return 0, false
 	}
+	return c.eventBridgePricing.CustomEventRate, true
+}
 
-	// Sort by upper bound (ascending)
-	sort.Slice(tiers, func(i, j int) bool {
-		return tiers[i].UpTo < tiers[j].UpTo
-	})
+// EventBridgePipesPricePerRequest returns the rate per EventBridge Pipes request.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) EventBridgePipesPricePerRequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "EventBridge").
+				Str("metric", "PipesRequest").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
 
-	return tiers
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.eventBridgePricing == nil || c.eventBridgePricing.PipesRequestRate == 0 {
+		return 0, false
+	}
+	return c.eventBridgePricing.PipesRequestRate, true
 }
 
-// Region returns the AWS region for this pricing data.
-func (c *Client) Region() string {
-	_ = c.init() // Ensure initialization
-	return c.region
+// DocumentDBInstancePricePerHour returns the hourly rate for an Amazon DocumentDB instance.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DocumentDBInstancePricePerHour(instanceType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DocumentDB").
+				Str("instance_type", instanceType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.docDBPricing == nil {
+		return 0, false
+	}
+
+	price, found := c.docDBPricing.InstanceRates[strings.ToLower(instanceType)]
+	if !found {
+		return 0, false
+	}
+	return price, true
 }
 
-// Currency returns the currency code.
-func (c *Client) Currency() string {
-	_ = c.init() // Ensure initialization
-	return c.currency
+// DocumentDBStoragePricePerGBMonth returns the DocumentDB cluster storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DocumentDBStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DocumentDB").
+				Str("metric", "Storage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.docDBPricing == nil || c.docDBPricing.StorageRate == 0 {
+		return 0, false
+	}
+	return c.docDBPricing.StorageRate, true
 }
 
-// EC2OnDemandPricePerHour returns hourly rate for an EC2 instance.
-func (c *Client) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (float64, bool) {
+// DocumentDBPricePerIORequest returns the DocumentDB rate per storage I/O request.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DocumentDBPricePerIORequest() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "EC2").
+				Str("resource_type", "DocumentDB").
+				Str("metric", "IO").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.docDBPricing == nil || c.docDBPricing.IORate == 0 {
+		return 0, false
+	}
+	return c.docDBPricing.IORate, true
+}
+
+// DocumentDBBackupPricePerGBMonth returns the DocumentDB backup storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) DocumentDBBackupPricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "DocumentDB").
+				Str("metric", "Backup").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.docDBPricing == nil || c.docDBPricing.BackupRate == 0 {
+		return 0, false
+	}
+	return c.docDBPricing.BackupRate, true
+}
+
+// NeptuneInstancePricePerHour returns the hourly rate for an Amazon Neptune instance.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) NeptuneInstancePricePerHour(instanceType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Neptune").
 				Str("instance_type", instanceType).
-				Str("os", os).
-				Str("tenancy", tenancy).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1201,24 +4031,128 @@ func (c *Client) EC2OnDemandPricePerHour(instanceType, os, tenancy string) (floa
 	if err := c.init(); err != nil {
 		return 0, false
 	}
+	if c.neptunePricing == nil {
+		return 0, false
+	}
+
+	price, found := c.neptunePricing.InstanceRates[strings.ToLower(instanceType)]
+	if !found {
+		return 0, false
+	}
+	return price, true
+}
+
+// NeptuneStoragePricePerGBMonth returns the Neptune cluster storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) NeptuneStoragePricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Neptune").
+				Str("metric", "Storage").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.neptunePricing == nil || c.neptunePricing.StorageRate == 0 {
+		return 0, false
+	}
+	return c.neptunePricing.StorageRate, true
+}
+
+// NeptunePricePerIORequest returns the Neptune rate per storage I/O request.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) NeptunePricePerIORequest() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Neptune").
+				Str("metric", "IO").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.neptunePricing == nil || c.neptunePricing.IORate == 0 {
+		return 0, false
+	}
+	return c.neptunePricing.IORate, true
+}
+
+// NeptuneBackupPricePerGBMonth returns the Neptune backup storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) NeptuneBackupPricePerGBMonth() (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "Neptune").
+				Str("metric", "Backup").
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.neptunePricing == nil || c.neptunePricing.BackupRate == 0 {
+		return 0, false
+	}
+	return c.neptunePricing.BackupRate, true
+}
+
+// MemoryDBNodePricePerHour returns the hourly rate for an Amazon MemoryDB node.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MemoryDBNodePricePerHour(nodeType string) (float64, bool) {
+	start := time.Now()
+	defer func() {
+		elapsed := time.Since(start)
+		if elapsed > 50*time.Millisecond {
+			c.logger.Warn().
+				Str("resource_type", "MemoryDB").
+				Str("node_type", nodeType).
+				Dur("elapsed", elapsed).
+				Msg("pricing lookup took too long")
+		}
+	}()
+
+	if err := c.init(); err != nil {
+		return 0, false
+	}
+	if c.memoryDBPricing == nil {
+		return 0, false
+	}
 
-	key := fmt.Sprintf("%s/%s/%s", instanceType, os, tenancy)
-	price, found := c.ec2Index[key]
+	price, found := c.memoryDBPricing.NodeRates[strings.ToLower(nodeType)]
 	if !found {
 		return 0, false
 	}
-	return price.HourlyRate, true
+	return price, true
 }
 
-// EBSPricePerGBMonth returns monthly rate per GB for an EBS volume.
-func (c *Client) EBSPricePerGBMonth(volumeType string) (float64, bool) {
+// MemoryDBDataWrittenPricePerGB returns the MemoryDB rate per GB of data written.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MemoryDBDataWrittenPricePerGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "EBS").
-				Str("volume_type", volumeType).
+				Str("resource_type", "MemoryDB").
+				Str("metric", "DataWritten").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1227,23 +4161,22 @@ func (c *Client) EBSPricePerGBMonth(volumeType string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	price, found := c.ebsIndex[volumeType]
-	if !found {
+	if c.memoryDBPricing == nil || c.memoryDBPricing.DataWrittenRate == 0 {
 		return 0, false
 	}
-	return price.RatePerGBMonth, true
+	return c.memoryDBPricing.DataWrittenRate, true
 }
 
-// S3PricePerGBMonth returns monthly rate per GB for S3 storage.
-func (c *Client) S3PricePerGBMonth(storageClass string) (float64, bool) {
+// MemoryDBSnapshotPricePerGBMonth returns the MemoryDB snapshot storage rate.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MemoryDBSnapshotPricePerGBMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "S3").
-				Str("storage_class", storageClass).
+				Str("resource_type", "MemoryDB").
+				Str("metric", "Snapshot").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1252,26 +4185,22 @@ func (c *Client) S3PricePerGBMonth(storageClass string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	price, found := c.s3Index[storageClass]
-	if !found {
+	if c.memoryDBPricing == nil || c.memoryDBPricing.SnapshotRate == 0 {
 		return 0, false
 	}
-	return price.RatePerGBMonth, true
+	return c.memoryDBPricing.SnapshotRate, true
 }
 
-// RDSOnDemandPricePerHour returns hourly rate for an RDS instance
-// instanceType: e.g., "db.t3.medium"
-// engine: normalized engine name, e.g., "MySQL", "PostgreSQL"
-func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+// MSKBrokerPricePerHour returns the hourly rate for an Amazon MSK broker.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MSKBrokerPricePerHour(instanceType string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "RDS").
+				Str("resource_type", "MSK").
 				Str("instance_type", instanceType).
-				Str("engine", engine).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1280,25 +4209,27 @@ func (c *Client) RDSOnDemandPricePerHour(instanceType, engine string) (float64,
 	if err := c.init(); err != nil {
 		return 0, false
 	}
+	if c.mskPricing == nil {
+		return 0, false
+	}
 
-	key := fmt.Sprintf("%s/%s", instanceType, engine)
-	price, found := c.rdsInstanceIndex[key]
+	price, found := c.mskPricing.BrokerRates[strings.ToLower(instanceType)]
 	if !found {
 		return 0, false
 	}
-	return price.HourlyRate, true
+	return price, true
 }
 
-// RDSStoragePricePerGBMonth returns monthly rate per GB for RDS storage.
-// volumeType: e.g., "gp2", "gp3", "io1", "standard".
-func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
+// MSKStoragePricePerGBMonth returns the MSK broker storage rate per GB-month.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MSKStoragePricePerGBMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "RDS_Storage").
-				Str("volume_type", volumeType).
+				Str("resource_type", "MSK").
+				Str("metric", "Storage").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1307,24 +4238,22 @@ func (c *Client) RDSStoragePricePerGBMonth(volumeType string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	price, found := c.rdsStorageIndex[volumeType]
-	if !found {
+	if c.mskPricing == nil || c.mskPricing.StorageRate == 0 {
 		return 0, false
 	}
-	return price.RatePerGBMonth, true
+	return c.mskPricing.StorageRate, true
 }
 
-// EKSClusterPricePerHour returns hourly rate for EKS cluster control plane.
-// extendedSupport: true for extended support pricing, false for standard support.
-func (c *Client) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
+// MSKThroughputPricePerMBpsMonth returns the MSK provisioned storage throughput rate per MB/s-month.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) MSKThroughputPricePerMBpsMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "EKS").
-				Bool("extended_support", extendedSupport).
+				Str("resource_type", "MSK").
+				Str("metric", "Throughput").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1333,37 +4262,22 @@ func (c *Client) EKSClusterPricePerHour(extendedSupport bool) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	if c.eksPricing == nil {
-		return 0, false
-	}
-
-	if extendedSupport {
-		if c.eksPricing.ExtendedHourlyRate > 0 {
-			return c.eksPricing.ExtendedHourlyRate, true
-		}
+	if c.mskPricing == nil || c.mskPricing.ThroughputRate == 0 {
 		return 0, false
 	}
-
-	if c.eksPricing.StandardHourlyRate > 0 {
-		return c.eksPricing.StandardHourlyRate, true
-	}
-	return 0, false
+	return c.mskPricing.ThroughputRate, true
 }
 
-// LambdaPricePerRequest returns the cost per request for AWS Lambda invocations.
-// The rate is sourced from AWS Price List API product family "AWS Lambda" with
-// group "AWS-Lambda-Requests". Standard pricing is $0.20 per 1 million requests
-// ($0.0000002 per request) as of December 2025.
+// MSKServerlessClusterPricePerHour returns the MSK Serverless cluster-hour rate.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) LambdaPricePerRequest() (float64, bool) {
+func (c *Client) MSKServerlessClusterPricePerHour() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "Lambda").
-				Str("metric", "Requests").
+				Str("resource_type", "MSK").
+				Str("metric", "ServerlessCluster").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1372,33 +4286,22 @@ func (c *Client) LambdaPricePerRequest() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	if c.lambdaPricing == nil || c.lambdaPricing.RequestPrice == 0 {
+	if c.mskPricing == nil || c.mskPricing.ServerlessClusterRate == 0 {
 		return 0, false
 	}
-	return c.lambdaPricing.RequestPrice, true
+	return c.mskPricing.ServerlessClusterRate, true
 }
 
-// LambdaPricePerGBSecond returns the cost per GB-second of compute duration.
-// The rate is sourced from AWS Price List API product family "Serverless" with
-// group "AWS-Lambda-Duration" (x86) or "AWS-Lambda-Duration-ARM" (arm64).
-// This represents the compute cost based on allocated memory and execution time.
-//
-// Architecture pricing (as of December 2025):
-//   - x86_64: ~$0.0000166667 per GB-second
-//   - arm64:  ~$0.0000133334 per GB-second (~20% cheaper)
-//
-// arch parameter accepts: "x86_64", "arm64", "x86", "arm" (defaults to x86_64)
+// MSKServerlessPartitionPricePerHour returns the MSK Serverless partition-hour rate.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
+func (c *Client) MSKServerlessPartitionPricePerHour() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "Lambda").
-				Str("metric", "GB-Second").
-				Str("architecture", arch).
+				Str("resource_type", "MSK").
+				Str("metric", "ServerlessPartition").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1407,41 +4310,22 @@ func (c *Client) LambdaPricePerGBSecond(arch string) (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	if c.lambdaPricing == nil {
-		return 0, false
-	}
-
-	// Normalize architecture string and select appropriate price
-	switch strings.ToLower(arch) {
-	case "arm64", "arm":
-		if c.lambdaPricing.ARMGBSecondPrice > 0 {
-			return c.lambdaPricing.ARMGBSecondPrice, true
-		}
-		// Fall back to x86 if ARM pricing not available
-		if c.lambdaPricing.X86GBSecondPrice > 0 {
-			return c.lambdaPricing.X86GBSecondPrice, true
-		}
-		return 0, false
-	default:
-		// x86_64, x86, or any unrecognized value defaults to x86
-		if c.lambdaPricing.X86GBSecondPrice > 0 {
-			return c.lambdaPricing.X86GBSecondPrice, true
-		}
+	if c.mskPricing == nil || c.mskPricing.ServerlessPartitionRate == 0 {
 		return 0, false
 	}
+	return c.mskPricing.ServerlessPartitionRate, true
 }
 
-// DynamoDBOnDemandReadPrice returns the cost per read request unit.
+// MSKServerlessDataInPricePerGB returns the MSK Serverless rate per GB written.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
+func (c *Client) MSKServerlessDataInPricePerGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "OnDemandRead").
+				Str("resource_type", "MSK").
+				Str("metric", "ServerlessDataIn").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1450,22 +4334,22 @@ func (c *Client) DynamoDBOnDemandReadPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandReadPrice == 0 {
+	if c.mskPricing == nil || c.mskPricing.ServerlessDataInRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.OnDemandReadPrice, true
+	return c.mskPricing.ServerlessDataInRate, true
 }
 
-// DynamoDBOnDemandWritePrice returns the cost per write request unit.
+// MSKServerlessDataOutPricePerGB returns the MSK Serverless rate per GB read.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
+func (c *Client) MSKServerlessDataOutPricePerGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "OnDemandWrite").
+				Str("resource_type", "MSK").
+				Str("metric", "ServerlessDataOut").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1474,22 +4358,22 @@ func (c *Client) DynamoDBOnDemandWritePrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.OnDemandWritePrice == 0 {
+	if c.mskPricing == nil || c.mskPricing.ServerlessDataOutRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.OnDemandWritePrice, true
+	return c.mskPricing.ServerlessDataOutRate, true
 }
 
-// DynamoDBStoragePricePerGBMonth returns the monthly rate per GB for table storage.
+// MSKServerlessStoragePricePerGBMonth returns the MSK Serverless storage rate per GB-month.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
+func (c *Client) MSKServerlessStoragePricePerGBMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "Storage").
+				Str("resource_type", "MSK").
+				Str("metric", "ServerlessStorage").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1498,22 +4382,22 @@ func (c *Client) DynamoDBStoragePricePerGBMonth() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.StoragePrice == 0 {
+	if c.mskPricing == nil || c.mskPricing.ServerlessStorageRate == 0 {
 		return 0, false
 	}
-	return c.dynamoDBPricing.StoragePrice, true
+	return c.mskPricing.ServerlessStorageRate, true
 }
 
-// DynamoDBProvisionedRCUPrice returns the cost per RCU-hour.
+// SageMakerHostingPricePerHour returns the hourly rate for a SageMaker real-time inference instance.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
+func (c *Client) SageMakerHostingPricePerHour(instanceType string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "ProvisionedRCU").
+				Str("resource_type", "SageMakerHosting").
+				Str("instance_type", instanceType).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1522,22 +4406,27 @@ func (c *Client) DynamoDBProvisionedRCUPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedRCUPrice == 0 {
+	if c.sageMakerPricing == nil {
 		return 0, false
 	}
-	return c.dynamoDBPricing.ProvisionedRCUPrice, true
+
+	price, found := c.sageMakerPricing.HostingRates[strings.ToLower(instanceType)]
+	if !found {
+		return 0, false
+	}
+	return price, true
 }
 
-// DynamoDBProvisionedWCUPrice returns the cost per WCU-hour.
+// SageMakerNotebookPricePerHour returns the hourly rate for a SageMaker notebook instance.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
+func (c *Client) SageMakerNotebookPricePerHour(instanceType string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "DynamoDB").
-				Str("metric", "ProvisionedWCU").
+				Str("resource_type", "SageMakerNotebook").
+				Str("instance_type", instanceType).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1546,22 +4435,27 @@ func (c *Client) DynamoDBProvisionedWCUPrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.dynamoDBPricing == nil || c.dynamoDBPricing.ProvisionedWCUPrice == 0 {
+	if c.sageMakerPricing == nil {
 		return 0, false
 	}
-	return c.dynamoDBPricing.ProvisionedWCUPrice, true
+
+	price, found := c.sageMakerPricing.NotebookRates[strings.ToLower(instanceType)]
+	if !found {
+		return 0, false
+	}
+	return price, true
 }
 
-// ALBPricePerHour returns the fixed hourly rate for an Application Load Balancer.
-func (c *Client) ALBPricePerHour() (float64, bool) {
+// SageMakerNotebookStoragePricePerGBMonth returns the SageMaker notebook ML storage rate per GB-month.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) SageMakerNotebookStoragePricePerGBMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "ALB").
-				Str("metric", "FixedHourly").
+				Str("resource_type", "SageMaker").
+				Str("metric", "NotebookStorage").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1570,22 +4464,22 @@ func (c *Client) ALBPricePerHour() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.ALBHourlyRate == 0 {
+	if c.sageMakerPricing == nil || c.sageMakerPricing.NotebookStorageRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.ALBHourlyRate, true
+	return c.sageMakerPricing.NotebookStorageRate, true
 }
 
-// ALBPricePerLCU returns the cost per LCU-hour for an Application Load Balancer.
-func (c *Client) ALBPricePerLCU() (float64, bool) {
+// SageMakerServerlessPricePerGBSecond returns the SageMaker Serverless Inference compute rate per GB-second.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) SageMakerServerlessPricePerGBSecond() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "ALB").
-				Str("metric", "LCU").
+				Str("resource_type", "SageMaker").
+				Str("metric", "ServerlessCompute").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1594,22 +4488,22 @@ func (c *Client) ALBPricePerLCU() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.ALBLCURate == 0 {
+	if c.sageMakerPricing == nil || c.sageMakerPricing.ServerlessGBSecondRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.ALBLCURate, true
+	return c.sageMakerPricing.ServerlessGBSecondRate, true
 }
 
-// NLBPricePerHour returns the fixed hourly rate for a Network Load Balancer.
-func (c *Client) NLBPricePerHour() (float64, bool) {
+// SageMakerServerlessDataPricePerGB returns the SageMaker Serverless Inference rate per GB of data processed.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) SageMakerServerlessDataPricePerGB() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "NLB").
-				Str("metric", "FixedHourly").
+				Str("resource_type", "SageMaker").
+				Str("metric", "ServerlessData").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1618,22 +4512,22 @@ func (c *Client) NLBPricePerHour() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.NLBHourlyRate == 0 {
+	if c.sageMakerPricing == nil || c.sageMakerPricing.ServerlessDataRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.NLBHourlyRate, true
+	return c.sageMakerPricing.ServerlessDataRate, true
 }
 
-// NLBPricePerNLCU returns the cost per NLCU-hour for a Network Load Balancer.
-func (c *Client) NLBPricePerNLCU() (float64, bool) {
+// WAFPricePerWebACLMonth returns the monthly fee per AWS WAF web ACL.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) WAFPricePerWebACLMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "ELB").
-				Str("lb_type", "NLB").
-				Str("metric", "NLCU").
+				Str("resource_type", "WAF").
+				Str("metric", "WebACL").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1642,71 +4536,70 @@ func (c *Client) NLBPricePerNLCU() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.elbPricing == nil || c.elbPricing.NLBNLCURate == 0 {
+	if c.wafPricing == nil || c.wafPricing.WebACLRate == 0 {
 		return 0, false
 	}
-	return c.elbPricing.NLBNLCURate, true
+	return c.wafPricing.WebACLRate, true
 }
 
-// NATGatewayPrice returns the pricing for a NAT Gateway.
-func (c *Client) NATGatewayPrice() (*NATGatewayPrice, bool) {
+// WAFPricePerRuleMonth returns the monthly fee per rule in an AWS WAF web ACL.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) WAFPricePerRuleMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "NATGateway").
+				Str("resource_type", "WAF").
+				Str("metric", "Rule").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.natGatewayPricing == nil || c.natGatewayPricing.HourlyRate == 0 {
-		return nil, false
+	if c.wafPricing == nil || c.wafPricing.RuleRate == 0 {
+		return 0, false
 	}
-	return c.natGatewayPricing, true
+	return c.wafPricing.RuleRate, true
 }
 
-// CloudWatchLogsIngestionTiers returns the tiered pricing for CloudWatch log ingestion.
-// Returns (tiers, true) if found, (nil, false) if not found.
-func (c *Client) CloudWatchLogsIngestionTiers() ([]TierRate, bool) {
+// WAFPricePerRequest returns the AWS WAF cost per web request inspected.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) WAFPricePerRequest() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "LogsIngestionTiers").
+				Str("resource_type", "WAF").
+				Str("metric", "Request").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.LogsIngestionTiers) == 0 {
-		return nil, false
+	if c.wafPricing == nil || c.wafPricing.RequestRate == 0 {
+		return 0, false
 	}
-	// Return a copy to prevent callers from modifying shared pricing data
-	result := make([]TierRate, len(c.cloudWatchPricing.LogsIngestionTiers))
-	copy(result, c.cloudWatchPricing.LogsIngestionTiers)
-	return result, true
+	return c.wafPricing.RequestRate, true
 }
 
-// CloudWatchLogsStoragePrice returns the per-GB-month rate for CloudWatch log storage.
+// ShieldAdvancedPricePerMonth returns the Shield Advanced subscription fee per month.
 // Returns (price, true) if found, (0, false) if not found.
-func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
+func (c *Client) ShieldAdvancedPricePerMonth() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "LogsStorage").
+				Str("resource_type", "Shield").
+				Str("metric", "MonthlyFee").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1715,60 +4608,48 @@ func (c *Client) CloudWatchLogsStoragePrice() (float64, bool) {
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-	if c.cloudWatchPricing == nil || c.cloudWatchPricing.LogsStorageRate == 0 {
+	if c.shieldPricing == nil || c.shieldPricing.MonthlyFee == 0 {
 		return 0, false
 	}
-	return c.cloudWatchPricing.LogsStorageRate, true
+	return c.shieldPricing.MonthlyFee, true
 }
 
-// CloudWatchMetricsTiers returns the tiered pricing for CloudWatch custom metrics.
-// Returns (tiers, true) if found, (nil, false) if not found.
-func (c *Client) CloudWatchMetricsTiers() ([]TierRate, bool) {
+// GlobalAcceleratorPricePerHour returns the Global Accelerator fixed fee per accelerator-hour.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) GlobalAcceleratorPricePerHour() (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "CloudWatch").
-				Str("metric", "MetricsTiers").
+				Str("resource_type", "GlobalAccelerator").
+				Str("metric", "FixedFee").
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
 	}()
 
 	if err := c.init(); err != nil {
-		return nil, false
+		return 0, false
 	}
-	if c.cloudWatchPricing == nil || len(c.cloudWatchPricing.MetricsTiers) == 0 {
-		return nil, false
+	if c.globalAcceleratorPricing == nil || c.globalAcceleratorPricing.HourlyRate == 0 {
+		return 0, false
 	}
-	// Return a copy to prevent callers from modifying shared pricing data
-	result := make([]TierRate, len(c.cloudWatchPricing.MetricsTiers))
-	copy(result, c.cloudWatchPricing.MetricsTiers)
-	return result, true
+	return c.globalAcceleratorPricing.HourlyRate, true
 }
 
-// ElastiCacheOnDemandPricePerHour returns the hourly rate for an ElastiCache cache node.
-//
-// Parameters:
-//   - instanceType: The cache node type (e.g., "cache.m5.large", "cache.t3.micro")
-//   - engine: The cache engine, case-insensitive ("redis", "memcached", "valkey")
-//
-// Engine names are normalized to AWS canonical format:
-//   - "redis", "Redis", "REDIS" -> "Redis"
-//   - "memcached", "Memcached" -> "Memcached"
-//   - "valkey", "Valkey" -> "Valkey"
-//
-// Returns (price, true) if found, (0, false) if not found. or engine unknown.
-func (c *Client) ElastiCacheOnDemandPricePerHour(instanceType, engine string) (float64, bool) {
+// GlobalAcceleratorDTPremiumPricePerGB returns the Global Accelerator Data Transfer-Premium
+// rate per GB from the source geography to the destination geography.
+// Returns (price, true) if found, (0, false) if not found.
+func (c *Client) GlobalAcceleratorDTPremiumPricePerGB(source, destination string) (float64, bool) {
 	start := time.Now()
 	defer func() {
 		elapsed := time.Since(start)
 		if elapsed > 50*time.Millisecond {
 			c.logger.Warn().
-				Str("resource_type", "ElastiCache").
-				Str("instance_type", instanceType).
-				Str("engine", engine).
+				Str("resource_type", "GlobalAccelerator").
+				Str("source", source).
+				Str("destination", destination).
 				Dur("elapsed", elapsed).
 				Msg("pricing lookup took too long")
 		}
@@ -1777,19 +4658,13 @@ func (c *Client) ElastiCacheOnDemandPricePerHour(instanceType, engine string) (f
 	if err := c.init(); err != nil {
 		return 0, false
 	}
-
-	// Normalize engine name to AWS canonical format
-	normalizedEngine, ok := elasticacheEngineNormalization[strings.ToLower(engine)]
-	if !ok {
-		// Unknown engine - return not found
+	if c.globalAcceleratorPricing == nil {
 		return 0, false
 	}
 
-	// Build index key: "instanceType:engine"
-	key := fmt.Sprintf("%s:%s", instanceType, normalizedEngine)
-	price, found := c.elasticacheIndex[key]
+	price, found := c.globalAcceleratorPricing.DTPremiumRates[geographyPairKey(source, destination)]
 	if !found {
 		return 0, false
 	}
-	return price.HourlyRate, true
+	return price, true
 }
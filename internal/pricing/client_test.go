@@ -641,6 +641,22 @@ func TestEmbeddedData_AllServicesOfferCodes(t *testing.T) {
 		{"Lambda", rawLambdaJSON, "AWSLambda"},
 		{"DynamoDB", rawDynamoDBJSON, "AmazonDynamoDB"},
 		{"ELB", rawELBJSON, "AWSELB"},
+		{"SecretsManager", rawSecretsManagerJSON, "AWSSecretsManager"},
+		{"KMS", rawKMSJSON, "awskms"},
+		{"OpenSearch", rawOpenSearchJSON, "AmazonES"},
+		{"Redshift", rawRedshiftJSON, "AmazonRedshift"},
+		{"ECR", rawECRJSON, "AmazonECR"},
+		{"Backup", rawBackupJSON, "AWSBackup"},
+		{"StepFunctions", rawStepFunctionsJSON, "AmazonStates"},
+		{"EventBridge", rawEventBridgeJSON, "AWSEvents"},
+		{"DocumentDB", rawDocumentDBJSON, "AmazonDocDB"},
+		{"Neptune", rawNeptuneJSON, "AmazonNeptune"},
+		{"MemoryDB", rawMemoryDBJSON, "AmazonMemoryDB"},
+		{"MSK", rawMSKJSON, "AmazonMSK"},
+		{"SageMaker", rawSageMakerJSON, "AmazonSageMaker"},
+		{"WAF", rawWAFJSON, "awswaf"},
+		{"Shield", rawShieldJSON, "AWSShield"},
+		{"GlobalAccelerator", rawGlobalAcceleratorJSON, "AWSGlobalAccelerator"},
 	}
 
 	for _, tt := range tests {
@@ -36,3 +36,51 @@ var rawCloudWatchJSON []byte
 
 //go:embed data/elasticache_ap-southeast-1.json
 var rawElastiCacheJSON []byte
+
+//go:embed data/secretsmanager_ap-southeast-1.json
+var rawSecretsManagerJSON []byte
+
+//go:embed data/kms_ap-southeast-1.json
+var rawKMSJSON []byte
+
+//go:embed data/opensearch_ap-southeast-1.json
+var rawOpenSearchJSON []byte
+
+//go:embed data/redshift_ap-southeast-1.json
+var rawRedshiftJSON []byte
+
+//go:embed data/ecr_ap-southeast-1.json
+var rawECRJSON []byte
+
+//go:embed data/backup_ap-southeast-1.json
+var rawBackupJSON []byte
+
+//go:embed data/stepfunctions_ap-southeast-1.json
+var rawStepFunctionsJSON []byte
+
+//go:embed data/eventbridge_ap-southeast-1.json
+var rawEventBridgeJSON []byte
+
+//go:embed data/docdb_ap-southeast-1.json
+var rawDocumentDBJSON []byte
+
+//go:embed data/neptune_ap-southeast-1.json
+var rawNeptuneJSON []byte
+
+//go:embed data/memorydb_ap-southeast-1.json
+var rawMemoryDBJSON []byte
+
+//go:embed data/msk_ap-southeast-1.json
+var rawMSKJSON []byte
+
+//go:embed data/sagemaker_ap-southeast-1.json
+var rawSageMakerJSON []byte
+
+//go:embed data/waf_ap-southeast-1.json
+var rawWAFJSON []byte
+
+//go:embed data/shield_ap-southeast-1.json
+var rawShieldJSON []byte
+
+//go:embed data/globalaccelerator_ap-southeast-1.json
+var rawGlobalAcceleratorJSON []byte
@@ -493,3 +493,2579 @@ var rawElastiCacheJSON = []byte(`{
     }
   }
 }`)
+
+// rawSecretsManagerJSON contains minimal Secrets Manager pricing data for development/testing.
+var rawSecretsManagerJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSSecretsManager",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_SM_SECRET": {
+      "sku": "SKU_SM_SECRET",
+      "productFamily": "Secret",
+      "attributes": {
+        "usagetype": "AWSSecretsManager-Secrets",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_API": {
+      "sku": "SKU_SM_API",
+      "productFamily": "API Request",
+      "attributes": {
+        "usagetype": "AWSSecretsManager-APIRequest",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_SM_SECRET": {
+        "SKU_SM_SECRET.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_SECRET",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_SECRET.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_SECRET.JRTCKXETXF.6YS6EN2CT7",
+              "description": "Secrets Manager secret per month",
+              "unit": "Secrets",
+              "pricePerUnit": { "USD": "0.40" }
+            }
+          }
+        }
+      },
+      "SKU_SM_API": {
+        "SKU_SM_API.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_API",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_API.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_API.JRTCKXETXF.6YS6EN2CT7",
+              "description": "Secrets Manager API request",
+              "unit": "API Requests",
+              "pricePerUnit": { "USD": "0.000005" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawKMSJSON contains minimal KMS pricing data for development/testing.
+var rawKMSJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "awskms",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_KMS_KEY": {
+      "sku": "SKU_KMS_KEY",
+      "productFamily": "Encryption Key",
+      "attributes": {
+        "usagetype": "KMS-Keys",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_KMS_REQUESTS": {
+      "sku": "SKU_KMS_REQUESTS",
+      "productFamily": "KMS Requests",
+      "attributes": {
+        "usagetype": "KMS-Requests",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_KMS_KEY": {
+        "SKU_KMS_KEY.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_KMS_KEY",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_KMS_KEY.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_KMS_KEY.JRTCKXETXF.6YS6EN2CT7",
+              "description": "KMS customer managed key per month",
+              "unit": "Keys",
+              "pricePerUnit": { "USD": "1.00" }
+            }
+          }
+        }
+      },
+      "SKU_KMS_REQUESTS": {
+        "SKU_KMS_REQUESTS.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_KMS_REQUESTS",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_KMS_REQUESTS.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_KMS_REQUESTS.JRTCKXETXF.6YS6EN2CT7",
+              "description": "KMS requests free tier",
+              "beginRange": "0",
+              "endRange": "20000",
+              "unit": "Requests",
+              "pricePerUnit": { "USD": "0.0000000000" }
+            },
+            "SKU_KMS_REQUESTS.JRTCKXETXF.8EEUB22XNJ": {
+              "rateCode": "SKU_KMS_REQUESTS.JRTCKXETXF.8EEUB22XNJ",
+              "description": "KMS symmetric requests",
+              "beginRange": "20000",
+              "endRange": "Inf",
+              "unit": "Requests",
+              "pricePerUnit": { "USD": "0.000003" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawOpenSearchJSON contains minimal OpenSearch pricing data for development/testing.
+var rawOpenSearchJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonES",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_OS_M6G_LARGE": {
+      "sku": "SKU_OS_M6G_LARGE",
+      "productFamily": "Amazon OpenSearch Service Instance",
+      "attributes": {
+        "instanceType": "m6g.large.search",
+        "usagetype": "ESInstance:m6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_R6G_LARGE": {
+      "sku": "SKU_OS_R6G_LARGE",
+      "productFamily": "Amazon OpenSearch Service Instance",
+      "attributes": {
+        "instanceType": "r6g.large.search",
+        "usagetype": "ESInstance:r6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_ULTRAWARM_MEDIUM": {
+      "sku": "SKU_OS_ULTRAWARM_MEDIUM",
+      "productFamily": "Amazon OpenSearch Service Instance",
+      "attributes": {
+        "instanceType": "ultrawarm1.medium.search",
+        "usagetype": "ESInstance:ultrawarm1.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_GP3": {
+      "sku": "SKU_OS_GP3",
+      "productFamily": "Amazon OpenSearch Service Volume",
+      "attributes": {
+        "storageMedia": "GP3",
+        "usagetype": "ES:GP3-Storage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_GP2": {
+      "sku": "SKU_OS_GP2",
+      "productFamily": "Amazon OpenSearch Service Volume",
+      "attributes": {
+        "storageMedia": "GP2",
+        "usagetype": "ES:GP2-Storage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_SERVERLESS_INDEXING": {
+      "sku": "SKU_OS_SERVERLESS_INDEXING",
+      "productFamily": "Amazon OpenSearch Service Serverless",
+      "attributes": {
+        "usagetype": "IndexingOCU",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_OS_SERVERLESS_SEARCH": {
+      "sku": "SKU_OS_SERVERLESS_SEARCH",
+      "productFamily": "Amazon OpenSearch Service Serverless",
+      "attributes": {
+        "usagetype": "SearchOCU",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_OS_M6G_LARGE": {
+        "SKU_OS_M6G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_M6G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_M6G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_M6G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.128 per m6g.large.search instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.128" }
+            }
+          }
+        }
+      },
+      "SKU_OS_R6G_LARGE": {
+        "SKU_OS_R6G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_R6G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.167 per r6g.large.search instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.167" }
+            }
+          }
+        }
+      },
+      "SKU_OS_ULTRAWARM_MEDIUM": {
+        "SKU_OS_ULTRAWARM_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_ULTRAWARM_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_ULTRAWARM_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_ULTRAWARM_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.238 per ultrawarm1.medium.search instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.238" }
+            }
+          }
+        }
+      },
+      "SKU_OS_GP3": {
+        "SKU_OS_GP3.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_GP3",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_GP3.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_GP3.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.122 per GB-month of GP3 storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.122" }
+            }
+          }
+        }
+      },
+      "SKU_OS_GP2": {
+        "SKU_OS_GP2.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_GP2",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_GP2.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_GP2.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.135 per GB-month of General Purpose provisioned storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.135" }
+            }
+          }
+        }
+      },
+      "SKU_OS_SERVERLESS_INDEXING": {
+        "SKU_OS_SERVERLESS_INDEXING.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_SERVERLESS_INDEXING",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_SERVERLESS_INDEXING.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_SERVERLESS_INDEXING.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.24 per OCU-hour for indexing",
+              "unit": "OCU-hours",
+              "pricePerUnit": { "USD": "0.24" }
+            }
+          }
+        }
+      },
+      "SKU_OS_SERVERLESS_SEARCH": {
+        "SKU_OS_SERVERLESS_SEARCH.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_OS_SERVERLESS_SEARCH",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_OS_SERVERLESS_SEARCH.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_OS_SERVERLESS_SEARCH.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.24 per OCU-hour for search",
+              "unit": "OCU-hours",
+              "pricePerUnit": { "USD": "0.24" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawRedshiftJSON contains minimal Redshift pricing data for development/testing.
+var rawRedshiftJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonRedshift",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_RS_DC2_LARGE": {
+      "sku": "SKU_RS_DC2_LARGE",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "dc2.large",
+        "usagetype": "Node:dc2.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_RS_DC2_8XLARGE": {
+      "sku": "SKU_RS_DC2_8XLARGE",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "dc2.8xlarge",
+        "usagetype": "Node:dc2.8xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_RS_RA3_LARGE": {
+      "sku": "SKU_RS_RA3_LARGE",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "ra3.large",
+        "usagetype": "Node:ra3.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_RS_RA3_4XLARGE": {
+      "sku": "SKU_RS_RA3_4XLARGE",
+      "productFamily": "Compute Instance",
+      "attributes": {
+        "instanceType": "ra3.4xlarge",
+        "usagetype": "Node:ra3.4xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_RS_RMS": {
+      "sku": "SKU_RS_RMS",
+      "productFamily": "Redshift Managed Storage",
+      "attributes": {
+        "usagetype": "RMS:ra3",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_RS_SERVERLESS": {
+      "sku": "SKU_RS_SERVERLESS",
+      "productFamily": "Redshift Serverless",
+      "attributes": {
+        "usagetype": "ServerlessUsage",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_RS_DC2_LARGE": {
+        "SKU_RS_DC2_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_DC2_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_DC2_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_DC2_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.25 per DC2 Large Compute Node-hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.25" }
+            }
+          }
+        }
+      },
+      "SKU_RS_DC2_8XLARGE": {
+        "SKU_RS_DC2_8XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_DC2_8XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_DC2_8XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_DC2_8XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$4.80 per DC2 Eight Extra Large Compute Node-hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "4.80" }
+            }
+          }
+        }
+      },
+      "SKU_RS_RA3_LARGE": {
+        "SKU_RS_RA3_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_RA3_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_RA3_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_RA3_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.543 per RA3 Large Compute Node-hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.543" }
+            }
+          }
+        }
+      },
+      "SKU_RS_RA3_4XLARGE": {
+        "SKU_RS_RA3_4XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_RA3_4XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_RA3_4XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_RA3_4XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$3.26 per RA3 4XL Compute Node-hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "3.26" }
+            }
+          }
+        }
+      },
+      "SKU_RS_RMS": {
+        "SKU_RS_RMS.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_RMS",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_RMS.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_RMS.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.024 per GB-month for Redshift Managed Storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.024" }
+            }
+          }
+        }
+      },
+      "SKU_RS_SERVERLESS": {
+        "SKU_RS_SERVERLESS.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_RS_SERVERLESS",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_RS_SERVERLESS.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_RS_SERVERLESS.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.375 per RPU hour for Redshift Serverless",
+              "unit": "RPU-Hr",
+              "pricePerUnit": { "USD": "0.375" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawECRJSON contains minimal ECR pricing data for development/testing.
+var rawECRJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonECR",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_ECR_STORAGE": {
+      "sku": "SKU_ECR_STORAGE",
+      "productFamily": "EC2 Container Registry",
+      "attributes": {
+        "usagetype": "TimedStorage-ByteHrs",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_ECR_STORAGE": {
+        "SKU_ECR_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_ECR_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_ECR_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_ECR_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of data storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawBackupJSON contains minimal AWS Backup pricing data for development/testing.
+var rawBackupJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSBackup",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_BACKUP_EBS_WARM": {
+      "sku": "SKU_BACKUP_EBS_WARM",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "WarmStorage-ByteHrs-EBS",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_EBS_COLD": {
+      "sku": "SKU_BACKUP_EBS_COLD",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "ColdStorage-ByteHrs-EBS",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_EFS_WARM": {
+      "sku": "SKU_BACKUP_EFS_WARM",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "WarmStorage-ByteHrs-EFS",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_EFS_COLD": {
+      "sku": "SKU_BACKUP_EFS_COLD",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "ColdStorage-ByteHrs-EFS",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_RDS_WARM": {
+      "sku": "SKU_BACKUP_RDS_WARM",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "WarmStorage-ByteHrs-RDS",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_DDB_WARM": {
+      "sku": "SKU_BACKUP_DDB_WARM",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "WarmStorage-ByteHrs-DynamoDB",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_DDB_COLD": {
+      "sku": "SKU_BACKUP_DDB_COLD",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "ColdStorage-ByteHrs-DynamoDB",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_BACKUP_S3_WARM": {
+      "sku": "SKU_BACKUP_S3_WARM",
+      "productFamily": "AWS Backup Storage",
+      "attributes": {
+        "usagetype": "WarmStorage-ByteHrs-S3",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_BACKUP_EBS_WARM": {
+        "SKU_BACKUP_EBS_WARM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_EBS_WARM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_EBS_WARM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_EBS_WARM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.05 per GB-month of EBS snapshot backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.05" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_EBS_COLD": {
+        "SKU_BACKUP_EBS_COLD.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_EBS_COLD",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_EBS_COLD.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_EBS_COLD.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.0125 per GB-month of EBS snapshot archive storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.0125" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_EFS_WARM": {
+        "SKU_BACKUP_EFS_WARM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_EFS_WARM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_EFS_WARM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_EFS_WARM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.05 per GB-month of EFS warm backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.05" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_EFS_COLD": {
+        "SKU_BACKUP_EFS_COLD.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_EFS_COLD",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_EFS_COLD.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_EFS_COLD.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.01 per GB-month of EFS cold backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.01" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_RDS_WARM": {
+        "SKU_BACKUP_RDS_WARM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_RDS_WARM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_RDS_WARM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_RDS_WARM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.095 per GB-month of RDS snapshot backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.095" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_DDB_WARM": {
+        "SKU_BACKUP_DDB_WARM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_DDB_WARM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_DDB_WARM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_DDB_WARM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of DynamoDB warm backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_DDB_COLD": {
+        "SKU_BACKUP_DDB_COLD.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_DDB_COLD",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_DDB_COLD.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_DDB_COLD.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.03 per GB-month of DynamoDB cold backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.03" }
+            }
+          }
+        }
+      },
+      "SKU_BACKUP_S3_WARM": {
+        "SKU_BACKUP_S3_WARM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_BACKUP_S3_WARM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_BACKUP_S3_WARM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_BACKUP_S3_WARM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.05 per GB-month of S3 backup storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.05" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawStepFunctionsJSON contains minimal Step Functions pricing data for development/testing.
+var rawStepFunctionsJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonStates",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_SFN_STANDARD": {
+      "sku": "SKU_SFN_STANDARD",
+      "productFamily": "AWS Step Functions",
+      "attributes": {
+        "usagetype": "StateTransition",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SFN_EXPRESS_REQ": {
+      "sku": "SKU_SFN_EXPRESS_REQ",
+      "productFamily": "AWS Step Functions",
+      "attributes": {
+        "usagetype": "ExpressWorkflows-Requests",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SFN_EXPRESS_DUR": {
+      "sku": "SKU_SFN_EXPRESS_DUR",
+      "productFamily": "AWS Step Functions",
+      "attributes": {
+        "usagetype": "ExpressWorkflows-Duration-GB-Second",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_SFN_STANDARD": {
+        "SKU_SFN_STANDARD.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SFN_STANDARD",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SFN_STANDARD.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SFN_STANDARD.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.025 per 1,000 state transitions",
+              "unit": "StateTransitions",
+              "pricePerUnit": { "USD": "0.000025" }
+            }
+          }
+        }
+      },
+      "SKU_SFN_EXPRESS_REQ": {
+        "SKU_SFN_EXPRESS_REQ.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SFN_EXPRESS_REQ",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SFN_EXPRESS_REQ.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SFN_EXPRESS_REQ.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$1.00 per million Express Workflow requests",
+              "unit": "Requests",
+              "pricePerUnit": { "USD": "0.000001" }
+            }
+          }
+        }
+      },
+      "SKU_SFN_EXPRESS_DUR": {
+        "SKU_SFN_EXPRESS_DUR.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SFN_EXPRESS_DUR",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.00001667 per GB-second for the first 1,000 GB-hours",
+              "beginRange": "0",
+              "endRange": "3600000",
+              "unit": "GB-Second",
+              "pricePerUnit": { "USD": "0.00001667" }
+            },
+            "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.8EEUB22XNJ": {
+              "rateCode": "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.8EEUB22XNJ",
+              "description": "$0.00000833 per GB-second for the next 4 million GB-hours",
+              "beginRange": "3600000",
+              "endRange": "14403600000",
+              "unit": "GB-Second",
+              "pricePerUnit": { "USD": "0.00000833" }
+            },
+            "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.PGHJ3S3EYE": {
+              "rateCode": "SKU_SFN_EXPRESS_DUR.JRTCKXETXF.PGHJ3S3EYE",
+              "description": "$0.00000456 per GB-second thereafter",
+              "beginRange": "14403600000",
+              "endRange": "Inf",
+              "unit": "GB-Second",
+              "pricePerUnit": { "USD": "0.00000456" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawEventBridgeJSON contains minimal EventBridge pricing data for development/testing.
+var rawEventBridgeJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSEvents",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_EB_CUSTOM": {
+      "sku": "SKU_EB_CUSTOM",
+      "productFamily": "EventBridge",
+      "attributes": {
+        "eventType": "Custom Events",
+        "usagetype": "Event-64K-Chunks",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_EB_PIPES": {
+      "sku": "SKU_EB_PIPES",
+      "productFamily": "EventBridge",
+      "attributes": {
+        "usagetype": "Pipes-Requests-64K-Chunks",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_EB_CUSTOM": {
+        "SKU_EB_CUSTOM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_EB_CUSTOM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_EB_CUSTOM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_EB_CUSTOM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$1.00 per million custom events published",
+              "unit": "Events",
+              "pricePerUnit": { "USD": "0.000001" }
+            }
+          }
+        }
+      },
+      "SKU_EB_PIPES": {
+        "SKU_EB_PIPES.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_EB_PIPES",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_EB_PIPES.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_EB_PIPES.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.40 per million Pipes requests",
+              "unit": "Requests",
+              "pricePerUnit": { "USD": "0.0000004" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawDocumentDBJSON contains minimal DocumentDB pricing data for development/testing.
+var rawDocumentDBJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonDocDB",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_DOCDB_T3_MEDIUM": {
+      "sku": "SKU_DOCDB_T3_MEDIUM",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.t3.medium",
+        "usagetype": "InstanceUsage:db.t3.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_T4G_MEDIUM": {
+      "sku": "SKU_DOCDB_T4G_MEDIUM",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.t4g.medium",
+        "usagetype": "InstanceUsage:db.t4g.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_R4_LARGE": {
+      "sku": "SKU_DOCDB_R4_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r4.large",
+        "usagetype": "InstanceUsage:db.r4.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_R5_LARGE": {
+      "sku": "SKU_DOCDB_R5_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r5.large",
+        "usagetype": "InstanceUsage:db.r5.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_R6G_LARGE": {
+      "sku": "SKU_DOCDB_R6G_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r6g.large",
+        "usagetype": "InstanceUsage:db.r6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_R6G_LARGE_IOOPT": {
+      "sku": "SKU_DOCDB_R6G_LARGE_IOOPT",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r6g.large",
+        "usagetype": "InstanceUsageIOOptimized:db.r6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_STORAGE": {
+      "sku": "SKU_DOCDB_STORAGE",
+      "productFamily": "Database Storage",
+      "attributes": {
+        "usagetype": "StorageUsage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_STORAGE_IOOPT": {
+      "sku": "SKU_DOCDB_STORAGE_IOOPT",
+      "productFamily": "Database Storage",
+      "attributes": {
+        "usagetype": "IOOptimizedStorageUsage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_IO": {
+      "sku": "SKU_DOCDB_IO",
+      "productFamily": "System Operation",
+      "attributes": {
+        "usagetype": "StorageIOUsage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_DOCDB_BACKUP": {
+      "sku": "SKU_DOCDB_BACKUP",
+      "productFamily": "Storage Snapshot",
+      "attributes": {
+        "usagetype": "BackupUsage",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_DOCDB_T3_MEDIUM": {
+        "SKU_DOCDB_T3_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_T3_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.078 per db.t3.medium instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.078" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_T4G_MEDIUM": {
+        "SKU_DOCDB_T4G_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_T4G_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.073 per db.t4g.medium instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.073" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_R4_LARGE": {
+        "SKU_DOCDB_R4_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_R4_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_R4_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_R4_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.277 per db.r4.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.277" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_R5_LARGE": {
+        "SKU_DOCDB_R5_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_R5_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_R5_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_R5_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.277 per db.r5.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.277" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_R6G_LARGE": {
+        "SKU_DOCDB_R6G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_R6G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.249 per db.r6g.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.249" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_R6G_LARGE_IOOPT": {
+        "SKU_DOCDB_R6G_LARGE_IOOPT.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_R6G_LARGE_IOOPT",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_R6G_LARGE_IOOPT.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_R6G_LARGE_IOOPT.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.274 per db.r6g.large I/O-Optimized instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.274" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_STORAGE": {
+        "SKU_DOCDB_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_STORAGE_IOOPT": {
+        "SKU_DOCDB_STORAGE_IOOPT.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_STORAGE_IOOPT",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_STORAGE_IOOPT.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_STORAGE_IOOPT.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.30 per GB-month of I/O-Optimized storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.30" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_IO": {
+        "SKU_DOCDB_IO.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_IO",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_IO.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_IO.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.20 per 1 million I/O requests",
+              "unit": "IOs",
+              "pricePerUnit": { "USD": "0.0000002" }
+            }
+          }
+        }
+      },
+      "SKU_DOCDB_BACKUP": {
+        "SKU_DOCDB_BACKUP.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_DOCDB_BACKUP",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_DOCDB_BACKUP.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_DOCDB_BACKUP.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.021 per GB-month of backup storage beyond the free allowance",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.021" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawNeptuneJSON contains minimal Neptune pricing data for development/testing.
+var rawNeptuneJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonNeptune",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_NEPTUNE_T3_MEDIUM": {
+      "sku": "SKU_NEPTUNE_T3_MEDIUM",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.t3.medium",
+        "usagetype": "InstanceUsage:db.t3.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_T4G_MEDIUM": {
+      "sku": "SKU_NEPTUNE_T4G_MEDIUM",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.t4g.medium",
+        "usagetype": "InstanceUsage:db.t4g.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_R4_LARGE": {
+      "sku": "SKU_NEPTUNE_R4_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r4.large",
+        "usagetype": "InstanceUsage:db.r4.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_R5_LARGE": {
+      "sku": "SKU_NEPTUNE_R5_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r5.large",
+        "usagetype": "InstanceUsage:db.r5.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_R6G_LARGE": {
+      "sku": "SKU_NEPTUNE_R6G_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r6g.large",
+        "usagetype": "InstanceUsage:db.r6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_R6I_LARGE": {
+      "sku": "SKU_NEPTUNE_R6I_LARGE",
+      "productFamily": "Database Instance",
+      "attributes": {
+        "instanceType": "db.r6i.large",
+        "usagetype": "InstanceUsage:db.r6i.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_STORAGE": {
+      "sku": "SKU_NEPTUNE_STORAGE",
+      "productFamily": "Database Storage",
+      "attributes": {
+        "usagetype": "StorageUsage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_IO": {
+      "sku": "SKU_NEPTUNE_IO",
+      "productFamily": "System Operation",
+      "attributes": {
+        "usagetype": "StorageIOUsage",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_NEPTUNE_BACKUP": {
+      "sku": "SKU_NEPTUNE_BACKUP",
+      "productFamily": "Storage Snapshot",
+      "attributes": {
+        "usagetype": "BackupUsage",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_NEPTUNE_T3_MEDIUM": {
+        "SKU_NEPTUNE_T3_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_T3_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.098 per db.t3.medium instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.098" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_T4G_MEDIUM": {
+        "SKU_NEPTUNE_T4G_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_T4G_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.087 per db.t4g.medium instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.087" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_R4_LARGE": {
+        "SKU_NEPTUNE_R4_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_R4_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_R4_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_R4_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.348 per db.r4.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.348" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_R5_LARGE": {
+        "SKU_NEPTUNE_R5_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_R5_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_R5_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_R5_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.348 per db.r5.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.348" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_R6G_LARGE": {
+        "SKU_NEPTUNE_R6G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_R6G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.313 per db.r6g.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.313" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_R6I_LARGE": {
+        "SKU_NEPTUNE_R6I_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_R6I_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_R6I_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_R6I_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.348 per db.r6i.large instance hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.348" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_STORAGE": {
+        "SKU_NEPTUNE_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_IO": {
+        "SKU_NEPTUNE_IO.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_IO",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_IO.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_IO.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.20 per 1 million I/O requests",
+              "unit": "IOs",
+              "pricePerUnit": { "USD": "0.0000002" }
+            }
+          }
+        }
+      },
+      "SKU_NEPTUNE_BACKUP": {
+        "SKU_NEPTUNE_BACKUP.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_NEPTUNE_BACKUP",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_NEPTUNE_BACKUP.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_NEPTUNE_BACKUP.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.021 per GB-month of backup storage beyond the free allowance",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.021" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawMemoryDBJSON contains minimal MemoryDB pricing data for development/testing.
+var rawMemoryDBJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonMemoryDB",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_MEMDB_T4G_SMALL": {
+      "sku": "SKU_MEMDB_T4G_SMALL",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "instanceType": "db.t4g.small",
+        "usagetype": "NodeUsage:db.t4g.small",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MEMDB_T4G_MEDIUM": {
+      "sku": "SKU_MEMDB_T4G_MEDIUM",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "instanceType": "db.t4g.medium",
+        "usagetype": "NodeUsage:db.t4g.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MEMDB_R6G_LARGE": {
+      "sku": "SKU_MEMDB_R6G_LARGE",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "instanceType": "db.r6g.large",
+        "usagetype": "NodeUsage:db.r6g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MEMDB_R7G_LARGE": {
+      "sku": "SKU_MEMDB_R7G_LARGE",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "instanceType": "db.r7g.large",
+        "usagetype": "NodeUsage:db.r7g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MEMDB_DATA_WRITTEN": {
+      "sku": "SKU_MEMDB_DATA_WRITTEN",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "usagetype": "DataWritten-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MEMDB_SNAPSHOT": {
+      "sku": "SKU_MEMDB_SNAPSHOT",
+      "productFamily": "Amazon MemoryDB",
+      "attributes": {
+        "usagetype": "SnapshotStorage-ByteHrs",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_MEMDB_T4G_SMALL": {
+        "SKU_MEMDB_T4G_SMALL.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_T4G_SMALL",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_T4G_SMALL.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_T4G_SMALL.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.054 per db.t4g.small node hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.054" }
+            }
+          }
+        }
+      },
+      "SKU_MEMDB_T4G_MEDIUM": {
+        "SKU_MEMDB_T4G_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_T4G_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_T4G_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.108 per db.t4g.medium node hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.108" }
+            }
+          }
+        }
+      },
+      "SKU_MEMDB_R6G_LARGE": {
+        "SKU_MEMDB_R6G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_R6G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_R6G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.309 per db.r6g.large node hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.309" }
+            }
+          }
+        }
+      },
+      "SKU_MEMDB_R7G_LARGE": {
+        "SKU_MEMDB_R7G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_R7G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_R7G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_R7G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.327 per db.r7g.large node hour (or partial hour)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.327" }
+            }
+          }
+        }
+      },
+      "SKU_MEMDB_DATA_WRITTEN": {
+        "SKU_MEMDB_DATA_WRITTEN.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_DATA_WRITTEN",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_DATA_WRITTEN.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_DATA_WRITTEN.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.20 per GB of data written",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.20" }
+            }
+          }
+        }
+      },
+      "SKU_MEMDB_SNAPSHOT": {
+        "SKU_MEMDB_SNAPSHOT.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MEMDB_SNAPSHOT",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MEMDB_SNAPSHOT.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MEMDB_SNAPSHOT.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.021 per GB-month of snapshot storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.021" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawMSKJSON contains minimal MSK pricing data for development/testing.
+var rawMSKJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonMSK",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_MSK_T3_SMALL": {
+      "sku": "SKU_MSK_T3_SMALL",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.t3.small",
+        "usagetype": "Kafka.t3.small",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M5_LARGE": {
+      "sku": "SKU_MSK_M5_LARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m5.large",
+        "usagetype": "Kafka.m5.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M5_XLARGE": {
+      "sku": "SKU_MSK_M5_XLARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m5.xlarge",
+        "usagetype": "Kafka.m5.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M5_2XLARGE": {
+      "sku": "SKU_MSK_M5_2XLARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m5.2xlarge",
+        "usagetype": "Kafka.m5.2xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M7G_LARGE": {
+      "sku": "SKU_MSK_M7G_LARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m7g.large",
+        "usagetype": "Kafka.m7g.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M7G_XLARGE": {
+      "sku": "SKU_MSK_M7G_XLARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m7g.xlarge",
+        "usagetype": "Kafka.m7g.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_M7G_2XLARGE": {
+      "sku": "SKU_MSK_M7G_2XLARGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "instanceType": "kafka.m7g.2xlarge",
+        "usagetype": "Kafka.m7g.2xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_STORAGE": {
+      "sku": "SKU_MSK_STORAGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Storage.GP2",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_THROUGHPUT": {
+      "sku": "SKU_MSK_THROUGHPUT",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Storage.ProvisionedThroughput",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_SLS_CLUSTER": {
+      "sku": "SKU_MSK_SLS_CLUSTER",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Serverless.ClusterHours",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_SLS_PARTITION": {
+      "sku": "SKU_MSK_SLS_PARTITION",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Serverless.PartitionHours",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_SLS_DATAIN": {
+      "sku": "SKU_MSK_SLS_DATAIN",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Serverless.DataIn",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_SLS_DATAOUT": {
+      "sku": "SKU_MSK_SLS_DATAOUT",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Serverless.DataOut",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_MSK_SLS_STORAGE": {
+      "sku": "SKU_MSK_SLS_STORAGE",
+      "productFamily": "Managed Streaming for Apache Kafka (MSK)",
+      "attributes": {
+        "usagetype": "Kafka.Serverless.Storage",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_MSK_T3_SMALL": {
+        "SKU_MSK_T3_SMALL.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_T3_SMALL",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_T3_SMALL.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_T3_SMALL.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.0456 per Kafka.t3.small broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.0456" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M5_LARGE": {
+        "SKU_MSK_M5_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M5_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M5_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M5_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.21 per Kafka.m5.large broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.21" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M5_XLARGE": {
+        "SKU_MSK_M5_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M5_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.42 per Kafka.m5.xlarge broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.42" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M5_2XLARGE": {
+        "SKU_MSK_M5_2XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M5_2XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M5_2XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M5_2XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.84 per Kafka.m5.2xlarge broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.84" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M7G_LARGE": {
+        "SKU_MSK_M7G_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M7G_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M7G_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M7G_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.204 per Kafka.m7g.large broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.204" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M7G_XLARGE": {
+        "SKU_MSK_M7G_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M7G_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M7G_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M7G_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.408 per Kafka.m7g.xlarge broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.408" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_M7G_2XLARGE": {
+        "SKU_MSK_M7G_2XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_M7G_2XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_M7G_2XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_M7G_2XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.816 per Kafka.m7g.2xlarge broker hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.816" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_STORAGE": {
+        "SKU_MSK_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of broker storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_THROUGHPUT": {
+        "SKU_MSK_THROUGHPUT.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_THROUGHPUT",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_THROUGHPUT.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_THROUGHPUT.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.08 per MB/s-month of provisioned storage throughput",
+              "unit": "MBps-Mo",
+              "pricePerUnit": { "USD": "0.08" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_SLS_CLUSTER": {
+        "SKU_MSK_SLS_CLUSTER.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_SLS_CLUSTER",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_SLS_CLUSTER.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_SLS_CLUSTER.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.75 per MSK Serverless cluster hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.75" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_SLS_PARTITION": {
+        "SKU_MSK_SLS_PARTITION.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_SLS_PARTITION",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_SLS_PARTITION.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_SLS_PARTITION.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.0015 per MSK Serverless partition hour",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.0015" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_SLS_DATAIN": {
+        "SKU_MSK_SLS_DATAIN.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_SLS_DATAIN",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_SLS_DATAIN.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_SLS_DATAIN.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB of data written to MSK Serverless",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_SLS_DATAOUT": {
+        "SKU_MSK_SLS_DATAOUT.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_SLS_DATAOUT",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_SLS_DATAOUT.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_SLS_DATAOUT.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.05 per GB of data read from MSK Serverless",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.05" }
+            }
+          }
+        }
+      },
+      "SKU_MSK_SLS_STORAGE": {
+        "SKU_MSK_SLS_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_MSK_SLS_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_MSK_SLS_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_MSK_SLS_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per GB-month of MSK Serverless storage",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawSageMakerJSON contains minimal SageMaker pricing data for development/testing.
+var rawSageMakerJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AmazonSageMaker",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_SM_HOST_T2_MEDIUM": {
+      "sku": "SKU_SM_HOST_T2_MEDIUM",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.t2.medium",
+        "component": "Hosting",
+        "usagetype": "Host:ml.t2.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_M5_LARGE": {
+      "sku": "SKU_SM_HOST_M5_LARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.m5.large",
+        "component": "Hosting",
+        "usagetype": "Host:ml.m5.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_M5_XLARGE": {
+      "sku": "SKU_SM_HOST_M5_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.m5.xlarge",
+        "component": "Hosting",
+        "usagetype": "Host:ml.m5.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_C5_XLARGE": {
+      "sku": "SKU_SM_HOST_C5_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.c5.xlarge",
+        "component": "Hosting",
+        "usagetype": "Host:ml.c5.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_G4DN_XLARGE": {
+      "sku": "SKU_SM_HOST_G4DN_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.g4dn.xlarge",
+        "component": "Hosting",
+        "usagetype": "Host:ml.g4dn.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_G5_XLARGE": {
+      "sku": "SKU_SM_HOST_G5_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.g5.xlarge",
+        "component": "Hosting",
+        "usagetype": "Host:ml.g5.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_HOST_P3_2XLARGE": {
+      "sku": "SKU_SM_HOST_P3_2XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.p3.2xlarge",
+        "component": "Hosting",
+        "usagetype": "Host:ml.p3.2xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_NB_T3_MEDIUM": {
+      "sku": "SKU_SM_NB_T3_MEDIUM",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.t3.medium",
+        "component": "Notebook",
+        "usagetype": "Notebk:ml.t3.medium",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_NB_T3_LARGE": {
+      "sku": "SKU_SM_NB_T3_LARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.t3.large",
+        "component": "Notebook",
+        "usagetype": "Notebk:ml.t3.large",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_NB_M5_XLARGE": {
+      "sku": "SKU_SM_NB_M5_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.m5.xlarge",
+        "component": "Notebook",
+        "usagetype": "Notebk:ml.m5.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_NB_G4DN_XLARGE": {
+      "sku": "SKU_SM_NB_G4DN_XLARGE",
+      "productFamily": "ML Instance",
+      "attributes": {
+        "instanceName": "ml.g4dn.xlarge",
+        "component": "Notebook",
+        "usagetype": "Notebk:ml.g4dn.xlarge",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_NB_STORAGE": {
+      "sku": "SKU_SM_NB_STORAGE",
+      "productFamily": "ML Storage",
+      "attributes": {
+        "component": "Notebook",
+        "usagetype": "Notebk:VolumeUsage.gp2",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_SLS_MEM_1GB": {
+      "sku": "SKU_SM_SLS_MEM_1GB",
+      "productFamily": "ML Serverless Inference",
+      "attributes": {
+        "component": "Hosting",
+        "usagetype": "ServerlessInf:Mem-1GB",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_SLS_MEM_4GB": {
+      "sku": "SKU_SM_SLS_MEM_4GB",
+      "productFamily": "ML Serverless Inference",
+      "attributes": {
+        "component": "Hosting",
+        "usagetype": "ServerlessInf:Mem-4GB",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_SM_SLS_DATA": {
+      "sku": "SKU_SM_SLS_DATA",
+      "productFamily": "ML Serverless Inference",
+      "attributes": {
+        "component": "Hosting",
+        "usagetype": "ServerlessInf:Data-Bytes",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_SM_HOST_T2_MEDIUM": {
+        "SKU_SM_HOST_T2_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_T2_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_T2_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_T2_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.056 per Hosting ml.t2.medium hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.056" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_M5_LARGE": {
+        "SKU_SM_HOST_M5_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_M5_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_M5_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_M5_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.115 per Hosting ml.m5.large hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.115" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_M5_XLARGE": {
+        "SKU_SM_HOST_M5_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_M5_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.23 per Hosting ml.m5.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.23" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_C5_XLARGE": {
+        "SKU_SM_HOST_C5_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_C5_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_C5_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_C5_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.204 per Hosting ml.c5.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.204" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_G4DN_XLARGE": {
+        "SKU_SM_HOST_G4DN_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_G4DN_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_G4DN_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_G4DN_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.736 per Hosting ml.g4dn.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.736" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_G5_XLARGE": {
+        "SKU_SM_HOST_G5_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_G5_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_G5_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_G5_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$1.408 per Hosting ml.g5.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "1.408" }
+            }
+          }
+        }
+      },
+      "SKU_SM_HOST_P3_2XLARGE": {
+        "SKU_SM_HOST_P3_2XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_HOST_P3_2XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_HOST_P3_2XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_HOST_P3_2XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$3.825 per Hosting ml.p3.2xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "3.825" }
+            }
+          }
+        }
+      },
+      "SKU_SM_NB_T3_MEDIUM": {
+        "SKU_SM_NB_T3_MEDIUM.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_NB_T3_MEDIUM",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_NB_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_NB_T3_MEDIUM.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.05 per Notebook ml.t3.medium hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.05" }
+            }
+          }
+        }
+      },
+      "SKU_SM_NB_T3_LARGE": {
+        "SKU_SM_NB_T3_LARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_NB_T3_LARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_NB_T3_LARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_NB_T3_LARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.10 per Notebook ml.t3.large hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.10" }
+            }
+          }
+        }
+      },
+      "SKU_SM_NB_M5_XLARGE": {
+        "SKU_SM_NB_M5_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_NB_M5_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_NB_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_NB_M5_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.23 per Notebook ml.m5.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.23" }
+            }
+          }
+        }
+      },
+      "SKU_SM_NB_G4DN_XLARGE": {
+        "SKU_SM_NB_G4DN_XLARGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_NB_G4DN_XLARGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_NB_G4DN_XLARGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_NB_G4DN_XLARGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.7364 per Notebook ml.g4dn.xlarge hour in US East (N. Virginia)",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.7364" }
+            }
+          }
+        }
+      },
+      "SKU_SM_NB_STORAGE": {
+        "SKU_SM_NB_STORAGE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_NB_STORAGE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_NB_STORAGE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_NB_STORAGE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.14 per GB-month of ML storage for Notebook instances",
+              "unit": "GB-Mo",
+              "pricePerUnit": { "USD": "0.14" }
+            }
+          }
+        }
+      },
+      "SKU_SM_SLS_MEM_1GB": {
+        "SKU_SM_SLS_MEM_1GB.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_SLS_MEM_1GB",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_SLS_MEM_1GB.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_SLS_MEM_1GB.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.00002 per second of Serverless Inference with 1 GB of memory",
+              "unit": "Second",
+              "pricePerUnit": { "USD": "0.00002" }
+            }
+          }
+        }
+      },
+      "SKU_SM_SLS_MEM_4GB": {
+        "SKU_SM_SLS_MEM_4GB.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_SLS_MEM_4GB",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_SLS_MEM_4GB.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_SLS_MEM_4GB.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.00008 per second of Serverless Inference with 4 GB of memory",
+              "unit": "Second",
+              "pricePerUnit": { "USD": "0.00008" }
+            }
+          }
+        }
+      },
+      "SKU_SM_SLS_DATA": {
+        "SKU_SM_SLS_DATA.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SM_SLS_DATA",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SM_SLS_DATA.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SM_SLS_DATA.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.016 per GB of data processed in and out by Serverless Inference",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.016" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawWAFJSON contains minimal AWS WAF pricing data for development/testing.
+var rawWAFJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "awswaf",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_WAF_WEBACL": {
+      "sku": "SKU_WAF_WEBACL",
+      "productFamily": "Web Application Firewall",
+      "attributes": {
+        "group": "Web ACL",
+        "usagetype": "USE1-WebACL",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_WAF_RULE": {
+      "sku": "SKU_WAF_RULE",
+      "productFamily": "Web Application Firewall",
+      "attributes": {
+        "group": "Rule",
+        "usagetype": "USE1-Rule",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_WAF_REQUEST": {
+      "sku": "SKU_WAF_REQUEST",
+      "productFamily": "Web Application Firewall",
+      "attributes": {
+        "group": "Request",
+        "usagetype": "USE1-Request",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_WAF_WEBACL": {
+        "SKU_WAF_WEBACL.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_WAF_WEBACL",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_WAF_WEBACL.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_WAF_WEBACL.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$5.00 per web ACL per month (prorated hourly)",
+              "unit": "WebACL",
+              "pricePerUnit": { "USD": "5.00" }
+            }
+          }
+        }
+      },
+      "SKU_WAF_RULE": {
+        "SKU_WAF_RULE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_WAF_RULE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_WAF_RULE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_WAF_RULE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$1.00 per rule per web ACL per month (prorated hourly)",
+              "unit": "Rule",
+              "pricePerUnit": { "USD": "1.00" }
+            }
+          }
+        }
+      },
+      "SKU_WAF_REQUEST": {
+        "SKU_WAF_REQUEST.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_WAF_REQUEST",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_WAF_REQUEST.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_WAF_REQUEST.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.60 per million requests",
+              "unit": "Request",
+              "pricePerUnit": { "USD": "0.0000006" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawShieldJSON contains minimal AWS Shield pricing data for development/testing.
+var rawShieldJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSShield",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_SHIELD_ADV_FEE": {
+      "sku": "SKU_SHIELD_ADV_FEE",
+      "productFamily": "Shield",
+      "attributes": {
+        "group": "Shield Advanced",
+        "usagetype": "ShieldAdvanced-MonthlyFee",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_SHIELD_ADV_FEE": {
+        "SKU_SHIELD_ADV_FEE.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_SHIELD_ADV_FEE",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_SHIELD_ADV_FEE.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_SHIELD_ADV_FEE.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$3,000 per month for Shield Advanced (per organization)",
+              "unit": "Month",
+              "pricePerUnit": { "USD": "3000" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+// rawGlobalAcceleratorJSON contains minimal Global Accelerator pricing data for development/testing.
+var rawGlobalAcceleratorJSON = []byte(`{
+  "formatVersion": "v1.0",
+  "disclaimer": "Fallback data for development/testing only",
+  "offerCode": "AWSGlobalAccelerator",
+  "version": "fallback",
+  "publicationDate": "2024-01-01T00:00:00Z",
+  "products": {
+    "SKU_GA_FIXED": {
+      "sku": "SKU_GA_FIXED",
+      "productFamily": "Accelerator",
+      "attributes": {
+        "usagetype": "GlobalAccelerator-Hours",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_NA_NA": {
+      "sku": "SKU_GA_DTP_NA_NA",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "North America",
+        "toLocation": "North America",
+        "usagetype": "NA-NA-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_NA_EU": {
+      "sku": "SKU_GA_DTP_NA_EU",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "North America",
+        "toLocation": "Europe",
+        "usagetype": "NA-EU-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_NA_AP": {
+      "sku": "SKU_GA_DTP_NA_AP",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "North America",
+        "toLocation": "Asia Pacific",
+        "usagetype": "NA-AP-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_EU_EU": {
+      "sku": "SKU_GA_DTP_EU_EU",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "Europe",
+        "toLocation": "Europe",
+        "usagetype": "EU-EU-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_EU_NA": {
+      "sku": "SKU_GA_DTP_EU_NA",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "Europe",
+        "toLocation": "North America",
+        "usagetype": "EU-NA-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_AP_AP": {
+      "sku": "SKU_GA_DTP_AP_AP",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "Asia Pacific",
+        "toLocation": "Asia Pacific",
+        "usagetype": "AP-AP-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    },
+    "SKU_GA_DTP_AP_NA": {
+      "sku": "SKU_GA_DTP_AP_NA",
+      "productFamily": "Data Transfer Premium",
+      "attributes": {
+        "fromLocation": "Asia Pacific",
+        "toLocation": "North America",
+        "usagetype": "AP-NA-DT-Premium-Bytes",
+        "regionCode": "unknown"
+      }
+    }
+  },
+  "terms": {
+    "OnDemand": {
+      "SKU_GA_FIXED": {
+        "SKU_GA_FIXED.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_FIXED",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_FIXED.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_FIXED.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.025 per hour for each accelerator",
+              "unit": "Hrs",
+              "pricePerUnit": { "USD": "0.025" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_NA_NA": {
+        "SKU_GA_DTP_NA_NA.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_NA_NA",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_NA_NA.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_NA_NA.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.015 per GB Data Transfer-Premium from North America to North America",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.015" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_NA_EU": {
+        "SKU_GA_DTP_NA_EU.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_NA_EU",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_NA_EU.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_NA_EU.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.015 per GB Data Transfer-Premium from North America to Europe",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.015" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_NA_AP": {
+        "SKU_GA_DTP_NA_AP.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_NA_AP",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_NA_AP.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_NA_AP.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.035 per GB Data Transfer-Premium from North America to Asia Pacific",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.035" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_EU_EU": {
+        "SKU_GA_DTP_EU_EU.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_EU_EU",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_EU_EU.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_EU_EU.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.015 per GB Data Transfer-Premium from Europe to Europe",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.015" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_EU_NA": {
+        "SKU_GA_DTP_EU_NA.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_EU_NA",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_EU_NA.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_EU_NA.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.015 per GB Data Transfer-Premium from Europe to North America",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.015" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_AP_AP": {
+        "SKU_GA_DTP_AP_AP.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_AP_AP",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_AP_AP.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_AP_AP.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.010 per GB Data Transfer-Premium from Asia Pacific to Asia Pacific",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.010" }
+            }
+          }
+        }
+      },
+      "SKU_GA_DTP_AP_NA": {
+        "SKU_GA_DTP_AP_NA.JRTCKXETXF": {
+          "offerTermCode": "JRTCKXETXF",
+          "sku": "SKU_GA_DTP_AP_NA",
+          "effectiveDate": "2024-01-01T00:00:00Z",
+          "priceDimensions": {
+            "SKU_GA_DTP_AP_NA.JRTCKXETXF.6YS6EN2CT7": {
+              "rateCode": "SKU_GA_DTP_AP_NA.JRTCKXETXF.6YS6EN2CT7",
+              "description": "$0.035 per GB Data Transfer-Premium from Asia Pacific to North America",
+              "unit": "GB",
+              "pricePerUnit": { "USD": "0.035" }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
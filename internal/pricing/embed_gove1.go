@@ -36,3 +36,51 @@ var rawCloudWatchJSON []byte
 
 //go:embed data/elasticache_us-gov-east-1.json
 var rawElastiCacheJSON []byte
+
+//go:embed data/secretsmanager_us-gov-east-1.json
+var rawSecretsManagerJSON []byte
+
+//go:embed data/kms_us-gov-east-1.json
+var rawKMSJSON []byte
+
+//go:embed data/opensearch_us-gov-east-1.json
+var rawOpenSearchJSON []byte
+
+//go:embed data/redshift_us-gov-east-1.json
+var rawRedshiftJSON []byte
+
+//go:embed data/ecr_us-gov-east-1.json
+var rawECRJSON []byte
+
+//go:embed data/backup_us-gov-east-1.json
+var rawBackupJSON []byte
+
+//go:embed data/stepfunctions_us-gov-east-1.json
+var rawStepFunctionsJSON []byte
+
+//go:embed data/eventbridge_us-gov-east-1.json
+var rawEventBridgeJSON []byte
+
+//go:embed data/docdb_us-gov-east-1.json
+var rawDocumentDBJSON []byte
+
+//go:embed data/neptune_us-gov-east-1.json
+var rawNeptuneJSON []byte
+
+//go:embed data/memorydb_us-gov-east-1.json
+var rawMemoryDBJSON []byte
+
+//go:embed data/msk_us-gov-east-1.json
+var rawMSKJSON []byte
+
+//go:embed data/sagemaker_us-gov-east-1.json
+var rawSageMakerJSON []byte
+
+//go:embed data/waf_us-gov-east-1.json
+var rawWAFJSON []byte
+
+//go:embed data/shield_us-gov-east-1.json
+var rawShieldJSON []byte
+
+//go:embed data/globalaccelerator_us-gov-east-1.json
+var rawGlobalAcceleratorJSON []byte
@@ -36,3 +36,51 @@ var rawCloudWatchJSON []byte
 
 //go:embed data/elasticache_sa-east-1.json
 var rawElastiCacheJSON []byte
+
+//go:embed data/secretsmanager_sa-east-1.json
+var rawSecretsManagerJSON []byte
+
+//go:embed data/kms_sa-east-1.json
+var rawKMSJSON []byte
+
+//go:embed data/opensearch_sa-east-1.json
+var rawOpenSearchJSON []byte
+
+//go:embed data/redshift_sa-east-1.json
+var rawRedshiftJSON []byte
+
+//go:embed data/ecr_sa-east-1.json
+var rawECRJSON []byte
+
+//go:embed data/backup_sa-east-1.json
+var rawBackupJSON []byte
+
+//go:embed data/stepfunctions_sa-east-1.json
+var rawStepFunctionsJSON []byte
+
+//go:embed data/eventbridge_sa-east-1.json
+var rawEventBridgeJSON []byte
+
+//go:embed data/docdb_sa-east-1.json
+var rawDocumentDBJSON []byte
+
+//go:embed data/neptune_sa-east-1.json
+var rawNeptuneJSON []byte
+
+//go:embed data/memorydb_sa-east-1.json
+var rawMemoryDBJSON []byte
+
+//go:embed data/msk_sa-east-1.json
+var rawMSKJSON []byte
+
+//go:embed data/sagemaker_sa-east-1.json
+var rawSageMakerJSON []byte
+
+//go:embed data/waf_sa-east-1.json
+var rawWAFJSON []byte
+
+//go:embed data/shield_sa-east-1.json
+var rawShieldJSON []byte
+
+//go:embed data/globalaccelerator_sa-east-1.json
+var rawGlobalAcceleratorJSON []byte
@@ -0,0 +1,50 @@
+package pricing
+
+import "time"
+
+// PriceListAge is the age of one parsed price list at a given time.
+type PriceListAge struct {
+	PriceVintage
+
+	// Age is how long before the evaluation time AWS published the price list.
+	// Price lists published after the evaluation time have an age of zero.
+	Age time.Duration
+}
+
+// Published returns when AWS published the price list. ok is false when the
+// publication date is missing or is not an RFC 3339 timestamp.
+func (v PriceVintage) Published() (time.Time, bool) {
+	if v.PublicationDate == "" {
+		return time.Time{}, false
+	}
+	published, err := time.Parse(time.RFC3339, v.PublicationDate)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return published, true
+}
+
+// AgeAt returns the age of the price list at now. ok is false when the
+// publication date is unknown.
+func (v PriceVintage) AgeAt(now time.Time) (time.Duration, bool) {
+	published, ok := v.Published()
+	if !ok {
+		return 0, false
+	}
+	return max(now.Sub(published), 0), true
+}
+
+// PriceListAges returns the age at now of every parsed price list whose
+// publication date is known, sorted by offer code.
+func (c *Client) PriceListAges(now time.Time) []PriceListAge {
+	vintages := c.PriceVintages()
+	ages := make([]PriceListAge, 0, len(vintages))
+	for _, v := range vintages {
+		age, ok := v.AgeAt(now)
+		if !ok {
+			continue
+		}
+		ages = append(ages, PriceListAge{PriceVintage: v, Age: age})
+	}
+	return ages
+}
@@ -0,0 +1,54 @@
+package pricing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// TestPriceVintage_AgeAt verifies price list ages are measured from the
+// publication date and are never negative.
+func TestPriceVintage_AgeAt(t *testing.T) {
+	now := time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		publicationDate string
+		wantAge         time.Duration
+		wantOK          bool
+	}{
+		{"published earlier", "2025-01-01T00:00:00Z", 181 * 24 * time.Hour, true},
+		{"published later", "2025-08-01T00:00:00Z", 0, true},
+		{"missing date", "", 0, false},
+		{"malformed date", "January 2025", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			age, ok := PriceVintage{PublicationDate: tt.publicationDate}.AgeAt(now)
+			if ok != tt.wantOK || age != tt.wantAge {
+				t.Errorf("AgeAt() = (%v, %v), want (%v, %v)", age, ok, tt.wantAge, tt.wantOK)
+			}
+		})
+	}
+}
+
+// TestClient_PriceListAges verifies every embedded price list reports its age.
+func TestClient_PriceListAges(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	now := time.Now()
+	ages := client.PriceListAges(now)
+	if len(ages) != len(client.PriceVintages()) {
+		t.Errorf("PriceListAges() returned %d ages for %d price lists", len(ages), len(client.PriceVintages()))
+	}
+	for _, age := range ages {
+		published, _ := age.Published()
+		if want := now.Sub(published); age.Age != max(want, 0) {
+			t.Errorf("%s: Age = %v, want %v", age.OfferCode, age.Age, want)
+		}
+	}
+}
@@ -0,0 +1,1177 @@
+package pricing
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestClient_parseSecretsManagerPricing_Logic verifies secret and API request rates
+// are extracted from a minimal AWSSecretsManager payload.
+func TestClient_parseSecretsManagerPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSSecretsManager",
+		"products": {
+			"SKU_SECRET": {
+				"sku": "SKU_SECRET",
+				"productFamily": "Secret",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-AWSSecretsManager-Secrets"}
+			},
+			"SKU_API": {
+				"sku": "SKU_API",
+				"productFamily": "API Request",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-AWSSecretsManager-APIRequest"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_SECRET": {"SKU_SECRET.OFFER": {"priceDimensions": {"SKU_SECRET.OFFER.RATE": {
+					"unit": "Secrets", "pricePerUnit": {"USD": "0.40"}}}}},
+				"SKU_API": {"SKU_API.OFFER": {"priceDimensions": {"SKU_API.OFFER.RATE": {
+					"unit": "API Requests", "pricePerUnit": {"USD": "0.000005"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseSecretsManagerPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseSecretsManagerPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.secretsManagerPricing == nil {
+		t.Fatal("secretsManagerPricing is nil after parsing")
+	}
+	if client.secretsManagerPricing.SecretMonthlyRate != 0.40 {
+		t.Errorf("expected secret rate 0.40, got %v", client.secretsManagerPricing.SecretMonthlyRate)
+	}
+	if client.secretsManagerPricing.APIRequestRate != 0.000005 {
+		t.Errorf("expected API rate 0.000005, got %v", client.secretsManagerPricing.APIRequestRate)
+	}
+}
+
+// TestClient_parseKMSPricing_Logic verifies the key rate is extracted and the
+// $0 free-tier request dimension is skipped in favor of the paid rate.
+func TestClient_parseKMSPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "awskms",
+		"products": {
+			"SKU_KEY": {
+				"sku": "SKU_KEY",
+				"productFamily": "Encryption Key",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-KMS-Keys"}
+			},
+			"SKU_REQ": {
+				"sku": "SKU_REQ",
+				"productFamily": "KMS Requests",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-KMS-Requests"}
+			},
+			"SKU_REQ_ASYM": {
+				"sku": "SKU_REQ_ASYM",
+				"productFamily": "KMS Requests",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-KMS-Requests-Asymmetric"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_KEY": {"SKU_KEY.OFFER": {"priceDimensions": {"SKU_KEY.OFFER.RATE": {
+					"unit": "Keys", "pricePerUnit": {"USD": "1.00"}}}}},
+				"SKU_REQ": {"SKU_REQ.OFFER": {"priceDimensions": {
+					"SKU_REQ.OFFER.FREE": {"beginRange": "0", "endRange": "20000",
+						"unit": "Requests", "pricePerUnit": {"USD": "0.0000000000"}},
+					"SKU_REQ.OFFER.PAID": {"beginRange": "20000", "endRange": "Inf",
+						"unit": "Requests", "pricePerUnit": {"USD": "0.000003"}}}}},
+				"SKU_REQ_ASYM": {"SKU_REQ_ASYM.OFFER": {"priceDimensions": {"SKU_REQ_ASYM.OFFER.RATE": {
+					"unit": "Requests", "pricePerUnit": {"USD": "0.000015"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseKMSPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseKMSPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.kmsPricing == nil {
+		t.Fatal("kmsPricing is nil after parsing")
+	}
+	if client.kmsPricing.KeyMonthlyRate != 1.00 {
+		t.Errorf("expected key rate 1.00, got %v", client.kmsPricing.KeyMonthlyRate)
+	}
+	if client.kmsPricing.RequestRate != 0.000003 {
+		t.Errorf("expected request rate 0.000003, got %v", client.kmsPricing.RequestRate)
+	}
+}
+
+// TestClient_SecretsManagerAndKMSPrices verifies the public lookups return the
+// embedded rates for the current build.
+func TestClient_SecretsManagerAndKMSPrices(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	lookups := map[string]func() (float64, bool){
+		"SecretsManagerPricePerSecretMonth": client.SecretsManagerPricePerSecretMonth,
+		"SecretsManagerPricePerAPIRequest":  client.SecretsManagerPricePerAPIRequest,
+		"KMSPricePerKeyMonth":               client.KMSPricePerKeyMonth,
+		"KMSPricePerRequest":                client.KMSPricePerRequest,
+	}
+	for name, lookup := range lookups {
+		t.Run(name, func(t *testing.T) {
+			price, found := lookup()
+			if !found {
+				t.Fatalf("%s() returned not found", name)
+			}
+			if price <= 0 {
+				t.Errorf("%s() = %v, want > 0", name, price)
+			}
+		})
+	}
+}
+
+// TestClient_parseOpenSearchPricing_Logic verifies node, storage and serverless
+// OCU rates are indexed and that unknown storage media are skipped.
+func TestClient_parseOpenSearchPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonES",
+		"products": {
+			"SKU_NODE": {
+				"sku": "SKU_NODE",
+				"productFamily": "Amazon OpenSearch Service Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "r6g.large.search"}
+			},
+			"SKU_GP3": {
+				"sku": "SKU_GP3",
+				"productFamily": "Amazon OpenSearch Service Volume",
+				"attributes": {"regionCode": "us-test-1", "storageMedia": "GP3"}
+			},
+			"SKU_UNKNOWN_MEDIA": {
+				"sku": "SKU_UNKNOWN_MEDIA",
+				"productFamily": "Amazon OpenSearch Service Volume",
+				"attributes": {"regionCode": "us-test-1", "storageMedia": "Ultrawarm"}
+			},
+			"SKU_OCU": {
+				"sku": "SKU_OCU",
+				"productFamily": "Amazon OpenSearch Service Serverless",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-IndexingOCU"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_NODE": {"SKU_NODE.OFFER": {"priceDimensions": {"SKU_NODE.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.167"}}}}},
+				"SKU_GP3": {"SKU_GP3.OFFER": {"priceDimensions": {"SKU_GP3.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.122"}}}}},
+				"SKU_UNKNOWN_MEDIA": {"SKU_UNKNOWN_MEDIA.OFFER": {"priceDimensions": {"SKU_UNKNOWN_MEDIA.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.024"}}}}},
+				"SKU_OCU": {"SKU_OCU.OFFER": {"priceDimensions": {"SKU_OCU.OFFER.RATE": {
+					"unit": "OCU-hours", "pricePerUnit": {"USD": "0.24"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:                  zerolog.Nop(),
+		openSearchInstanceIndex: make(map[string]openSearchInstancePrice),
+		openSearchStorageIndex:  make(map[string]openSearchStoragePrice),
+	}
+	region, err := client.parseOpenSearchPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseOpenSearchPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if got := client.openSearchInstanceIndex["r6g.large.search"].HourlyRate; got != 0.167 {
+		t.Errorf("expected node rate 0.167, got %v", got)
+	}
+	if got := client.openSearchStorageIndex["gp3"].RatePerGBMonth; got != 0.122 {
+		t.Errorf("expected gp3 rate 0.122, got %v", got)
+	}
+	if len(client.openSearchStorageIndex) != 1 {
+		t.Errorf("expected 1 storage entry, got %d", len(client.openSearchStorageIndex))
+	}
+	if client.openSearchServerlessPricing == nil || client.openSearchServerlessPricing.OCURate != 0.24 {
+		t.Errorf("expected OCU rate 0.24, got %+v", client.openSearchServerlessPricing)
+	}
+}
+
+// TestClient_OpenSearchPrices verifies the public lookups, including suffix
+// normalization for node types.
+func TestClient_OpenSearchPrices(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	for _, instanceType := range []string{"m6g.large.search", "m6g.large.elasticsearch", "m6g.large"} {
+		if price, found := client.OpenSearchInstancePricePerHour(instanceType); !found || price <= 0 {
+			t.Errorf("OpenSearchInstancePricePerHour(%q) = %v, %v; want > 0, true", instanceType, price, found)
+		}
+	}
+	if price, found := client.OpenSearchStoragePricePerGBMonth("GP3"); !found || price <= 0 {
+		t.Errorf("OpenSearchStoragePricePerGBMonth(GP3) = %v, %v; want > 0, true", price, found)
+	}
+	if price, found := client.OpenSearchServerlessOCUPricePerHour(); !found || price <= 0 {
+		t.Errorf("OpenSearchServerlessOCUPricePerHour() = %v, %v; want > 0, true", price, found)
+	}
+	if _, found := client.OpenSearchInstancePricePerHour("nonexistent.huge.search"); found {
+		t.Error("expected unknown node type to be not found")
+	}
+}
+
+// TestClient_parseRedshiftPricing_Logic verifies node rates, managed storage and
+// serverless RPU rates are extracted.
+func TestClient_parseRedshiftPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonRedshift",
+		"products": {
+			"SKU_NODE": {
+				"sku": "SKU_NODE",
+				"productFamily": "Compute Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "ra3.xlplus"}
+			},
+			"SKU_RMS": {
+				"sku": "SKU_RMS",
+				"productFamily": "Redshift Managed Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-RMS:ra3"}
+			},
+			"SKU_RPU": {
+				"sku": "SKU_RPU",
+				"productFamily": "Redshift Serverless",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ServerlessUsage"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_NODE": {"SKU_NODE.OFFER": {"priceDimensions": {"SKU_NODE.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "1.086"}}}}},
+				"SKU_RMS": {"SKU_RMS.OFFER": {"priceDimensions": {"SKU_RMS.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.024"}}}}},
+				"SKU_RPU": {"SKU_RPU.OFFER": {"priceDimensions": {"SKU_RPU.OFFER.RATE": {
+					"unit": "RPU-Hr", "pricePerUnit": {"USD": "0.375"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:            zerolog.Nop(),
+		redshiftNodeIndex: make(map[string]redshiftNodePrice),
+	}
+	region, err := client.parseRedshiftPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseRedshiftPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if got := client.redshiftNodeIndex["ra3.xlplus"].HourlyRate; got != 1.086 {
+		t.Errorf("expected node rate 1.086, got %v", got)
+	}
+	if client.redshiftPricing == nil {
+		t.Fatal("redshiftPricing is nil after parsing")
+	}
+	if client.redshiftPricing.ManagedStorageRate != 0.024 {
+		t.Errorf("expected managed storage rate 0.024, got %v", client.redshiftPricing.ManagedStorageRate)
+	}
+	if client.redshiftPricing.ServerlessRPURate != 0.375 {
+		t.Errorf("expected RPU rate 0.375, got %v", client.redshiftPricing.ServerlessRPURate)
+	}
+}
+
+// TestClient_parseECRPricing_Logic verifies the storage rate is taken from the
+// TimedStorage SKU and data transfer SKUs are ignored.
+func TestClient_parseECRPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonECR",
+		"products": {
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "EC2 Container Registry",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-TimedStorage-ByteHrs"}
+			},
+			"SKU_DTO": {
+				"sku": "SKU_DTO",
+				"productFamily": "Data Transfer",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-DataTransfer-Out-Bytes"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_STORAGE": {"SKU_STORAGE.OFFER": {"priceDimensions": {"SKU_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_DTO": {"SKU_DTO.OFFER": {"priceDimensions": {"SKU_DTO.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.09"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseECRPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseECRPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.ecrPricing == nil {
+		t.Fatal("ecrPricing is nil after parsing")
+	}
+	if client.ecrPricing.StorageRate != 0.10 {
+		t.Errorf("expected storage rate 0.10, got %v", client.ecrPricing.StorageRate)
+	}
+}
+
+// TestClient_parseBackupPricing_Logic verifies warm and cold storage rates are
+// indexed by protected resource type and tier, and restore SKUs are ignored.
+func TestClient_parseBackupPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSBackup",
+		"products": {
+			"SKU_EFS_WARM": {
+				"sku": "SKU_EFS_WARM",
+				"productFamily": "AWS Backup Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-WarmStorage-ByteHrs-EFS"}
+			},
+			"SKU_EFS_COLD": {
+				"sku": "SKU_EFS_COLD",
+				"productFamily": "AWS Backup Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ColdStorage-ByteHrs-EFS"}
+			},
+			"SKU_DDB_WARM": {
+				"sku": "SKU_DDB_WARM",
+				"productFamily": "AWS Backup Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-WarmStorage-ByteHrs-DynamoDB"}
+			},
+			"SKU_EFS_RESTORE": {
+				"sku": "SKU_EFS_RESTORE",
+				"productFamily": "AWS Backup Restore",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Restore-Bytes-EFS"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_EFS_WARM": {"SKU_EFS_WARM.OFFER": {"priceDimensions": {"SKU_EFS_WARM.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.05"}}}}},
+				"SKU_EFS_COLD": {"SKU_EFS_COLD.OFFER": {"priceDimensions": {"SKU_EFS_COLD.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.01"}}}}},
+				"SKU_DDB_WARM": {"SKU_DDB_WARM.OFFER": {"priceDimensions": {"SKU_DDB_WARM.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_EFS_RESTORE": {"SKU_EFS_RESTORE.OFFER": {"priceDimensions": {"SKU_EFS_RESTORE.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.02"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{
+		logger:             zerolog.Nop(),
+		backupStorageIndex: make(map[string]backupStoragePrice),
+	}
+	region, err := client.parseBackupPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseBackupPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+
+	want := map[string]float64{
+		"efs/warm":      0.05,
+		"efs/cold":      0.01,
+		"dynamodb/warm": 0.10,
+	}
+	if len(client.backupStorageIndex) != len(want) {
+		t.Errorf("expected %d backup storage entries, got %d", len(want), len(client.backupStorageIndex))
+	}
+	for key, rate := range want {
+		if got := client.backupStorageIndex[key].RatePerGBMonth; got != rate {
+			t.Errorf("%s: expected rate %v, got %v", key, rate, got)
+		}
+	}
+}
+
+// TestClient_parseLambdaPricing_AddOns verifies provisioned concurrency, ephemeral
+// storage and SnapStart groups are captured, and that ARM lookups fall back to x86
+// rates when no -ARM group is published.
+func TestClient_parseLambdaPricing_AddOns(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSLambda",
+		"products": {
+			"SKU_PC": {
+				"sku": "SKU_PC",
+				"productFamily": "Serverless",
+				"attributes": {"regionCode": "us-test-1", "group": "AWS-Lambda-Provisioned-Concurrency"}
+			},
+			"SKU_PC_ARM": {
+				"sku": "SKU_PC_ARM",
+				"productFamily": "Serverless",
+				"attributes": {"regionCode": "us-test-1", "group": "AWS-Lambda-Provisioned-Concurrency-ARM"}
+			},
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "Serverless",
+				"attributes": {"regionCode": "us-test-1", "group": "AWS-Lambda-Storage-Duration"}
+			},
+			"SKU_SNAP_CACHE": {
+				"sku": "SKU_SNAP_CACHE",
+				"productFamily": "Serverless",
+				"attributes": {"regionCode": "us-test-1", "group": "AWS-Lambda-SnapStart-Cache"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_PC": {"SKU_PC.OFFER": {"priceDimensions": {"SKU_PC.OFFER.RATE": {
+					"unit": "Lambda-GB-Second", "pricePerUnit": {"USD": "0.0000041667"}}}}},
+				"SKU_PC_ARM": {"SKU_PC_ARM.OFFER": {"priceDimensions": {"SKU_PC_ARM.OFFER.RATE": {
+					"unit": "Lambda-GB-Second", "pricePerUnit": {"USD": "0.0000033334"}}}}},
+				"SKU_STORAGE": {"SKU_STORAGE.OFFER": {"priceDimensions": {"SKU_STORAGE.OFFER.RATE": {
+					"unit": "GB-Seconds", "pricePerUnit": {"USD": "0.0000000309"}}}}},
+				"SKU_SNAP_CACHE": {"SKU_SNAP_CACHE.OFFER": {"priceDimensions": {"SKU_SNAP_CACHE.OFFER.RATE": {
+					"unit": "Lambda-GB-Second", "pricePerUnit": {"USD": "0.0000015046"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseLambdaPricing(jsonData); err != nil {
+		t.Fatalf("parseLambdaPricing failed: %v", err)
+	}
+	if len(client.lambdaAddOnRates) != 4 {
+		t.Errorf("expected 4 add-on rates, got %d", len(client.lambdaAddOnRates))
+	}
+	// Mark initialization done so accessors read the parsed fixture.
+	client.once.Do(func() {})
+
+	arm, ok := client.LambdaAddOnPrices("arm64")
+	if !ok {
+		t.Fatal("LambdaAddOnPrices(arm64) not found")
+	}
+	if arm.ProvisionedConcurrencyRate != 0.0000033334 {
+		t.Errorf("expected ARM provisioned concurrency rate 0.0000033334, got %v", arm.ProvisionedConcurrencyRate)
+	}
+	if arm.EphemeralStorageRate != 0.0000000309 {
+		t.Errorf("expected ARM to fall back to x86 storage rate, got %v", arm.EphemeralStorageRate)
+	}
+
+	x86, ok := client.LambdaAddOnPrices("x86_64")
+	if !ok {
+		t.Fatal("LambdaAddOnPrices(x86_64) not found")
+	}
+	if x86.ProvisionedConcurrencyRate != 0.0000041667 {
+		t.Errorf("expected x86 provisioned concurrency rate 0.0000041667, got %v", x86.ProvisionedConcurrencyRate)
+	}
+	if x86.SnapStartRestoreRate != 0 {
+		t.Errorf("expected unpublished SnapStart restore rate 0, got %v", x86.SnapStartRestoreRate)
+	}
+}
+
+// TestClient_parseStepFunctionsPricing_Logic verifies Standard transitions, Express
+// requests and tiered Express duration are captured.
+func TestClient_parseStepFunctionsPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonStates",
+		"products": {
+			"SKU_TRANSITION": {
+				"sku": "SKU_TRANSITION",
+				"productFamily": "AWS Step Functions",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-StateTransition"}
+			},
+			"SKU_EXPRESS_REQ": {
+				"sku": "SKU_EXPRESS_REQ",
+				"productFamily": "AWS Step Functions",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ExpressWorkflows-Requests"}
+			},
+			"SKU_EXPRESS_DUR": {
+				"sku": "SKU_EXPRESS_DUR",
+				"productFamily": "AWS Step Functions",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ExpressWorkflows-Duration-GB-Second"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_TRANSITION": {"SKU_TRANSITION.OFFER": {"priceDimensions": {"SKU_TRANSITION.OFFER.RATE": {
+					"unit": "StateTransitions", "pricePerUnit": {"USD": "0.000025"}}}}},
+				"SKU_EXPRESS_REQ": {"SKU_EXPRESS_REQ.OFFER": {"priceDimensions": {"SKU_EXPRESS_REQ.OFFER.RATE": {
+					"unit": "Requests", "pricePerUnit": {"USD": "0.000001"}}}}},
+				"SKU_EXPRESS_DUR": {"SKU_EXPRESS_DUR.OFFER": {"priceDimensions": {
+					"SKU_EXPRESS_DUR.OFFER.T1": {"unit": "GB-Second", "beginRange": "0", "endRange": "3600000",
+						"pricePerUnit": {"USD": "0.00001667"}},
+					"SKU_EXPRESS_DUR.OFFER.T2": {"unit": "GB-Second", "beginRange": "3600000", "endRange": "Inf",
+						"pricePerUnit": {"USD": "0.00000833"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseStepFunctionsPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseStepFunctionsPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.stepFunctionsPricing == nil {
+		t.Fatal("stepFunctionsPricing is nil after parsing")
+	}
+	if client.stepFunctionsPricing.StandardTransitionRate != 0.000025 {
+		t.Errorf("expected transition rate 0.000025, got %v", client.stepFunctionsPricing.StandardTransitionRate)
+	}
+	if client.stepFunctionsPricing.ExpressRequestRate != 0.000001 {
+		t.Errorf("expected express request rate 0.000001, got %v", client.stepFunctionsPricing.ExpressRequestRate)
+	}
+	tiers := client.stepFunctionsPricing.ExpressDurationTiers
+	if len(tiers) != 2 {
+		t.Fatalf("expected 2 duration tiers, got %d", len(tiers))
+	}
+	if tiers[0].UpTo != 3600000 || tiers[0].Rate != 0.00001667 {
+		t.Errorf("unexpected first tier: %+v", tiers[0])
+	}
+}
+
+// TestClient_parseEventBridgePricing_Logic verifies custom event and Pipes rates are
+// captured while partner events are ignored.
+func TestClient_parseEventBridgePricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSEvents",
+		"products": {
+			"SKU_CUSTOM": {
+				"sku": "SKU_CUSTOM",
+				"productFamily": "EventBridge",
+				"attributes": {"regionCode": "us-test-1", "eventType": "Custom Events", "usagetype": "USE1-Event-64K-Chunks"}
+			},
+			"SKU_PARTNER": {
+				"sku": "SKU_PARTNER",
+				"productFamily": "EventBridge",
+				"attributes": {"regionCode": "us-test-1", "eventType": "Partner Events", "usagetype": "USE1-PartnerEvent-64K-Chunks"}
+			},
+			"SKU_PIPES": {
+				"sku": "SKU_PIPES",
+				"productFamily": "EventBridge",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Pipes-Requests-64K-Chunks"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_CUSTOM": {"SKU_CUSTOM.OFFER": {"priceDimensions": {"SKU_CUSTOM.OFFER.RATE": {
+					"unit": "Events", "pricePerUnit": {"USD": "0.000001"}}}}},
+				"SKU_PARTNER": {"SKU_PARTNER.OFFER": {"priceDimensions": {"SKU_PARTNER.OFFER.RATE": {
+					"unit": "Events", "pricePerUnit": {"USD": "0.000002"}}}}},
+				"SKU_PIPES": {"SKU_PIPES.OFFER": {"priceDimensions": {"SKU_PIPES.OFFER.RATE": {
+					"unit": "Requests", "pricePerUnit": {"USD": "0.0000004"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseEventBridgePricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseEventBridgePricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.eventBridgePricing == nil {
+		t.Fatal("eventBridgePricing is nil after parsing")
+	}
+	if client.eventBridgePricing.CustomEventRate != 0.000001 {
+		t.Errorf("expected custom event rate 0.000001, got %v", client.eventBridgePricing.CustomEventRate)
+	}
+	if client.eventBridgePricing.PipesRequestRate != 0.0000004 {
+		t.Errorf("expected pipes rate 0.0000004, got %v", client.eventBridgePricing.PipesRequestRate)
+	}
+}
+
+func TestClient_parseDocumentDBPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonDocDB",
+		"products": {
+			"SKU_R6G": {
+				"sku": "SKU_R6G",
+				"productFamily": "Database Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "db.r6g.large", "usagetype": "USE1-InstanceUsage:db.r6g.large"}
+			},
+			"SKU_R6G_IOOPT": {
+				"sku": "SKU_R6G_IOOPT",
+				"productFamily": "Database Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "db.r6g.large", "usagetype": "USE1-InstanceUsageIOOptimized:db.r6g.large"}
+			},
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "Database Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-StorageUsage"}
+			},
+			"SKU_STORAGE_IOOPT": {
+				"sku": "SKU_STORAGE_IOOPT",
+				"productFamily": "Database Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-IOOptimized-StorageUsage"}
+			},
+			"SKU_IO": {
+				"sku": "SKU_IO",
+				"productFamily": "System Operation",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-StorageIOUsage"}
+			},
+			"SKU_BACKUP": {
+				"sku": "SKU_BACKUP",
+				"productFamily": "Storage Snapshot",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-BackupUsage"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_R6G": {"SKU_R6G.OFFER": {"priceDimensions": {"SKU_R6G.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.249"}}}}},
+				"SKU_R6G_IOOPT": {"SKU_R6G_IOOPT.OFFER": {"priceDimensions": {"SKU_R6G_IOOPT.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.274"}}}}},
+				"SKU_STORAGE": {"SKU_STORAGE.OFFER": {"priceDimensions": {"SKU_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_STORAGE_IOOPT": {"SKU_STORAGE_IOOPT.OFFER": {"priceDimensions": {"SKU_STORAGE_IOOPT.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.30"}}}}},
+				"SKU_IO": {"SKU_IO.OFFER": {"priceDimensions": {"SKU_IO.OFFER.RATE": {
+					"unit": "IOs", "pricePerUnit": {"USD": "0.0000002"}}}}},
+				"SKU_BACKUP": {"SKU_BACKUP.OFFER": {"priceDimensions": {"SKU_BACKUP.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.021"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseDocumentDBPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseDocumentDBPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.docDBPricing == nil {
+		t.Fatal("docDBPricing is nil after parsing")
+	}
+
+	// I/O-Optimized SKUs must not overwrite Standard rates
+	if got := client.docDBPricing.InstanceRates["db.r6g.large"]; got != 0.249 {
+		t.Errorf("expected db.r6g.large rate 0.249, got %v", got)
+	}
+	if client.docDBPricing.StorageRate != 0.10 {
+		t.Errorf("expected storage rate 0.10, got %v", client.docDBPricing.StorageRate)
+	}
+	if client.docDBPricing.IORate != 0.0000002 {
+		t.Errorf("expected I/O rate 0.0000002, got %v", client.docDBPricing.IORate)
+	}
+	if client.docDBPricing.BackupRate != 0.021 {
+		t.Errorf("expected backup rate 0.021, got %v", client.docDBPricing.BackupRate)
+	}
+}
+
+func TestClient_parseNeptunePricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonNeptune",
+		"products": {
+			"SKU_R5": {
+				"sku": "SKU_R5",
+				"productFamily": "Database Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "db.r5.large", "usagetype": "USE1-InstanceUsage:db.r5.large"}
+			},
+			"SKU_SERVERLESS": {
+				"sku": "SKU_SERVERLESS",
+				"productFamily": "Database Instance",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ServerlessUsage"}
+			},
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "Database Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-StorageUsage"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_R5": {"SKU_R5.OFFER": {"priceDimensions": {"SKU_R5.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.348"}}}}},
+				"SKU_SERVERLESS": {"SKU_SERVERLESS.OFFER": {"priceDimensions": {"SKU_SERVERLESS.OFFER.RATE": {
+					"unit": "NCU-hr", "pricePerUnit": {"USD": "0.1608"}}}}},
+				"SKU_STORAGE": {"SKU_STORAGE.OFFER": {"priceDimensions": {"SKU_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseNeptunePricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseNeptunePricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.neptunePricing == nil {
+		t.Fatal("neptunePricing is nil after parsing")
+	}
+	if got := client.neptunePricing.InstanceRates["db.r5.large"]; got != 0.348 {
+		t.Errorf("expected db.r5.large rate 0.348, got %v", got)
+	}
+	if len(client.neptunePricing.InstanceRates) != 1 {
+		t.Errorf("expected serverless SKU to be ignored, got %d instance rates",
+			len(client.neptunePricing.InstanceRates))
+	}
+	if client.neptunePricing.StorageRate != 0.10 {
+		t.Errorf("expected storage rate 0.10, got %v", client.neptunePricing.StorageRate)
+	}
+}
+
+func TestClient_parseMemoryDBPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonMemoryDB",
+		"products": {
+			"SKU_R6G": {
+				"sku": "SKU_R6G",
+				"productFamily": "Amazon MemoryDB",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "db.r6g.large", "usagetype": "USE1-NodeUsage:db.r6g.large"}
+			},
+			"SKU_WRITTEN": {
+				"sku": "SKU_WRITTEN",
+				"productFamily": "Amazon MemoryDB",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-DataWritten-Bytes"}
+			},
+			"SKU_SNAPSHOT": {
+				"sku": "SKU_SNAPSHOT",
+				"productFamily": "Amazon MemoryDB",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-SnapshotStorage-ByteHrs"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_R6G": {"SKU_R6G.OFFER": {"priceDimensions": {"SKU_R6G.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.309"}}}}},
+				"SKU_WRITTEN": {"SKU_WRITTEN.OFFER": {"priceDimensions": {"SKU_WRITTEN.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.20"}}}}},
+				"SKU_SNAPSHOT": {"SKU_SNAPSHOT.OFFER": {"priceDimensions": {"SKU_SNAPSHOT.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.021"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseMemoryDBPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseMemoryDBPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.memoryDBPricing == nil {
+		t.Fatal("memoryDBPricing is nil after parsing")
+	}
+	if got := client.memoryDBPricing.NodeRates["db.r6g.large"]; got != 0.309 {
+		t.Errorf("expected db.r6g.large rate 0.309, got %v", got)
+	}
+	if client.memoryDBPricing.DataWrittenRate != 0.20 {
+		t.Errorf("expected data written rate 0.20, got %v", client.memoryDBPricing.DataWrittenRate)
+	}
+	if client.memoryDBPricing.SnapshotRate != 0.021 {
+		t.Errorf("expected snapshot rate 0.021, got %v", client.memoryDBPricing.SnapshotRate)
+	}
+}
+
+func TestClient_parseMSKPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonMSK",
+		"products": {
+			"SKU_M5": {
+				"sku": "SKU_M5",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "instanceType": "Kafka.m5.large", "usagetype": "USE1-Kafka.m5.large"}
+			},
+			"SKU_STORAGE": {
+				"sku": "SKU_STORAGE",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Kafka.Storage.GP2"}
+			},
+			"SKU_THROUGHPUT": {
+				"sku": "SKU_THROUGHPUT",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Kafka.Storage.ProvisionedThroughput"}
+			},
+			"SKU_SLS_CLUSTER": {
+				"sku": "SKU_SLS_CLUSTER",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Kafka.Serverless.ClusterHours"}
+			},
+			"SKU_SLS_PARTITION": {
+				"sku": "SKU_SLS_PARTITION",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Kafka.Serverless.PartitionHours"}
+			},
+			"SKU_SLS_STORAGE": {
+				"sku": "SKU_SLS_STORAGE",
+				"productFamily": "Managed Streaming for Apache Kafka (MSK)",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Kafka.Serverless.Storage"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_M5": {"SKU_M5.OFFER": {"priceDimensions": {"SKU_M5.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.21"}}}}},
+				"SKU_STORAGE": {"SKU_STORAGE.OFFER": {"priceDimensions": {"SKU_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.10"}}}}},
+				"SKU_THROUGHPUT": {"SKU_THROUGHPUT.OFFER": {"priceDimensions": {"SKU_THROUGHPUT.OFFER.RATE": {
+					"unit": "MBps-Mo", "pricePerUnit": {"USD": "0.08"}}}}},
+				"SKU_SLS_CLUSTER": {"SKU_SLS_CLUSTER.OFFER": {"priceDimensions": {"SKU_SLS_CLUSTER.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.75"}}}}},
+				"SKU_SLS_PARTITION": {"SKU_SLS_PARTITION.OFFER": {"priceDimensions": {"SKU_SLS_PARTITION.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.0015"}}}}},
+				"SKU_SLS_STORAGE": {"SKU_SLS_STORAGE.OFFER": {"priceDimensions": {"SKU_SLS_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.12"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseMSKPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseMSKPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.mskPricing == nil {
+		t.Fatal("mskPricing is nil after parsing")
+	}
+
+	// Broker types are normalized to lowercase
+	if got := client.mskPricing.BrokerRates["kafka.m5.large"]; got != 0.21 {
+		t.Errorf("expected kafka.m5.large rate 0.21, got %v", got)
+	}
+	if client.mskPricing.StorageRate != 0.10 {
+		t.Errorf("expected storage rate 0.10, got %v", client.mskPricing.StorageRate)
+	}
+	if client.mskPricing.ThroughputRate != 0.08 {
+		t.Errorf("expected throughput rate 0.08, got %v", client.mskPricing.ThroughputRate)
+	}
+	if client.mskPricing.ServerlessClusterRate != 0.75 {
+		t.Errorf("expected serverless cluster rate 0.75, got %v", client.mskPricing.ServerlessClusterRate)
+	}
+	if client.mskPricing.ServerlessPartitionRate != 0.0015 {
+		t.Errorf("expected serverless partition rate 0.0015, got %v", client.mskPricing.ServerlessPartitionRate)
+	}
+	// Serverless storage must not overwrite provisioned broker storage
+	if client.mskPricing.ServerlessStorageRate != 0.12 {
+		t.Errorf("expected serverless storage rate 0.12, got %v", client.mskPricing.ServerlessStorageRate)
+	}
+}
+
+// TestClient_parseSageMakerPricing_Logic verifies hosting and notebook instance
+// rates are keyed by lowercase ml.* type, notebook storage is captured, and
+// Serverless Inference memory tiers are normalized to a per-GB-second rate.
+func TestClient_parseSageMakerPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonSageMaker",
+		"products": {
+			"SKU_HOST": {
+				"sku": "SKU_HOST",
+				"productFamily": "ML Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceName": "ml.m5.large", "usagetype": "USE1-Host:ml.m5.large"}
+			},
+			"SKU_NOTEBOOK": {
+				"sku": "SKU_NOTEBOOK",
+				"productFamily": "ML Instance",
+				"attributes": {"regionCode": "us-test-1", "instanceName": "ML.T3.Medium", "usagetype": "USE1-Notebk:ml.t3.medium"}
+			},
+			"SKU_NB_STORAGE": {
+				"sku": "SKU_NB_STORAGE",
+				"productFamily": "ML Storage",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Notebk:VolumeUsage.gp2"}
+			},
+			"SKU_SLS_MEM": {
+				"sku": "SKU_SLS_MEM",
+				"productFamily": "ML Serverless",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ServerlessInf:Mem-4GB"}
+			},
+			"SKU_SLS_DATA": {
+				"sku": "SKU_SLS_DATA",
+				"productFamily": "ML Serverless",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ServerlessInf:Data-Bytes-In"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_HOST": {"SKU_HOST.OFFER": {"priceDimensions": {"SKU_HOST.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.115"}}}}},
+				"SKU_NOTEBOOK": {"SKU_NOTEBOOK.OFFER": {"priceDimensions": {"SKU_NOTEBOOK.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.05"}}}}},
+				"SKU_NB_STORAGE": {"SKU_NB_STORAGE.OFFER": {"priceDimensions": {"SKU_NB_STORAGE.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.14"}}}}},
+				"SKU_SLS_MEM": {"SKU_SLS_MEM.OFFER": {"priceDimensions": {"SKU_SLS_MEM.OFFER.RATE": {
+					"unit": "Second", "pricePerUnit": {"USD": "0.00008"}}}}},
+				"SKU_SLS_DATA": {"SKU_SLS_DATA.OFFER": {"priceDimensions": {"SKU_SLS_DATA.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.016"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseSageMakerPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseSageMakerPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.sageMakerPricing == nil {
+		t.Fatal("sageMakerPricing is nil after parsing")
+	}
+
+	if got := client.sageMakerPricing.HostingRates["ml.m5.large"]; got != 0.115 {
+		t.Errorf("expected ml.m5.large hosting rate 0.115, got %v", got)
+	}
+	if _, ok := client.sageMakerPricing.HostingRates["ml.t3.medium"]; ok {
+		t.Error("notebook instance should not be recorded as a hosting rate")
+	}
+	// Instance names are normalized to lowercase
+	if got := client.sageMakerPricing.NotebookRates["ml.t3.medium"]; got != 0.05 {
+		t.Errorf("expected ml.t3.medium notebook rate 0.05, got %v", got)
+	}
+	if client.sageMakerPricing.NotebookStorageRate != 0.14 {
+		t.Errorf("expected notebook storage rate 0.14, got %v", client.sageMakerPricing.NotebookStorageRate)
+	}
+	// 4 GB tier at $0.00008/s normalizes to $0.00002 per GB-second
+	if got := client.sageMakerPricing.ServerlessGBSecondRate; got < 0.0000199 || got > 0.0000201 {
+		t.Errorf("expected serverless GB-second rate 0.00002, got %v", got)
+	}
+	if client.sageMakerPricing.ServerlessDataRate != 0.016 {
+		t.Errorf("expected serverless data rate 0.016, got %v", client.sageMakerPricing.ServerlessDataRate)
+	}
+}
+
+// TestClient_parseWAFPricing_Logic verifies web ACL, rule and request rates are
+// captured and managed rule group SKUs are not mistaken for rules.
+func TestClient_parseWAFPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "awswaf",
+		"products": {
+			"SKU_WEBACL": {
+				"sku": "SKU_WEBACL",
+				"productFamily": "Web Application Firewall",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-WebACL"}
+			},
+			"SKU_RULE": {
+				"sku": "SKU_RULE",
+				"productFamily": "Web Application Firewall",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-Rule"}
+			},
+			"SKU_MANAGED_RULE": {
+				"sku": "SKU_MANAGED_RULE",
+				"productFamily": "Web Application Firewall",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-ManagedRule"}
+			},
+			"SKU_REQUEST": {
+				"sku": "SKU_REQUEST",
+				"productFamily": "Web Application Firewall",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "Request"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_WEBACL": {"SKU_WEBACL.OFFER": {"priceDimensions": {"SKU_WEBACL.OFFER.RATE": {
+					"unit": "WebACL", "pricePerUnit": {"USD": "5.00"}}}}},
+				"SKU_RULE": {"SKU_RULE.OFFER": {"priceDimensions": {"SKU_RULE.OFFER.RATE": {
+					"unit": "Rule", "pricePerUnit": {"USD": "1.00"}}}}},
+				"SKU_MANAGED_RULE": {"SKU_MANAGED_RULE.OFFER": {"priceDimensions": {"SKU_MANAGED_RULE.OFFER.RATE": {
+					"unit": "Rule", "pricePerUnit": {"USD": "10.00"}}}}},
+				"SKU_REQUEST": {"SKU_REQUEST.OFFER": {"priceDimensions": {"SKU_REQUEST.OFFER.RATE": {
+					"unit": "Request", "pricePerUnit": {"USD": "0.0000006"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseWAFPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseWAFPricing failed: %v", err)
+	}
+	if region != "us-test-1" {
+		t.Errorf("expected region 'us-test-1', got '%s'", region)
+	}
+	if client.wafPricing == nil {
+		t.Fatal("wafPricing is nil after parsing")
+	}
+	if client.wafPricing.WebACLRate != 5.00 {
+		t.Errorf("expected web ACL rate 5.00, got %v", client.wafPricing.WebACLRate)
+	}
+	if client.wafPricing.RuleRate != 1.00 {
+		t.Errorf("expected rule rate 1.00 (managed rules ignored), got %v", client.wafPricing.RuleRate)
+	}
+	// Request usagetype without a region prefix is still recognized
+	if client.wafPricing.RequestRate != 0.0000006 {
+		t.Errorf("expected request rate 0.0000006, got %v", client.wafPricing.RequestRate)
+	}
+}
+
+// TestClient_parseShieldPricing_Logic verifies only the Shield Advanced monthly
+// fee is captured.
+func TestClient_parseShieldPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSShield",
+		"products": {
+			"SKU_FEE": {
+				"sku": "SKU_FEE",
+				"productFamily": "Shield",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "ShieldAdvanced-MonthlyFee"}
+			},
+			"SKU_DTO": {
+				"sku": "SKU_DTO",
+				"productFamily": "Shield",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-DataTransfer-Out-Bytes-ELB"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_FEE": {"SKU_FEE.OFFER": {"priceDimensions": {"SKU_FEE.OFFER.RATE": {
+					"unit": "Month", "pricePerUnit": {"USD": "3000"}}}}},
+				"SKU_DTO": {"SKU_DTO.OFFER": {"priceDimensions": {"SKU_DTO.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.025"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseShieldPricing(jsonData); err != nil {
+		t.Fatalf("parseShieldPricing failed: %v", err)
+	}
+	if client.shieldPricing == nil {
+		t.Fatal("shieldPricing is nil after parsing")
+	}
+	if client.shieldPricing.MonthlyFee != 3000 {
+		t.Errorf("expected monthly fee 3000, got %v", client.shieldPricing.MonthlyFee)
+	}
+}
+
+// TestClient_parseGlobalAcceleratorPricing_Logic verifies the fixed hourly fee and
+// DT-Premium rates keyed by source and destination geography.
+func TestClient_parseGlobalAcceleratorPricing_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AWSGlobalAccelerator",
+		"products": {
+			"SKU_FIXED": {
+				"sku": "SKU_FIXED",
+				"productFamily": "Accelerator",
+				"attributes": {"usagetype": "GlobalAccelerator-Hours"}
+			},
+			"SKU_NA_EU": {
+				"sku": "SKU_NA_EU",
+				"productFamily": "Data Transfer Premium",
+				"attributes": {"fromLocation": "North America", "toLocation": "Europe", "usagetype": "NA-EU-DT-Premium-Bytes"}
+			},
+			"SKU_NA_AP": {
+				"sku": "SKU_NA_AP",
+				"productFamily": "Data Transfer Premium",
+				"attributes": {"fromLocation": "North America", "toLocation": "Asia Pacific", "usagetype": "NA-AP-DT-Premium-Bytes"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_FIXED": {"SKU_FIXED.OFFER": {"priceDimensions": {"SKU_FIXED.OFFER.RATE": {
+					"unit": "Hrs", "pricePerUnit": {"USD": "0.025"}}}}},
+				"SKU_NA_EU": {"SKU_NA_EU.OFFER": {"priceDimensions": {"SKU_NA_EU.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.015"}}}}},
+				"SKU_NA_AP": {"SKU_NA_AP.OFFER": {"priceDimensions": {"SKU_NA_AP.OFFER.RATE": {
+					"unit": "GB", "pricePerUnit": {"USD": "0.035"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	region, err := client.parseGlobalAcceleratorPricing(jsonData)
+	if err != nil {
+		t.Fatalf("parseGlobalAcceleratorPricing failed: %v", err)
+	}
+	// The offer is global, so no region is detected
+	if region != "" {
+		t.Errorf("expected empty region, got '%s'", region)
+	}
+	if client.globalAcceleratorPricing == nil {
+		t.Fatal("globalAcceleratorPricing is nil after parsing")
+	}
+	if client.globalAcceleratorPricing.HourlyRate != 0.025 {
+		t.Errorf("expected hourly rate 0.025, got %v", client.globalAcceleratorPricing.HourlyRate)
+	}
+	if got := client.globalAcceleratorPricing.DTPremiumRates["north america|europe"]; got != 0.015 {
+		t.Errorf("expected NA to Europe rate 0.015, got %v", got)
+	}
+	if got := client.globalAcceleratorPricing.DTPremiumRates["north america|asia pacific"]; got != 0.035 {
+		t.Errorf("expected NA to Asia Pacific rate 0.035, got %v", got)
+	}
+}
+
+// TestClient_parseS3Pricing_Tiers verifies S3 Standard volume tiers are
+// captured and its single-bucket rate is the first tier.
+func TestClient_parseS3Pricing_Tiers(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "AmazonS3",
+		"products": {
+			"SKU_STANDARD": {
+				"sku": "SKU_STANDARD",
+				"productFamily": "Storage",
+				"attributes": {"regionCode": "us-test-1", "storageClass": "General Purpose"}
+			},
+			"SKU_GLACIER": {
+				"sku": "SKU_GLACIER",
+				"productFamily": "Storage",
+				"attributes": {"regionCode": "us-test-1", "storageClass": "Archive"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_STANDARD": {"SKU_STANDARD.OFFER": {"priceDimensions": {
+					"SKU_STANDARD.OFFER.T3": {"unit": "GB-Mo", "beginRange": "512000", "endRange": "Inf",
+						"pricePerUnit": {"USD": "0.021"}},
+					"SKU_STANDARD.OFFER.T1": {"unit": "GB-Mo", "beginRange": "0", "endRange": "51200",
+						"pricePerUnit": {"USD": "0.023"}},
+					"SKU_STANDARD.OFFER.T2": {"unit": "GB-Mo", "beginRange": "51200", "endRange": "512000",
+						"pricePerUnit": {"USD": "0.022"}}}}},
+				"SKU_GLACIER": {"SKU_GLACIER.OFFER": {"priceDimensions": {"SKU_GLACIER.OFFER.RATE": {
+					"unit": "GB-Mo", "pricePerUnit": {"USD": "0.004"}}}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop(), s3Index: make(map[string]s3Price)}
+	if _, err := client.parseS3Pricing(jsonData); err != nil {
+		t.Fatalf("parseS3Pricing failed: %v", err)
+	}
+
+	standard := client.s3Index["General Purpose"]
+	if standard.RatePerGBMonth != 0.023 {
+		t.Errorf("expected first tier rate 0.023, got %v", standard.RatePerGBMonth)
+	}
+	if len(standard.Tiers) != 3 {
+		t.Fatalf("expected 3 tiers, got %d", len(standard.Tiers))
+	}
+	if standard.Tiers[1].UpTo != 512000 || standard.Tiers[1].Rate != 0.022 {
+		t.Errorf("unexpected second tier: %+v", standard.Tiers[1])
+	}
+	if glacier := client.s3Index["Archive"]; glacier.RatePerGBMonth != 0.004 || glacier.Tiers != nil {
+		t.Errorf("expected single-rate Archive class, got %+v", glacier)
+	}
+}
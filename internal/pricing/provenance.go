@@ -0,0 +1,164 @@
+package pricing
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PriceVintage identifies the AWS price list an offer's rates were parsed from.
+// Together with a RateSource it lets any estimate be traced back to the
+// published AWS price list.
+type PriceVintage struct {
+	// OfferCode identifies the AWS service offer (e.g., "AmazonEC2", "AWSELB").
+	OfferCode string
+
+	// Version is the price list version (timestamp-based, e.g., "20251218235654").
+	Version string
+
+	// PublicationDate is the ISO timestamp when AWS published the price list.
+	PublicationDate string
+}
+
+// RateSource identifies the price list entry behind one rate.
+type RateSource struct {
+	PriceVintage
+
+	// SKU is the AWS product SKU the rate belongs to.
+	SKU string
+
+	// RateCode is the OnDemand price dimension rate code
+	// ("<SKU>.<OfferTermCode>.<RateCode>").
+	RateCode string
+}
+
+// rateRef is the per-rate part of a RateSource; the vintage is shared per offer.
+type rateRef struct {
+	sku      string
+	rateCode string
+}
+
+// rateSourceIndex collects the rate sources of one price list while it is parsed.
+// Keys are the lookup keys the plugin reports as cost component SKUs (e.g.,
+// "t3.micro/Linux/Shared", "gp3", "request") and are matched case-insensitively.
+type rateSourceIndex struct {
+	pricing *awsPricing
+	refs    map[string]rateRef
+}
+
+// newRateSourceIndex returns an empty index for the given price list.
+func newRateSourceIndex(pricing *awsPricing) *rateSourceIndex {
+	return &rateSourceIndex{
+		pricing: pricing,
+		refs:    make(map[string]rateRef),
+	}
+}
+
+// add records the OnDemand rate of product sku under key, replacing any
+// earlier entry so the recorded source matches the rate that was kept.
+func (r *rateSourceIndex) add(key, sku string) {
+	r.refs[strings.ToLower(key)] = rateRef{
+		sku:      sku,
+		rateCode: onDemandRateCode(r.pricing, sku),
+	}
+}
+
+// onDemandRateCode returns the rate code of a product's OnDemand USD price
+// dimension. For tiered products it returns the lowest paid tier, matching the
+// rate extractTieredPricing lists first. Returns "" when there is none.
+func onDemandRateCode(data *awsPricing, sku string) string {
+	var (
+		rateCode string
+		lowest   = math.Inf(1)
+		fallback string
+	)
+	for _, t := range data.Terms["OnDemand"][sku] {
+		for _, dim := range t.PriceDimensions {
+			amountStr, hasUSD := dim.PricePerUnit["USD"]
+			if !hasUSD {
+				continue
+			}
+			amount, err := strconv.ParseFloat(amountStr, 64)
+			if err != nil {
+				continue
+			}
+			if fallback == "" {
+				fallback = dim.RateCode
+			}
+			if amount == 0 {
+				continue
+			}
+			begin, err := strconv.ParseFloat(dim.BeginRange, 64)
+			if err != nil {
+				begin = 0
+			}
+			if begin < lowest {
+				lowest = begin
+				rateCode = dim.RateCode
+			}
+		}
+	}
+	if rateCode == "" {
+		return fallback
+	}
+	return rateCode
+}
+
+// recordProvenance stores the vintage and rate sources of a parsed price list.
+// Safe to call from the parallel parsers.
+func (c *Client) recordProvenance(pricing *awsPricing, sources *rateSourceIndex) {
+	c.provenanceMu.Lock()
+	defer c.provenanceMu.Unlock()
+
+	if c.vintages == nil {
+		c.vintages = make(map[string]PriceVintage)
+		c.rateSources = make(map[string]map[string]rateRef)
+	}
+	c.vintages[pricing.OfferCode] = PriceVintage{
+		OfferCode:       pricing.OfferCode,
+		Version:         pricing.Version,
+		PublicationDate: pricing.PublicationDate,
+	}
+	if sources != nil && len(sources.refs) > 0 {
+		c.rateSources[pricing.OfferCode] = sources.refs
+	}
+}
+
+// PriceVintages returns the vintage of every parsed price list, sorted by offer code.
+func (c *Client) PriceVintages() []PriceVintage {
+	_ = c.init()
+
+	c.provenanceMu.RLock()
+	defer c.provenanceMu.RUnlock()
+
+	vintages := make([]PriceVintage, 0, len(c.vintages))
+	for _, v := range c.vintages {
+		vintages = append(vintages, v)
+	}
+	sort.Slice(vintages, func(i, j int) bool {
+		return vintages[i].OfferCode < vintages[j].OfferCode
+	})
+	return vintages
+}
+
+// RateSource returns the price list entry behind the rate looked up by key in
+// the given offer. When the offer was parsed but the key is unknown, the
+// returned source carries only the vintage and found is false.
+func (c *Client) RateSource(offerCode, key string) (RateSource, bool) {
+	_ = c.init()
+
+	c.provenanceMu.RLock()
+	defer c.provenanceMu.RUnlock()
+
+	vintage, ok := c.vintages[offerCode]
+	if !ok {
+		return RateSource{}, false
+	}
+	ref, found := c.rateSources[offerCode][strings.ToLower(key)]
+	return RateSource{
+		PriceVintage: vintage,
+		SKU:          ref.sku,
+		RateCode:     ref.rateCode,
+	}, found
+}
@@ -0,0 +1,115 @@
+package pricing
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rs/zerolog"
+)
+
+// TestClient_RateSource verifies embedded price lists record their vintage and
+// the source of the rates estimators look up. The keys are in both the regional
+// and the fallback price lists, so the test runs with or without a region tag.
+func TestClient_RateSource(t *testing.T) {
+	client, err := NewClient(zerolog.Nop())
+	if err != nil {
+		t.Fatalf("NewClient() failed: %v", err)
+	}
+
+	vintages := client.PriceVintages()
+	if len(vintages) == 0 {
+		t.Fatal("PriceVintages() returned no vintages")
+	}
+	for i, v := range vintages {
+		if v.OfferCode == "" || v.Version == "" || v.PublicationDate == "" {
+			t.Errorf("incomplete vintage %+v", v)
+		}
+		if i > 0 && vintages[i-1].OfferCode >= v.OfferCode {
+			t.Errorf("vintages not sorted by offer code: %q before %q", vintages[i-1].OfferCode, v.OfferCode)
+		}
+	}
+
+	tests := []struct {
+		offerCode string
+		key       string
+	}{
+		{"AmazonEC2", "t3.micro/Linux/Shared"},
+		{"AmazonEC2", "gp3"},
+		{"AWSELB", "alb-lcu"},
+		{"awskms", "customer-managed-key"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.offerCode+"/"+tt.key, func(t *testing.T) {
+			source, found := client.RateSource(tt.offerCode, tt.key)
+			if !found {
+				t.Fatalf("RateSource(%q, %q) not found", tt.offerCode, tt.key)
+			}
+			if source.OfferCode != tt.offerCode {
+				t.Errorf("OfferCode = %q, want %q", source.OfferCode, tt.offerCode)
+			}
+			if source.SKU == "" || !strings.HasPrefix(source.RateCode, source.SKU+".") {
+				t.Errorf("RateCode %q does not belong to SKU %q", source.RateCode, source.SKU)
+			}
+		})
+	}
+
+	source, found := client.RateSource("AmazonEC2", "t99.mega/Linux/Shared")
+	if found {
+		t.Error("RateSource() found an unknown key")
+	}
+	if source.Version == "" {
+		t.Error("RateSource() for an unknown key should still return the vintage")
+	}
+}
+
+// TestClient_recordProvenance_Logic verifies the vintage and rate codes captured
+// while parsing, including the lowest paid tier of a tiered rate.
+func TestClient_recordProvenance_Logic(t *testing.T) {
+	jsonData := []byte(`{
+		"formatVersion": "v1.0",
+		"offerCode": "awskms",
+		"version": "20250101000000",
+		"publicationDate": "2025-01-01T00:00:00Z",
+		"products": {
+			"SKU_KEY": {
+				"sku": "SKU_KEY",
+				"productFamily": "Encryption Key",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-KMS-Keys"}
+			},
+			"SKU_REQ": {
+				"sku": "SKU_REQ",
+				"productFamily": "KMS Requests",
+				"attributes": {"regionCode": "us-test-1", "usagetype": "USE1-KMS-Requests"}
+			}
+		},
+		"terms": {
+			"OnDemand": {
+				"SKU_KEY": {"SKU_KEY.OFFER": {"priceDimensions": {"SKU_KEY.OFFER.RATE": {
+					"rateCode": "SKU_KEY.OFFER.RATE", "unit": "Keys", "pricePerUnit": {"USD": "1"}}}}},
+				"SKU_REQ": {"SKU_REQ.OFFER": {"priceDimensions": {
+					"SKU_REQ.OFFER.FREE": {"rateCode": "SKU_REQ.OFFER.FREE", "beginRange": "0",
+						"endRange": "20000", "unit": "Requests", "pricePerUnit": {"USD": "0"}},
+					"SKU_REQ.OFFER.PAID": {"rateCode": "SKU_REQ.OFFER.PAID", "beginRange": "20000",
+						"endRange": "Inf", "unit": "Requests", "pricePerUnit": {"USD": "0.000003"}}
+				}}}
+			}
+		}
+	}`)
+
+	client := &Client{logger: zerolog.Nop()}
+	if _, err := client.parseKMSPricing(jsonData); err != nil {
+		t.Fatalf("parseKMSPricing failed: %v", err)
+	}
+
+	vintage := client.vintages["awskms"]
+	if vintage.Version != "20250101000000" || vintage.PublicationDate != "2025-01-01T00:00:00Z" {
+		t.Errorf("unexpected vintage %+v", vintage)
+	}
+	if got := client.rateSources["awskms"]["customer-managed-key"]; got.sku != "SKU_KEY" ||
+		got.rateCode != "SKU_KEY.OFFER.RATE" {
+		t.Errorf("unexpected key rate source %+v", got)
+	}
+	if got := client.rateSources["awskms"]["request"].rateCode; got != "SKU_REQ.OFFER.PAID" {
+		t.Errorf("expected the paid request tier rate code, got %q", got)
+	}
+}
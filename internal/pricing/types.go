@@ -63,6 +63,7 @@ type s3Price struct {
 	Unit           string
 	RatePerGBMonth float64
 	Currency       string
+	Tiers          []TierRate // volume tiers by GB stored; nil for a single rate
 }
 
 // rdsInstancePrice represents the hourly compute cost for RDS instances.
@@ -114,6 +115,35 @@ type lambdaPrice struct {
 	Currency string
 }
 
+// LambdaAddOnPrice represents the regional pricing for optional AWS Lambda features
+// for a single architecture. Rates are per GB-second unless noted.
+// Derived from AWS Pricing API for service AWSLambda; ARM rates come from the
+// "-ARM" variant of each group.
+type LambdaAddOnPrice struct {
+	// ProvisionedConcurrencyRate is the cost per GB-second of configured provisioned concurrency.
+	// Source: Group "AWS-Lambda-Provisioned-Concurrency"
+	ProvisionedConcurrencyRate float64
+
+	// ProvisionedDurationRate is the cost per GB-second of duration served by provisioned concurrency.
+	// Source: Group "AWS-Lambda-Duration-Provisioned"
+	ProvisionedDurationRate float64
+
+	// EphemeralStorageRate is the cost per GB-second of ephemeral storage above the free 512 MB.
+	// Source: Group "AWS-Lambda-Storage-Duration"
+	EphemeralStorageRate float64
+
+	// SnapStartCacheRate is the cost per GB-second of cached SnapStart snapshot.
+	// Source: Group "AWS-Lambda-SnapStart-Cache"
+	SnapStartCacheRate float64
+
+	// SnapStartRestoreRate is the cost per GB of snapshot restored.
+	// Source: Group "AWS-Lambda-SnapStart-Restore"
+	SnapStartRestoreRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
 // dynamoDBPrice holds the regional pricing configuration for Amazon DynamoDB.
 // Derived from AWS Pricing API for service AmazonDynamoDB.
 type dynamoDBPrice struct {
@@ -225,3 +255,318 @@ type cloudWatchPrice struct {
 	// Currency code (e.g., "USD")
 	Currency string
 }
+
+// secretsManagerPrice holds the regional pricing for AWS Secrets Manager.
+// Derived from AWS Pricing API for service AWSSecretsManager.
+type secretsManagerPrice struct {
+	// SecretMonthlyRate is the cost per secret per month.
+	// Source: Product Family "Secret", usageType containing "AWSSecretsManager-Secrets"
+	SecretMonthlyRate float64
+
+	// APIRequestRate is the cost per API request.
+	// Source: Product Family "API Request", usageType containing "AWSSecretsManager-APIRequest"
+	// Typical rate: $0.05 per 10,000 requests ($0.000005 per request)
+	APIRequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// kmsPrice holds the regional pricing for AWS Key Management Service.
+// Derived from AWS Pricing API for service awskms.
+type kmsPrice struct {
+	// KeyMonthlyRate is the cost per customer managed key per month.
+	// Source: Product Family "Encryption Key", usageType containing "KMS-Keys"
+	KeyMonthlyRate float64
+
+	// RequestRate is the cost per symmetric cryptographic request, excluding
+	// the account-level free tier.
+	// Source: Product Family "KMS Requests", usageType ending in "KMS-Requests"
+	// Typical rate: $0.03 per 10,000 requests ($0.000003 per request)
+	RequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// openSearchInstancePrice represents the hourly cost for an OpenSearch Service node.
+// Data nodes, dedicated master nodes and UltraWarm nodes share this shape; cost
+// calculations multiply this rate by node count and hours (730 per month).
+// Derived from AWS Pricing API for service AmazonES, Product Family "Amazon OpenSearch Service Instance".
+type openSearchInstancePrice struct {
+	// Unit is the billing unit, expected to be "Hrs" for hourly pricing.
+	Unit string
+	// HourlyRate is the on-demand cost per hour in USD.
+	HourlyRate float64
+	// Currency is the pricing currency (e.g., "USD").
+	Currency string
+}
+
+// openSearchStoragePrice represents the per-GB-month cost of EBS storage attached
+// to OpenSearch Service data nodes.
+// Derived from AWS Pricing API for service AmazonES, Product Family "Amazon OpenSearch Service Volume".
+type openSearchStoragePrice struct {
+	Unit           string
+	RatePerGBMonth float64
+	Currency       string
+}
+
+// openSearchServerlessPrice holds the regional pricing for OpenSearch Serverless.
+// Derived from AWS Pricing API for service AmazonES.
+type openSearchServerlessPrice struct {
+	// OCURate is the cost per OpenSearch Compute Unit hour.
+	// Source: Product Family "Amazon OpenSearch Service Serverless", usageType containing "IndexingOCU" or "SearchOCU"
+	// Indexing and search OCUs are billed at the same rate (~$0.24/OCU-hour).
+	OCURate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// redshiftNodePrice represents the hourly cost for a provisioned Redshift node.
+// Cluster cost multiplies this rate by node count and hours (730 per month).
+// Derived from AWS Pricing API for service AmazonRedshift, Product Family "Compute Instance".
+type redshiftNodePrice struct {
+	// Unit is the billing unit, expected to be "Hrs" for hourly pricing.
+	Unit string
+	// HourlyRate is the on-demand cost per node-hour in USD.
+	HourlyRate float64
+	// Currency is the pricing currency (e.g., "USD").
+	Currency string
+}
+
+// redshiftPrice holds the regional non-node pricing for Amazon Redshift.
+// Derived from AWS Pricing API for service AmazonRedshift.
+type redshiftPrice struct {
+	// ManagedStorageRate is the cost per GB-month of Redshift Managed Storage (RA3 and Serverless).
+	// Source: Product Family "Redshift Managed Storage"
+	ManagedStorageRate float64
+
+	// ServerlessRPURate is the cost per RPU-hour of Redshift Serverless compute.
+	// Source: Product Family "Redshift Serverless", usageType containing "ServerlessUsage"
+	ServerlessRPURate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// ecrPrice holds the regional storage pricing for Amazon ECR.
+// Derived from AWS Pricing API for service AmazonECR.
+type ecrPrice struct {
+	// StorageRate is the cost per GB-month of image storage in private repositories.
+	// Source: Product Family "EC2 Container Registry", usageType containing "TimedStorage-ByteHrs"
+	StorageRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// backupStoragePrice represents the monthly cost per GB of AWS Backup storage
+// for a single protected resource type and storage tier.
+// Derived from AWS Pricing API for service AWSBackup, usageType
+// "<Tier>Storage-ByteHrs-<ResourceType>" (e.g., "WarmStorage-ByteHrs-EFS").
+type backupStoragePrice struct {
+	// Unit is the billing unit, expected to be "GB-Mo".
+	Unit string
+	// RatePerGBMonth is the cost per GB-month in USD.
+	RatePerGBMonth float64
+	// Currency is the pricing currency (e.g., "USD").
+	Currency string
+}
+
+// stepFunctionsPrice holds the regional pricing for AWS Step Functions.
+// Derived from AWS Pricing API for service AmazonStates.
+type stepFunctionsPrice struct {
+	// StandardTransitionRate is the cost per state transition of a Standard workflow.
+	// Source: usageType containing "StateTransition"
+	StandardTransitionRate float64
+
+	// ExpressRequestRate is the cost per Express workflow request.
+	// Source: usageType containing "ExpressWorkflows-Requests"
+	ExpressRequestRate float64
+
+	// ExpressDurationTiers is the tiered cost per GB-second of Express workflow duration.
+	// Source: usageType containing "ExpressWorkflows-Duration"
+	ExpressDurationTiers []TierRate
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// eventBridgePrice holds the regional pricing for Amazon EventBridge.
+// Derived from AWS Pricing API for service AWSEvents. EventBridge bills each
+// 64 KB chunk of a payload as one event or request.
+type eventBridgePrice struct {
+	// CustomEventRate is the cost per custom event published to an event bus.
+	// Source: eventType "Custom Events"
+	CustomEventRate float64
+
+	// PipesRequestRate is the cost per EventBridge Pipes request.
+	// Source: usageType containing "Pipes"
+	PipesRequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// clusterDatabasePrice holds the regional pricing for a cluster database that
+// bills instance-hours plus shared cluster storage, I/O and backup storage.
+// Amazon DocumentDB (AmazonDocDB) and Amazon Neptune (AmazonNeptune) share this
+// price list structure. Rates are for the default Standard storage configuration.
+type clusterDatabasePrice struct {
+	// InstanceRates maps an instance class (e.g., "db.r6g.large") to its hourly rate.
+	// Source: Product Family "Database Instance"
+	InstanceRates map[string]float64
+
+	// StorageRate is the cost per GB-month of cluster storage.
+	// Source: usageType ending in "StorageUsage"
+	StorageRate float64
+
+	// IORate is the cost per I/O request against cluster storage.
+	// Source: usageType ending in "StorageIOUsage"
+	IORate float64
+
+	// BackupRate is the cost per GB-month of backup storage beyond the free allowance.
+	// Source: usageType ending in "BackupUsage"
+	BackupRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// memoryDBPrice holds the regional pricing for Amazon MemoryDB.
+// Derived from AWS Pricing API for service AmazonMemoryDB.
+type memoryDBPrice struct {
+	// NodeRates maps a node type (e.g., "db.r6g.large") to its hourly rate.
+	// Source: usageType containing "NodeUsage"
+	NodeRates map[string]float64
+
+	// DataWrittenRate is the cost per GB of data written to the cluster.
+	// Source: usageType containing "DataWritten"
+	DataWrittenRate float64
+
+	// SnapshotRate is the cost per GB-month of snapshot storage.
+	// Source: usageType containing "SnapshotStorage"
+	SnapshotRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// mskPrice holds the regional pricing for Amazon MSK (Managed Streaming for Apache Kafka).
+// Derived from AWS Pricing API for service AmazonMSK.
+type mskPrice struct {
+	// BrokerRates maps a broker instance type (e.g., "kafka.m5.large") to its hourly rate.
+	// Source: instanceType starting with "kafka."
+	BrokerRates map[string]float64
+
+	// StorageRate is the cost per GB-month of provisioned broker EBS storage.
+	// Source: usageType containing "Kafka.Storage" (excluding provisioned throughput)
+	StorageRate float64
+
+	// ThroughputRate is the cost per MB/s-month of provisioned storage throughput.
+	// Source: usageType containing "ProvisionedThroughput"
+	ThroughputRate float64
+
+	// ServerlessClusterRate is the cost per MSK Serverless cluster-hour.
+	// Source: usageType containing "Serverless.ClusterHours"
+	ServerlessClusterRate float64
+
+	// ServerlessPartitionRate is the cost per MSK Serverless partition-hour.
+	// Source: usageType containing "Serverless.PartitionHours"
+	ServerlessPartitionRate float64
+
+	// ServerlessDataInRate is the cost per GB written to an MSK Serverless cluster.
+	// Source: usageType containing "Serverless.DataIn"
+	ServerlessDataInRate float64
+
+	// ServerlessDataOutRate is the cost per GB read from an MSK Serverless cluster.
+	// Source: usageType containing "Serverless.DataOut"
+	ServerlessDataOutRate float64
+
+	// ServerlessStorageRate is the cost per GB-month of MSK Serverless storage.
+	// Source: usageType containing "Serverless.Storage"
+	ServerlessStorageRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// sageMakerPrice holds the regional pricing for Amazon SageMaker.
+// Derived from AWS Pricing API for service AmazonSageMaker.
+type sageMakerPrice struct {
+	// HostingRates maps an ML instance type (e.g., "ml.m5.large") to its hourly
+	// real-time inference (endpoint) rate.
+	// Source: usageType containing "Host:"
+	HostingRates map[string]float64
+
+	// NotebookRates maps an ML instance type (e.g., "ml.t3.medium") to its hourly
+	// notebook instance rate.
+	// Source: usageType containing "Notebk:"
+	NotebookRates map[string]float64
+
+	// NotebookStorageRate is the cost per GB-month of notebook ML storage.
+	// Source: usageType containing "Notebk:VolumeUsage"
+	NotebookStorageRate float64
+
+	// ServerlessGBSecondRate is the Serverless Inference compute cost per GB-second,
+	// normalized from the per-second rate of each memory size.
+	// Source: usageType containing "ServerlessInf:Mem-"
+	ServerlessGBSecondRate float64
+
+	// ServerlessDataRate is the cost per GB of data processed in and out by
+	// Serverless Inference.
+	// Source: usageType containing "ServerlessInf:Data"
+	ServerlessDataRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// wafPrice holds the regional pricing for AWS WAF (v2).
+// Derived from AWS Pricing API for service awswaf.
+type wafPrice struct {
+	// WebACLRate is the monthly fee per web ACL.
+	// Source: usageType ending in "WebACL"
+	WebACLRate float64
+
+	// RuleRate is the monthly fee per rule (or rule group) in a web ACL.
+	// Source: usageType ending in "Rule"
+	RuleRate float64
+
+	// RequestRate is the cost per web request inspected.
+	// Source: usageType ending in "Request"
+	RequestRate float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// shieldPrice holds the pricing for AWS Shield Advanced.
+// Derived from AWS Pricing API for service AWSShield.
+type shieldPrice struct {
+	// MonthlyFee is the Shield Advanced subscription fee per month. The fee is
+	// charged once per organization regardless of the number of protections.
+	// Source: usageType containing "MonthlyFee"
+	MonthlyFee float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
+
+// globalAcceleratorPrice holds the pricing for AWS Global Accelerator.
+// Derived from AWS Pricing API for service AWSGlobalAccelerator, which is
+// published as a single global offer.
+type globalAcceleratorPrice struct {
+	// HourlyRate is the fixed fee per accelerator-hour.
+	// Source: Product Family "Accelerator"
+	HourlyRate float64
+
+	// DTPremiumRates maps "<source>|<destination>" geographies (lowercase, e.g.,
+	// "north america|europe") to the Data Transfer-Premium rate per GB.
+	// Source: Product Family "Data Transfer Premium", fromLocation/toLocation attributes
+	DTPremiumRates map[string]float64
+
+	// Currency code (e.g., "USD")
+	Currency string
+}
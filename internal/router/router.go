@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/goccy/go-json"
@@ -14,6 +15,9 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/budget"
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 )
 
 // Plugin implements pluginsdk.Plugin as a multi-region router that delegates RPCs
@@ -26,6 +30,7 @@ type Plugin struct {
 	downloader *Downloader
 	offline    bool
 	binaryDir  string
+	budgets    atomic.Pointer[budget.Files] // replaced by ApplyConfig
 }
 
 // NewPlugin creates a new router Plugin with the given dependencies.
@@ -41,7 +46,7 @@ func NewPlugin(
 
 	registry := NewChildRegistry(discovered, downloader, offline, logger)
 
-	return &Plugin{
+	r := &Plugin{
 		version:    version,
 		logger:     logger.With().Str("component", "router").Logger(),
 		registry:   registry,
@@ -49,6 +54,20 @@ func NewPlugin(
 		offline:    offline,
 		binaryDir:  binaryDir,
 	}
+	r.ApplyConfig(nil)
+	return r
+}
+
+// ApplyConfig applies the budget files of a configuration file, nil for none.
+// FINFOCUS_BUDGETS_FILE and FINFOCUS_BUDGET_RESOURCES_FILE take precedence.
+// Children read the configuration file themselves, through FINFOCUS_CONFIG_FILE
+// in the environment they inherit.
+func (r *Plugin) ApplyConfig(cfg *config.Config) {
+	if cfg == nil {
+		cfg = &config.Config{}
+	}
+	files := cfg.Budgets.Files()
+	r.budgets.Store(&files)
 }
 
 // Name returns the plugin name identifier.
@@ -291,9 +310,32 @@ func (r *Plugin) DismissRecommendation(
 	return nil, status.Error(codes.Unimplemented, "DismissRecommendation is not supported by the router plugin")
 }
 
-// GetBudgets returns Unimplemented (no budget support in this plugin).
-func (r *Plugin) GetBudgets(_ context.Context, _ *pbc.GetBudgetsRequest) (*pbc.GetBudgetsResponse, error) {
-	return nil, status.Error(codes.Unimplemented, "GetBudgets is not supported by the router plugin")
+// GetBudgets evaluates the budgets declared in FINFOCUS_BUDGETS_FILE against
+// the resources in FINFOCUS_BUDGET_RESOURCES_FILE, pricing each resource with
+// its region's child so budgets can span regions.
+func (r *Plugin) GetBudgets(ctx context.Context, req *pbc.GetBudgetsRequest) (*pbc.GetBudgetsResponse, error) {
+	traceID := r.getTraceID(ctx)
+	config, resources, err := r.budgets.Load().Load()
+	if err != nil {
+		r.logger.Error().
+			Str("trace_id", traceID).
+			Err(err).
+			Msg("failed to load budgets")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return budget.Evaluate(ctx, config, resources, req, func(ctx context.Context,
+		resource *pbc.ResourceDescriptor) (*pbc.GetProjectedCostResponse, error) {
+		resp, priceErr := r.GetProjectedCost(ctx, &pbc.GetProjectedCostRequest{Resource: resource})
+		if priceErr != nil {
+			r.logger.Warn().
+				Str("trace_id", traceID).
+				Str("region", resource.GetRegion()).
+				Err(priceErr).
+				Msg("failed to price budget resource")
+		}
+		return resp, priceErr
+	}), nil
 }
 
 // HandleDryRun delegates to the first available child for region-agnostic introspection.
@@ -2,6 +2,8 @@ package router
 
 import (
 	"context"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/goccy/go-json"
@@ -15,6 +17,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 )
 
 // TestPlugin_Name verifies that the router returns the correct plugin name.
@@ -55,18 +59,30 @@ func TestPlugin_DismissRecommendation_Unimplemented(t *testing.T) {
 	assert.Equal(t, codes.Unimplemented, st.Code())
 }
 
-// TestPlugin_GetBudgets_Unimplemented verifies that GetBudgets returns
-// Unimplemented error as the router has no budget support.
-func TestPlugin_GetBudgets_Unimplemented(t *testing.T) {
+// TestPlugin_GetBudgets verifies that GetBudgets returns no budgets without a
+// budgets file, lists declared budgets, and reports invalid budgets files.
+func TestPlugin_GetBudgets(t *testing.T) {
 	logger := zerolog.New(zerolog.NewTestWriter(t))
 	r := NewPlugin("1.0.0", logger, t.TempDir(), true, nil)
 
-	_, err := r.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{})
+	resp, err := r.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, resp.GetBudgets())
+
+	budgetsFile := filepath.Join(t.TempDir(), "budgets.yaml")
+	require.NoError(t, os.WriteFile(budgetsFile, []byte("budgets:\n  - id: team\n    limit: 50\n"), 0o600))
+	r.ApplyConfig(&config.Config{Budgets: config.Budgets{File: budgetsFile}})
+
+	resp, err = r.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.GetBudgets(), 1)
+	assert.Equal(t, "team", resp.GetBudgets()[0].GetId())
+	assert.InDelta(t, 50, resp.GetBudgets()[0].GetAmount().GetLimit(), 1e-9)
 
+	require.NoError(t, os.WriteFile(budgetsFile, []byte("budgets:\n  - id: team\n"), 0o600))
+	_, err = r.GetBudgets(context.Background(), &pbc.GetBudgetsRequest{})
 	require.Error(t, err)
-	st, ok := status.FromError(err)
-	require.True(t, ok)
-	assert.Equal(t, codes.Unimplemented, st.Code())
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
 }
 
 // TestExtractRegionFromResource verifies region extraction from a ResourceDescriptor.
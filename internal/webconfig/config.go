@@ -8,6 +8,8 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 )
 
 // ParseWebConfig parses environment variables to configure the web server.
@@ -18,27 +20,44 @@ import (
 //   - FINFOCUS_CORS_ALLOW_CREDENTIALS: "true" to enable credentials
 //   - FINFOCUS_PLUGIN_HEALTH_ENDPOINT: "true" to enable health endpoint
 //   - FINFOCUS_CORS_MAX_AGE: preflight cache duration in seconds (default: 86400)
-func ParseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, error) { //nolint:gocognit
+func ParseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, error) {
+	return ParseWebConfigFrom(enabled, config.Web{}, logger)
+}
+
+// Enabled reports whether the web server is enabled by
+// FINFOCUS_PLUGIN_WEB_ENABLED, or by the configuration file when it is unset.
+func Enabled(file config.Web) bool {
+	return envBool("FINFOCUS_PLUGIN_WEB_ENABLED", file.Enabled)
+}
+
+// ParseWebConfigFrom configures the web server from the "web" section of the
+// configuration file. Environment variables that are set take precedence over
+// the file.
+func ParseWebConfigFrom(enabled bool, file config.Web, logger zerolog.Logger) (pluginsdk.WebConfig, error) { //nolint:gocognit
 	if !enabled {
 		return pluginsdk.WebConfig{}, nil
 	}
 
-	config := pluginsdk.WebConfig{
+	webConfig := pluginsdk.WebConfig{
 		Enabled: true,
 	}
 
 	// FR-001: Allowed Origins
 	hasWildcard := false
-	if origins := os.Getenv("FINFOCUS_CORS_ALLOWED_ORIGINS"); origins != "" {
+	origins := os.Getenv("FINFOCUS_CORS_ALLOWED_ORIGINS")
+	if origins == "" {
+		origins = strings.Join(file.CORSAllowedOrigins, ",")
+	}
+	if origins != "" {
 		for o := range strings.SplitSeq(origins, ",") {
 			trimmed := strings.TrimSpace(o)
 			if trimmed == "*" {
 				hasWildcard = true
-				config.AllowedOrigins = append(config.AllowedOrigins, trimmed)
+				webConfig.AllowedOrigins = append(webConfig.AllowedOrigins, trimmed)
 				continue
 			}
 			if trimmed != "" {
-				config.AllowedOrigins = append(config.AllowedOrigins, trimmed)
+				webConfig.AllowedOrigins = append(webConfig.AllowedOrigins, trimmed)
 			}
 		}
 
@@ -49,22 +68,21 @@ func ParseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, e
 	}
 
 	// FR-004: Allow Credentials
-	if strings.ToLower(os.Getenv("FINFOCUS_CORS_ALLOW_CREDENTIALS")) == "true" {
-		config.AllowCredentials = true
-	}
+	webConfig.AllowCredentials = envBool("FINFOCUS_CORS_ALLOW_CREDENTIALS", file.CORSAllowCredentials)
 
 	// FR-006: Health Endpoint
-	if strings.ToLower(os.Getenv("FINFOCUS_PLUGIN_HEALTH_ENDPOINT")) == "true" {
-		config.EnableHealthEndpoint = true
-	}
+	webConfig.EnableHealthEndpoint = envBool("FINFOCUS_PLUGIN_HEALTH_ENDPOINT", file.HealthEndpoint)
 
 	// FR-005: Fatal error on Wildcard + Credentials
-	if hasWildcard && config.AllowCredentials {
+	if hasWildcard && webConfig.AllowCredentials {
 		return pluginsdk.WebConfig{}, errors.New("cannot enable credentials with wildcard origin (*); security risk")
 	}
 
 	// FR-007 & FR-008: Max Age
 	maxAge := 86400 // Default
+	if file.CORSMaxAge != nil {
+		maxAge = *file.CORSMaxAge
+	}
 	if maxAgeStr := os.Getenv("FINFOCUS_CORS_MAX_AGE"); maxAgeStr != "" {
 		if parsed, err := strconv.Atoi(maxAgeStr); err == nil && parsed >= 0 {
 			maxAge = parsed
@@ -72,13 +90,22 @@ func ParseWebConfig(enabled bool, logger zerolog.Logger) (pluginsdk.WebConfig, e
 			logger.Warn().Str("value", maxAgeStr).Msg("invalid FINFOCUS_CORS_MAX_AGE, using default")
 		}
 	}
-	config.MaxAge = &maxAge
+	webConfig.MaxAge = &maxAge
 
 	// FR-009: Log configuration
 	logger.Debug().
-		Strs("allowed_origins", config.AllowedOrigins).
+		Strs("allowed_origins", webConfig.AllowedOrigins).
 		Int("max_age", maxAge).
 		Msg("CORS configuration applied")
 
-	return config, nil
+	return webConfig, nil
+}
+
+// envBool returns whether the named environment variable is "true" when it is
+// set, falling back to the configuration file value.
+func envBool(name string, fromFile *bool) bool {
+	if val := os.Getenv(name); val != "" {
+		return strings.ToLower(val) == "true"
+	}
+	return fromFile != nil && *fromFile
 }
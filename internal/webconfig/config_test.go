@@ -7,6 +7,8 @@ import (
 	"github.com/rshade/finfocus-spec/sdk/go/pluginsdk"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+
+	"github.com/rshade/finfocus-plugin-aws-public/internal/config"
 )
 
 func TestParseWebConfig(t *testing.T) {
@@ -184,3 +186,49 @@ func TestParseWebConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestParseWebConfigFrom(t *testing.T) {
+	logger := zerolog.New(zerolog.NewConsoleWriter())
+	for _, name := range []string{
+		"FINFOCUS_PLUGIN_WEB_ENABLED",
+		"FINFOCUS_CORS_ALLOWED_ORIGINS",
+		"FINFOCUS_CORS_ALLOW_CREDENTIALS",
+		"FINFOCUS_CORS_MAX_AGE",
+		"FINFOCUS_PLUGIN_HEALTH_ENDPOINT",
+	} {
+		t.Setenv(name, "")
+	}
+	enabled, credentials, health, maxAge := true, true, true, 600
+	file := config.Web{
+		Enabled:              &enabled,
+		CORSAllowedOrigins:   []string{"https://app.example.com"},
+		CORSAllowCredentials: &credentials,
+		CORSMaxAge:           &maxAge,
+		HealthEndpoint:       &health,
+	}
+
+	assert.True(t, Enabled(file))
+	assert.False(t, Enabled(config.Web{}))
+
+	webConfig, err := ParseWebConfigFrom(Enabled(file), file, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://app.example.com"}, webConfig.AllowedOrigins)
+	assert.True(t, webConfig.AllowCredentials)
+	assert.True(t, webConfig.EnableHealthEndpoint)
+	require.NotNil(t, webConfig.MaxAge)
+	assert.Equal(t, 600, *webConfig.MaxAge)
+
+	// Environment variables take precedence over the file
+	t.Setenv("FINFOCUS_PLUGIN_WEB_ENABLED", "false")
+	t.Setenv("FINFOCUS_CORS_ALLOWED_ORIGINS", "http://localhost:3000")
+	t.Setenv("FINFOCUS_CORS_ALLOW_CREDENTIALS", "false")
+	t.Setenv("FINFOCUS_CORS_MAX_AGE", "60")
+	assert.False(t, Enabled(file))
+
+	webConfig, err = ParseWebConfigFrom(true, file, logger)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"http://localhost:3000"}, webConfig.AllowedOrigins)
+	assert.False(t, webConfig.AllowCredentials)
+	assert.True(t, webConfig.EnableHealthEndpoint)
+	assert.Equal(t, 60, *webConfig.MaxAge)
+}
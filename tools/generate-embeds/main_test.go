@@ -34,7 +34,7 @@ func TestGenerateEmbedFile(t *testing.T) {
 				Tag:  "region_use1",
 			},
 			wantFile: "embed_use1.go",
-			// All 10 services must be present to catch template/fallback sync issues
+			// All 26 services must be present to catch template/fallback sync issues
 			wantConts: []string{
 				"//go:build region_use1",
 				"package pricing",
@@ -58,6 +58,38 @@ func TestGenerateEmbedFile(t *testing.T) {
 				"var rawCloudWatchJSON []byte",
 				"//go:embed data/elasticache_us-east-1.json",
 				"var rawElastiCacheJSON []byte",
+				"//go:embed data/secretsmanager_us-east-1.json",
+				"var rawSecretsManagerJSON []byte",
+				"//go:embed data/kms_us-east-1.json",
+				"var rawKMSJSON []byte",
+				"//go:embed data/opensearch_us-east-1.json",
+				"var rawOpenSearchJSON []byte",
+				"//go:embed data/redshift_us-east-1.json",
+				"var rawRedshiftJSON []byte",
+				"//go:embed data/ecr_us-east-1.json",
+				"var rawECRJSON []byte",
+				"//go:embed data/backup_us-east-1.json",
+				"var rawBackupJSON []byte",
+				"//go:embed data/stepfunctions_us-east-1.json",
+				"var rawStepFunctionsJSON []byte",
+				"//go:embed data/eventbridge_us-east-1.json",
+				"var rawEventBridgeJSON []byte",
+				"//go:embed data/docdb_us-east-1.json",
+				"var rawDocumentDBJSON []byte",
+				"//go:embed data/neptune_us-east-1.json",
+				"var rawNeptuneJSON []byte",
+				"//go:embed data/memorydb_us-east-1.json",
+				"var rawMemoryDBJSON []byte",
+				"//go:embed data/msk_us-east-1.json",
+				"var rawMSKJSON []byte",
+				"//go:embed data/sagemaker_us-east-1.json",
+				"var rawSageMakerJSON []byte",
+				"//go:embed data/waf_us-east-1.json",
+				"var rawWAFJSON []byte",
+				"//go:embed data/shield_us-east-1.json",
+				"var rawShieldJSON []byte",
+				"//go:embed data/globalaccelerator_us-east-1.json",
+				"var rawGlobalAcceleratorJSON []byte",
 			},
 		},
 		{
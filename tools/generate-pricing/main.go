@@ -17,16 +17,39 @@ import (
 // serviceConfig maps AWS service codes to lowercase file prefixes.
 // Used for generating per-service pricing files.
 var serviceConfig = map[string]string{
-	"AmazonEC2":         "ec2",
-	"AmazonS3":          "s3",
-	"AWSLambda":         "lambda",
-	"AmazonRDS":         "rds",
-	"AmazonEKS":         "eks",
-	"AmazonDynamoDB":    "dynamodb",
-	"AWSELB":            "elb",
-	"AmazonVPC":         "vpc",
-	"AmazonCloudWatch":  "cloudwatch",
-	"AmazonElastiCache": "elasticache",
+	"AmazonEC2":            "ec2",
+	"AmazonS3":             "s3",
+	"AWSLambda":            "lambda",
+	"AmazonRDS":            "rds",
+	"AmazonEKS":            "eks",
+	"AmazonDynamoDB":       "dynamodb",
+	"AWSELB":               "elb",
+	"AmazonVPC":            "vpc",
+	"AmazonCloudWatch":     "cloudwatch",
+	"AmazonElastiCache":    "elasticache",
+	"AWSSecretsManager":    "secretsmanager",
+	"awskms":               "kms",
+	"AmazonES":             "opensearch",
+	"AmazonRedshift":       "redshift",
+	"AmazonECR":            "ecr",
+	"AWSBackup":            "backup",
+	"AmazonStates":         "stepfunctions",
+	"AWSEvents":            "eventbridge",
+	"AmazonDocDB":          "docdb",
+	"AmazonNeptune":        "neptune",
+	"AmazonMemoryDB":       "memorydb",
+	"AmazonMSK":            "msk",
+	"AmazonSageMaker":      "sagemaker",
+	"awswaf":               "waf",
+	"AWSShield":            "shield",
+	"AWSGlobalAccelerator": "globalaccelerator",
+}
+
+// globalServices lists service codes that AWS publishes as a single global offer
+// file rather than per-region files. The global file is written for every region
+// so each regional binary embeds the same data.
+var globalServices = map[string]bool{
+	"AWSGlobalAccelerator": true,
 }
 
 // main is the program entry point that fetches AWS pricing data per service.
@@ -42,7 +65,7 @@ func main() {
 	outDir := flag.String("out-dir", "./data", "Output directory")
 	service := flag.String(
 		"service",
-		"AmazonEC2,AmazonS3,AWSLambda,AmazonRDS,AmazonEKS,AmazonDynamoDB,AWSELB,AmazonVPC,AmazonCloudWatch,AmazonElastiCache",
+		"AmazonEC2,AmazonS3,AWSLambda,AmazonRDS,AmazonEKS,AmazonDynamoDB,AWSELB,AmazonVPC,AmazonCloudWatch,AmazonElastiCache,AWSSecretsManager,awskms,AmazonES,AmazonRedshift,AmazonECR,AWSBackup,AmazonStates,AWSEvents,AmazonDocDB,AmazonNeptune,AmazonMemoryDB,AmazonMSK,AmazonSageMaker,awswaf,AWSShield,AWSGlobalAccelerator",
 		"AWS Service Codes (comma-separated)",
 	)
 	dummy := flag.Bool("dummy", false, "DEPRECATED: ignored, real data is always fetched")
@@ -146,6 +169,8 @@ type awsPricingResponse struct {
 //
 // region is the AWS region code (for example, "us-east-1").
 // service is the AWS service code (for example, "AmazonEC2", "AWSELB").
+// Services listed in globalServices are fetched from the service-wide offer file
+// regardless of region.
 //
 // Returns the filtered JSON bytes on success. An error is returned if the HTTP request fails,
 // the response status is not 200 OK, or reading the response body fails.
@@ -155,6 +180,9 @@ func fetchServicePricingRaw(region, service string) ([]byte, error) {
 		service,
 		region,
 	)
+	if globalServices[service] {
+		url = fmt.Sprintf("https://pricing.us-east-1.amazonaws.com/offers/v1.0/aws/%s/current/index.json", service)
+	}
 
 	// Create request with context for timeout support
 	ctx, cancel := context.WithTimeout(context.Background(), httpRequestTimeout)